@@ -1,14 +1,26 @@
 package analyzer
 
 import (
+	"sort"
+
 	awstype "github.com/ppiankov/awsspectre/internal/aws"
 )
 
-// Analyze filters findings by minimum cost and computes aggregated summary statistics.
+// Analyze suppresses baseline findings, applies severity overrides, filters findings
+// by minimum cost, sorts them so the costliest come first, and computes aggregated
+// summary statistics.
 func Analyze(result *awstype.ScanResult, cfg AnalyzerConfig) *AnalysisResult {
 	var filtered []awstype.Finding
+	suppressed := 0
 	for _, f := range result.Findings {
-		if includeFinding(f, cfg.MinMonthlyCost) {
+		if cfg.Baseline[Fingerprint(f)] {
+			suppressed++
+			continue
+		}
+		if severity, ok := cfg.SeverityOverrides[f.ID]; ok {
+			f.Severity = severity
+		}
+		if includeFinding(f, minMonthlyCost(f, cfg)) {
 			filtered = append(filtered, f)
 		}
 	}
@@ -17,16 +29,31 @@ func Analyze(result *awstype.ScanResult, cfg AnalyzerConfig) *AnalysisResult {
 		TotalResourcesScanned: result.ResourcesScanned,
 		TotalFindings:         len(filtered),
 		RegionsScanned:        result.RegionsScanned,
+		Suppressed:            suppressed,
 		BySeverity:            make(map[string]int),
 		ByResourceType:        make(map[string]int),
 	}
+	if cfg.GroupByTag != "" {
+		summary.TagKey = cfg.GroupByTag
+		summary.ByTag = make(map[string]float64)
+	}
 
 	for _, f := range filtered {
 		summary.TotalMonthlyWaste += f.EstimatedMonthlyWaste
+		if cfg.CostRanges {
+			low, high := wasteRange(f)
+			summary.TotalWasteLow += low
+			summary.TotalWasteHigh += high
+		}
 		summary.BySeverity[string(f.Severity)]++
 		summary.ByResourceType[string(f.ResourceType)]++
+		if cfg.GroupByTag != "" {
+			summary.ByTag[tagValue(f, cfg.GroupByTag)] += f.EstimatedMonthlyWaste
+		}
 	}
 
+	sortByWaste(filtered)
+
 	return &AnalysisResult{
 		Findings: filtered,
 		Summary:  summary,
@@ -34,6 +61,61 @@ func Analyze(result *awstype.ScanResult, cfg AnalyzerConfig) *AnalysisResult {
 	}
 }
 
+// sortByWaste orders findings by descending waste, then resource type, resource ID,
+// region, and finding ID. Scanners run concurrently, so without it report order
+// would change from run to run.
+func sortByWaste(findings []awstype.Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.EstimatedMonthlyWaste != b.EstimatedMonthlyWaste {
+			return a.EstimatedMonthlyWaste > b.EstimatedMonthlyWaste
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.ID < b.ID
+	})
+}
+
+// tagValue returns the value of tag key from a finding's "tags" metadata, or
+// UntaggedValue when the finding has no such tag.
+func tagValue(f awstype.Finding, key string) string {
+	var value string
+	switch tags := f.Metadata["tags"].(type) {
+	case map[string]string:
+		value = tags[key]
+	case map[string]any:
+		// Metadata decoded from a JSON report.
+		value, _ = tags[key].(string)
+	}
+	if value == "" {
+		return UntaggedValue
+	}
+	return value
+}
+
+// wasteRange returns a finding's cost bounds, collapsing to the point estimate when unset.
+func wasteRange(f awstype.Finding) (float64, float64) {
+	if f.EstimatedMonthlyWasteLow == 0 && f.EstimatedMonthlyWasteHigh == 0 {
+		return f.EstimatedMonthlyWaste, f.EstimatedMonthlyWaste
+	}
+	return f.EstimatedMonthlyWasteLow, f.EstimatedMonthlyWasteHigh
+}
+
+// minMonthlyCost returns the cost threshold for a finding's resource type.
+func minMonthlyCost(f awstype.Finding, cfg AnalyzerConfig) float64 {
+	if threshold, ok := cfg.MinMonthlyCostByType[f.ResourceType]; ok {
+		return threshold
+	}
+	return cfg.MinMonthlyCost
+}
+
 func includeFinding(f awstype.Finding, minMonthlyCost float64) bool {
 	if f.Hygiene {
 		return true
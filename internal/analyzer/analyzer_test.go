@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"math"
 	"testing"
 
 	awstype "github.com/ppiankov/awsspectre/internal/aws"
@@ -191,6 +192,62 @@ func TestAnalyze_SummaryAggregation(t *testing.T) {
 	}
 }
 
+func TestAnalyze_AppliesSeverityOverrides(t *testing.T) {
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			{ID: awstype.FindingIdleEC2, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEC2, EstimatedMonthlyWaste: 50.0},
+			{ID: awstype.FindingUnusedSecurityGroup, Severity: awstype.SeverityLow, ResourceType: awstype.ResourceSecurityGroup, ResourceID: "sg-1"},
+			{ID: awstype.FindingUnusedSecurityGroup, Severity: awstype.SeverityLow, ResourceType: awstype.ResourceSecurityGroup, ResourceID: "sg-2"},
+		},
+	}
+
+	analysis := Analyze(result, AnalyzerConfig{SeverityOverrides: map[awstype.FindingID]awstype.Severity{
+		awstype.FindingUnusedSecurityGroup: awstype.SeverityHigh,
+	}})
+
+	for _, f := range analysis.Findings {
+		if f.Severity != awstype.SeverityHigh {
+			t.Fatalf("expected %s to be high after override, got %s", f.ID, f.Severity)
+		}
+	}
+	if analysis.Summary.BySeverity["high"] != 3 {
+		t.Fatalf("expected 3 high severity, got %d", analysis.Summary.BySeverity["high"])
+	}
+	if n, ok := analysis.Summary.BySeverity["low"]; ok {
+		t.Fatalf("expected no low severity, got %d", n)
+	}
+	if result.Findings[1].Severity != awstype.SeverityLow {
+		t.Fatal("expected the scan result to be left unmodified")
+	}
+}
+
+func TestAnalyze_MinMonthlyCostByType(t *testing.T) {
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			{ID: awstype.FindingUnusedEIP, ResourceType: awstype.ResourceEIP, ResourceID: "eip-1", EstimatedMonthlyWaste: 3.6},
+			{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-1", EstimatedMonthlyWaste: 8.0},
+			{ID: awstype.FindingDetachedEBS, ResourceType: awstype.ResourceEBS, ResourceID: "vol-1", EstimatedMonthlyWaste: 4.0},
+		},
+	}
+
+	analysis := Analyze(result, AnalyzerConfig{
+		MinMonthlyCost: 5.0,
+		MinMonthlyCostByType: map[awstype.ResourceType]float64{
+			awstype.ResourceEIP: 0,
+			awstype.ResourceEC2: 10.0,
+		},
+	})
+
+	if len(analysis.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %+v", len(analysis.Findings), analysis.Findings)
+	}
+	// eip-1 is under the global $5 but its type threshold is $0; i-1 is over the
+	// global $5 but under its type threshold of $10; vol-1 uses the global $5.
+	if analysis.Findings[0].ResourceID != "eip-1" {
+		t.Fatalf("expected only eip-1, got %s", analysis.Findings[0].ResourceID)
+	}
+}
+
 func TestAnalyze_NoFindings(t *testing.T) {
 	result := &awstype.ScanResult{
 		ResourcesScanned: 50,
@@ -235,3 +292,87 @@ func hasFindingID(findings []awstype.Finding, id awstype.FindingID) bool {
 	}
 	return false
 }
+
+func TestAnalyze_SumsCostRanges(t *testing.T) {
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, EstimatedMonthlyWaste: 50, EstimatedMonthlyWasteLow: 45, EstimatedMonthlyWasteHigh: 55},
+			{ID: awstype.FindingUnusedEIP, ResourceType: awstype.ResourceEIP, EstimatedMonthlyWaste: 4},
+		},
+	}
+
+	analysis := Analyze(result, AnalyzerConfig{MinMonthlyCost: 1.0, CostRanges: true})
+	if analysis.Summary.TotalMonthlyWaste != 54 {
+		t.Fatalf("expected point total 54, got %f", analysis.Summary.TotalMonthlyWaste)
+	}
+	if analysis.Summary.TotalWasteLow != 49 || analysis.Summary.TotalWasteHigh != 59 {
+		t.Fatalf("expected range 49–59, got %f–%f", analysis.Summary.TotalWasteLow, analysis.Summary.TotalWasteHigh)
+	}
+
+	plain := Analyze(result, AnalyzerConfig{MinMonthlyCost: 1.0})
+	if plain.Summary.TotalWasteLow != 0 || plain.Summary.TotalWasteHigh != 0 {
+		t.Fatal("expected no range totals without CostRanges")
+	}
+}
+
+func TestAnalyze_GroupsWasteByTag(t *testing.T) {
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			{ID: awstype.FindingIdleEC2, ResourceID: "i-1", EstimatedMonthlyWaste: 50, Metadata: map[string]any{"tags": map[string]string{"team": "platform"}}},
+			{ID: awstype.FindingIdleEC2, ResourceID: "i-2", EstimatedMonthlyWaste: 30, Metadata: map[string]any{"tags": map[string]string{"team": "platform", "env": "dev"}}},
+			{ID: awstype.FindingDetachedEBS, ResourceID: "vol-1", EstimatedMonthlyWaste: 8, Metadata: map[string]any{"tags": map[string]any{"team": "data"}}},
+			{ID: awstype.FindingDetachedEBS, ResourceID: "vol-2", EstimatedMonthlyWaste: 4, Metadata: map[string]any{"tags": map[string]string{"env": "prod"}}},
+			{ID: awstype.FindingUnusedEIP, ResourceID: "eipalloc-1", EstimatedMonthlyWaste: 3.6, Metadata: map[string]any{}},
+			{ID: awstype.FindingIdleEC2, ResourceID: "i-3", EstimatedMonthlyWaste: 0.5, Metadata: map[string]any{"tags": map[string]string{"team": "platform"}}},
+		},
+	}
+
+	analysis := Analyze(result, AnalyzerConfig{MinMonthlyCost: 1.0, GroupByTag: "team"})
+	if analysis.Summary.TagKey != "team" {
+		t.Fatalf("expected tag key team, got %q", analysis.Summary.TagKey)
+	}
+	want := map[string]float64{"platform": 80, "data": 8, UntaggedValue: 7.6}
+	if len(analysis.Summary.ByTag) != len(want) {
+		t.Fatalf("expected %v, got %v", want, analysis.Summary.ByTag)
+	}
+	for k, v := range want {
+		if got := analysis.Summary.ByTag[k]; math.Abs(got-v) > 0.001 {
+			t.Fatalf("expected %s = %.2f, got %.2f", k, v, got)
+		}
+	}
+
+	plain := Analyze(result, AnalyzerConfig{MinMonthlyCost: 1.0})
+	if plain.Summary.ByTag != nil || plain.Summary.TagKey != "" {
+		t.Fatal("expected no tag breakdown without GroupByTag")
+	}
+}
+
+func TestAnalyze_SortsByWasteWithStableTieBreak(t *testing.T) {
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			{ID: awstype.FindingUnusedEIP, ResourceType: awstype.ResourceEIP, ResourceID: "eipalloc-1", Region: "us-east-1", EstimatedMonthlyWaste: 3.6},
+			{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-b", Region: "us-east-1", EstimatedMonthlyWaste: 20},
+			{ID: awstype.FindingDetachedEBS, ResourceType: awstype.ResourceEBS, ResourceID: "vol-1", Region: "us-east-1", EstimatedMonthlyWaste: 20},
+			{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-a", Region: "us-east-1", EstimatedMonthlyWaste: 20},
+			{ID: awstype.FindingIdleRDS, ResourceType: awstype.ResourceRDS, ResourceID: "db-1", Region: "eu-west-1", EstimatedMonthlyWaste: 90},
+		},
+	}
+
+	want := []string{"db-1", "vol-1", "i-a", "i-b", "eipalloc-1"}
+	for run := 0; run < 2; run++ {
+		analysis := Analyze(result, AnalyzerConfig{})
+		got := make([]string, 0, len(analysis.Findings))
+		for _, f := range analysis.Findings {
+			got = append(got, f.ResourceID)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("run %d: expected order %v, got %v", run, want, got)
+			}
+		}
+		// Reverse the input so the second run proves the order does not depend on it.
+		for i, j := 0, len(result.Findings)-1; i < j; i, j = i+1, j-1 {
+			result.Findings[i], result.Findings[j] = result.Findings[j], result.Findings[i]
+		}
+	}
+}
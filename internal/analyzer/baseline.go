@@ -0,0 +1,59 @@
+package analyzer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// Fingerprint returns the baseline entry for a finding: region/resource_type/resource_id/id.
+// Resource IDs may themselves contain slashes, so fingerprints are compared whole
+// rather than split.
+func Fingerprint(f awstype.Finding) string {
+	return f.Region + "/" + string(f.ResourceType) + "/" + f.ResourceID + "/" + string(f.ID)
+}
+
+// ParseBaseline reads one fingerprint per line. Blank lines and lines starting
+// with # are ignored.
+func ParseBaseline(r io.Reader) (map[string]bool, error) {
+	baseline := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		baseline[line] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read baseline: %w", err)
+	}
+	return baseline, nil
+}
+
+// WriteBaseline writes the sorted, de-duplicated fingerprints of findings in the
+// format ParseBaseline reads.
+func WriteBaseline(w io.Writer, findings []awstype.Finding) error {
+	seen := make(map[string]bool, len(findings))
+	fingerprints := make([]string, 0, len(findings))
+	for _, f := range findings {
+		fp := Fingerprint(f)
+		if !seen[fp] {
+			seen[fp] = true
+			fingerprints = append(fingerprints, fp)
+		}
+	}
+	sort.Strings(fingerprints)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# awsspectre baseline: findings listed here are suppressed by scan --baseline.")
+	fmt.Fprintln(bw, "# Format: region/resource_type/resource_id/finding_id")
+	for _, fp := range fingerprints {
+		fmt.Fprintln(bw, fp)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+func TestFingerprint(t *testing.T) {
+	f := awstype.Finding{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-abc123", Region: "us-east-1"}
+	if got := Fingerprint(f); got != "us-east-1/ec2/i-abc123/IDLE_EC2" {
+		t.Fatalf("unexpected fingerprint %q", got)
+	}
+}
+
+func TestParseBaseline_RoundTrip(t *testing.T) {
+	findings := []awstype.Finding{
+		{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-2", Region: "us-east-1"},
+		{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-1", Region: "us-east-1"},
+		{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-1", Region: "us-east-1"},
+		// Resource IDs containing slashes still match whole.
+		{ID: awstype.FindingLogsNoRetention, ResourceType: awstype.ResourceLogGroup, ResourceID: "/aws/lambda/fn", Region: "eu-west-1"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBaseline(&buf, findings); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	if !strings.Contains(buf.String(), "us-east-1/ec2/i-1/IDLE_EC2\nus-east-1/ec2/i-2/IDLE_EC2\n") {
+		t.Fatalf("expected sorted fingerprints, got:\n%s", buf.String())
+	}
+
+	baseline, err := ParseBaseline(strings.NewReader(buf.String() + "\n  # trailing comment\n"))
+	if err != nil {
+		t.Fatalf("parse baseline: %v", err)
+	}
+	if len(baseline) != 3 {
+		t.Fatalf("expected 3 fingerprints, got %v", baseline)
+	}
+	for _, f := range findings {
+		if !baseline[Fingerprint(f)] {
+			t.Fatalf("expected %s in baseline", Fingerprint(f))
+		}
+	}
+	other := awstype.Finding{ID: awstype.FindingIdleEC2, ResourceType: awstype.ResourceEC2, ResourceID: "i-1", Region: "us-west-2"}
+	if baseline[Fingerprint(other)] {
+		t.Fatal("expected the same resource in another region not to match")
+	}
+}
+
+func TestAnalyze_SuppressesBaselineFindings(t *testing.T) {
+	accepted := awstype.Finding{ID: awstype.FindingIdleEC2, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEC2, ResourceID: "i-accepted", Region: "us-east-1", EstimatedMonthlyWaste: 80}
+	result := &awstype.ScanResult{
+		Findings: []awstype.Finding{
+			accepted,
+			{ID: awstype.FindingIdleEC2, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEC2, ResourceID: "i-new", Region: "us-east-1", EstimatedMonthlyWaste: 20},
+		},
+	}
+
+	analysis := Analyze(result, AnalyzerConfig{Baseline: map[string]bool{Fingerprint(accepted): true}})
+
+	if len(analysis.Findings) != 1 || analysis.Findings[0].ResourceID != "i-new" {
+		t.Fatalf("expected only i-new, got %+v", analysis.Findings)
+	}
+	if analysis.Summary.Suppressed != 1 {
+		t.Fatalf("expected 1 suppressed, got %d", analysis.Summary.Suppressed)
+	}
+	if analysis.Summary.TotalFindings != 1 || analysis.Summary.TotalMonthlyWaste != 20 {
+		t.Fatalf("expected suppressed finding excluded from totals, got %+v", analysis.Summary)
+	}
+}
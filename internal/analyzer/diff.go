@@ -0,0 +1,101 @@
+package analyzer
+
+import (
+	"math"
+	"sort"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// FindingKey identifies a finding across scans.
+type FindingKey struct {
+	Region       string               `json:"region"`
+	ResourceType awstype.ResourceType `json:"resource_type"`
+	ResourceID   string               `json:"resource_id"`
+	ID           awstype.FindingID    `json:"id"`
+}
+
+// KeyOf returns the key that matches a finding across scans.
+func KeyOf(f awstype.Finding) FindingKey {
+	return FindingKey{Region: f.Region, ResourceType: f.ResourceType, ResourceID: f.ResourceID, ID: f.ID}
+}
+
+// ChangedFinding is a finding present in both scans whose waste or severity changed.
+type ChangedFinding struct {
+	Old awstype.Finding `json:"old"`
+	New awstype.Finding `json:"new"`
+	// WasteDelta is the new minus the old estimated monthly waste.
+	WasteDelta float64 `json:"waste_delta"`
+}
+
+// FindingsDiff compares the findings of two scans.
+type FindingsDiff struct {
+	Added     []awstype.Finding `json:"added"`
+	Removed   []awstype.Finding `json:"removed"`
+	Changed   []ChangedFinding  `json:"changed"`
+	Unchanged int               `json:"unchanged"`
+	OldWaste  float64           `json:"old_total_monthly_waste"`
+	NewWaste  float64           `json:"new_total_monthly_waste"`
+	// NetChange is the new minus the old total monthly waste; negative means less waste.
+	NetChange float64 `json:"net_change"`
+}
+
+// DiffFindings compares two scans' findings by FindingKey. A finding present in both
+// is changed when its severity or its waste (to the cent) differs. Results are sorted
+// by key so output is stable.
+func DiffFindings(oldFindings, newFindings []awstype.Finding) FindingsDiff {
+	d := FindingsDiff{Added: []awstype.Finding{}, Removed: []awstype.Finding{}, Changed: []ChangedFinding{}}
+
+	previous := make(map[FindingKey]awstype.Finding, len(oldFindings))
+	for _, f := range oldFindings {
+		previous[KeyOf(f)] = f
+		d.OldWaste += f.EstimatedMonthlyWaste
+	}
+	seen := make(map[FindingKey]bool, len(newFindings))
+	for _, f := range newFindings {
+		d.NewWaste += f.EstimatedMonthlyWaste
+		key := KeyOf(f)
+		seen[key] = true
+		old, ok := previous[key]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, f)
+		case old.Severity != f.Severity || cents(old.EstimatedMonthlyWaste) != cents(f.EstimatedMonthlyWaste):
+			d.Changed = append(d.Changed, ChangedFinding{Old: old, New: f, WasteDelta: f.EstimatedMonthlyWaste - old.EstimatedMonthlyWaste})
+		default:
+			d.Unchanged++
+		}
+	}
+	for _, f := range oldFindings {
+		if !seen[KeyOf(f)] {
+			d.Removed = append(d.Removed, f)
+		}
+	}
+	d.NetChange = d.NewWaste - d.OldWaste
+
+	sortFindings(d.Added)
+	sortFindings(d.Removed)
+	sort.SliceStable(d.Changed, func(i, j int) bool { return keyLess(KeyOf(d.Changed[i].New), KeyOf(d.Changed[j].New)) })
+	return d
+}
+
+func cents(v float64) int64 {
+	return int64(math.Round(v * 100))
+}
+
+func sortFindings(findings []awstype.Finding) {
+	sort.SliceStable(findings, func(i, j int) bool { return keyLess(KeyOf(findings[i]), KeyOf(findings[j])) })
+}
+
+func keyLess(a, b FindingKey) bool {
+	if a.Region != b.Region {
+		return a.Region < b.Region
+	}
+	if a.ResourceType != b.ResourceType {
+		return a.ResourceType < b.ResourceType
+	}
+	if a.ResourceID != b.ResourceID {
+		return a.ResourceID < b.ResourceID
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,70 @@
+package analyzer
+
+import (
+	"testing"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+func diffFinding(id awstype.FindingID, resourceID string, waste float64) awstype.Finding {
+	return awstype.Finding{
+		ID:                    id,
+		Severity:              awstype.SeverityHigh,
+		ResourceType:          awstype.ResourceEC2,
+		ResourceID:            resourceID,
+		Region:                "us-east-1",
+		EstimatedMonthlyWaste: waste,
+	}
+}
+
+func TestDiffFindings(t *testing.T) {
+	oldFindings := []awstype.Finding{
+		diffFinding(awstype.FindingIdleEC2, "i-same", 50),
+		diffFinding(awstype.FindingIdleEC2, "i-removed", 30),
+		diffFinding(awstype.FindingIdleEC2, "i-cost", 20),
+	}
+	newFindings := []awstype.Finding{
+		diffFinding(awstype.FindingIdleEC2, "i-same", 50.001),
+		diffFinding(awstype.FindingIdleEC2, "i-cost", 35),
+		diffFinding(awstype.FindingIdleEC2, "i-added", 10),
+		// Same resource, different finding ID: a new finding, not a change.
+		diffFinding(awstype.FindingStoppedEC2, "i-same", 5),
+	}
+
+	d := DiffFindings(oldFindings, newFindings)
+
+	if d.Unchanged != 1 {
+		t.Fatalf("expected 1 unchanged finding, got %d", d.Unchanged)
+	}
+	if len(d.Added) != 2 || d.Added[0].ResourceID != "i-added" || d.Added[1].ID != awstype.FindingStoppedEC2 {
+		t.Fatalf("expected i-added and STOPPED_EC2 i-same added, got %+v", d.Added)
+	}
+	if len(d.Removed) != 1 || d.Removed[0].ResourceID != "i-removed" {
+		t.Fatalf("expected i-removed removed, got %+v", d.Removed)
+	}
+	if len(d.Changed) != 1 || d.Changed[0].New.ResourceID != "i-cost" || d.Changed[0].WasteDelta != 15 {
+		t.Fatalf("expected i-cost changed by +$15, got %+v", d.Changed)
+	}
+	// 100.001 new - 100 old
+	if d.OldWaste != 100 || d.NetChange < -0.01 || d.NetChange > 0.01 {
+		t.Fatalf("expected $100 old waste and ~$0 net change, got $%.2f and $%.2f", d.OldWaste, d.NetChange)
+	}
+}
+
+func TestDiffFindings_SeverityChange(t *testing.T) {
+	oldF := diffFinding(awstype.FindingIdleEC2, "i-1", 10)
+	newF := oldF
+	newF.Severity = awstype.SeverityMedium
+
+	d := DiffFindings([]awstype.Finding{oldF}, []awstype.Finding{newF})
+	if len(d.Changed) != 1 || d.Changed[0].WasteDelta != 0 {
+		t.Fatalf("expected a severity-only change, got %+v", d)
+	}
+}
+
+func TestDiffFindings_Empty(t *testing.T) {
+	d := DiffFindings(nil, nil)
+	if len(d.Added) != 0 || len(d.Removed) != 0 || len(d.Changed) != 0 || d.Unchanged != 0 || d.NetChange != 0 {
+		t.Fatalf("expected an empty diff, got %+v", d)
+	}
+}
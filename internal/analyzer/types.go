@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	awstype "github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 // Summary holds aggregated statistics about scan findings.
@@ -9,9 +10,19 @@ type Summary struct {
 	TotalResourcesScanned int            `json:"total_resources_scanned"`
 	TotalFindings         int            `json:"total_findings"`
 	TotalMonthlyWaste     float64        `json:"total_monthly_waste"`
+	TotalWasteLow         float64        `json:"total_waste_low,omitempty"`
+	TotalWasteHigh        float64        `json:"total_waste_high,omitempty"`
 	BySeverity            map[string]int `json:"by_severity"`
 	ByResourceType        map[string]int `json:"by_resource_type"`
 	RegionsScanned        int            `json:"regions_scanned"`
+	// Suppressed counts findings hidden because they are listed in the baseline.
+	Suppressed int `json:"suppressed,omitempty"`
+	// TagKey is the tag ByTag groups waste by; ByTag maps each of its values, or
+	// UntaggedValue, to estimated monthly waste. Both are empty unless GroupByTag is set.
+	TagKey string             `json:"tag_key,omitempty"`
+	ByTag  map[string]float64 `json:"by_tag,omitempty"`
+	// PricingCoverage lists, per resource type, how many observed types had pricing data.
+	PricingCoverage []pricing.TypeCoverage `json:"pricing_coverage,omitempty"`
 }
 
 // AnalysisResult holds filtered findings and computed summary.
@@ -24,4 +35,16 @@ type AnalysisResult struct {
 // AnalyzerConfig controls analysis behavior.
 type AnalyzerConfig struct {
 	MinMonthlyCost float64
+	// MinMonthlyCostByType replaces MinMonthlyCost for findings of the listed resource types.
+	MinMonthlyCostByType map[awstype.ResourceType]float64
+	CostRanges           bool
+	GroupByTag           string
+	// SeverityOverrides replaces the scanner-assigned severity of findings by ID.
+	SeverityOverrides map[awstype.FindingID]awstype.Severity
+	// Baseline holds the Fingerprint of accepted findings, which are suppressed.
+	Baseline map[string]bool
 }
+
+// UntaggedValue is the ByTag key for findings without the GroupByTag tag, including
+// findings from scanners that do not read tags.
+const UntaggedValue = "(untagged)"
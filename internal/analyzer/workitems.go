@@ -0,0 +1,334 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// WorkItem groups linked findings into a single cleanup task. TotalMonthlySavings
+// counts only resources that are removed; recommendations that keep a resource and
+// change it are listed as Modify steps and summed in ModifyMonthlySavings.
+type WorkItem struct {
+	Title                string             `json:"title"`
+	Region               string             `json:"region"`
+	Resources            []WorkItemResource `json:"resources"`
+	TotalMonthlySavings  float64            `json:"total_monthly_savings"`
+	ModifyMonthlySavings float64            `json:"modify_monthly_savings,omitempty"`
+	TeardownSteps        []string           `json:"teardown_steps"`
+}
+
+// WorkItemResource is one resource inside a work item, listed in teardown order.
+type WorkItemResource struct {
+	FindingID             awstype.FindingID    `json:"finding_id"`
+	ResourceType          awstype.ResourceType `json:"resource_type"`
+	ResourceID            string               `json:"resource_id"`
+	ResourceName          string               `json:"resource_name,omitempty"`
+	EstimatedMonthlyWaste float64              `json:"estimated_monthly_waste"`
+}
+
+// workItemTagKeys are tag keys whose shared value places findings in the same work item.
+var workItemTagKeys = []string{"app", "App", "Application", "Service"}
+
+// teardownRank orders resource types so dependents are removed before their dependencies.
+var teardownRank = map[awstype.ResourceType]int{
+	awstype.ResourceALB:            10,
+	awstype.ResourceNLB:            10,
+	awstype.ResourceGWLB:           10,
+	awstype.ResourceEC2:            20,
+	awstype.ResourceRDS:            20,
+	awstype.ResourceLambda:         20,
+	awstype.ResourceNATGateway:     30,
+	awstype.ResourceEIP:            40,
+	awstype.ResourceEBS:            50,
+	awstype.ResourceSnapshot:       60,
+	awstype.ResourceTGWAttachment:  65,
+	awstype.ResourceTransitGateway: 70,
+	awstype.ResourceSecurityGroup:  90,
+}
+
+// modifyFindings are recommendations that keep the resource and change its type,
+// size, storage, or settings. They never become teardown steps.
+var modifyFindings = map[awstype.FindingID]bool{
+	awstype.FindingRightsizeEC2:              true,
+	awstype.FindingOldGenerationEC2:          true,
+	awstype.FindingEC2SpotCandidate:          true,
+	awstype.FindingEBSGp2ToGp3:               true,
+	awstype.FindingEBSOverProvisionedIOPS:    true,
+	awstype.FindingRDSStorageOverProvisioned: true,
+	awstype.FindingRDSUnnecessaryMonitoring:  true,
+	awstype.FindingLambdaOverProvisioned:     true,
+	awstype.FindingLambdaIdleConcurrency:     true,
+	awstype.FindingKinesisOverProvisioned:    true,
+	awstype.FindingDynamoDBOverProvisioned:   true,
+	awstype.FindingRedshiftPauseable:         true,
+	awstype.FindingS3NoLifecycle:             true,
+	awstype.FindingS3IncompleteMultipart:     true,
+	awstype.FindingS3StorageClassOpportunity: true,
+	awstype.FindingEFSNoLifecycle:            true,
+	awstype.FindingAPIGWUnusedCache:          true,
+	awstype.FindingAppSyncUnusedCache:        true,
+	awstype.FindingLogsNoRetention:           true,
+	awstype.FindingPublicIPv4Unneeded:        true,
+	awstype.FindingECRStaleImages:            true,
+}
+
+// teardownVerb describes the cleanup action for each resource type.
+var teardownVerb = map[awstype.ResourceType]string{
+	awstype.ResourceEC2:           "Terminate",
+	awstype.ResourceEIP:           "Release",
+	awstype.ResourceSecurityGroup: "Delete",
+}
+
+// BuildWorkItems merges findings linked by related resource IDs or shared tags into
+// cleanup work items. Findings with no links become single-resource work items.
+// Items are returned in descending order of total savings.
+func BuildWorkItems(findings []awstype.Finding) []WorkItem {
+	if len(findings) == 0 {
+		return nil
+	}
+
+	uf := newUnionFind(len(findings))
+	byKey := make(map[string]int, len(findings))
+	link := func(i int, key string) {
+		if j, ok := byKey[key]; ok {
+			uf.union(i, j)
+			return
+		}
+		byKey[key] = i
+	}
+
+	for i, f := range findings {
+		link(i, "id:"+f.Region+"/"+f.ResourceID)
+	}
+	for i, f := range findings {
+		for _, ref := range relatedResourceIDs(f) {
+			link(i, "id:"+f.Region+"/"+ref)
+		}
+		tags, _ := f.Metadata["tags"].(map[string]string)
+		for _, k := range workItemTagKeys {
+			if v := tags[k]; v != "" {
+				link(i, "tag:"+f.Region+"/"+k+"="+v)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	var roots []int
+	for i := range findings {
+		r := uf.find(i)
+		if _, ok := groups[r]; !ok {
+			roots = append(roots, r)
+		}
+		groups[r] = append(groups[r], i)
+	}
+
+	items := make([]WorkItem, 0, len(roots))
+	for _, r := range roots {
+		items = append(items, newWorkItem(findings, groups[r]))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].TotalMonthlySavings != items[j].TotalMonthlySavings {
+			return items[i].TotalMonthlySavings > items[j].TotalMonthlySavings
+		}
+		return items[i].ModifyMonthlySavings > items[j].ModifyMonthlySavings
+	})
+	return items
+}
+
+// newWorkItem lists the removed resources of a group in teardown order, then the
+// resources to modify. Each resource is listed and counted once, at its largest
+// saving, and a modification of a resource that is also removed is dropped.
+func newWorkItem(findings []awstype.Finding, members []int) WorkItem {
+	sort.SliceStable(members, func(a, b int) bool {
+		return rankOf(findings[members[a]].ResourceType) < rankOf(findings[members[b]].ResourceType)
+	})
+
+	removed := make(map[string]bool)
+	for _, idx := range members {
+		f := findings[idx]
+		if modifyFindings[f.ID] {
+			continue
+		}
+		removed[f.ResourceID] = true
+		for _, id := range attachedVolumeIDs(f) {
+			removed[id] = true
+		}
+	}
+
+	item := WorkItem{Region: findings[members[0]].Region}
+	var teardown, modify []WorkItemResource
+	teardown = addWorkItemResources(teardown, findings, members, func(f awstype.Finding) bool { return !modifyFindings[f.ID] })
+	modify = addWorkItemResources(modify, findings, members, func(f awstype.Finding) bool { return modifyFindings[f.ID] && !removed[f.ResourceID] })
+	item.Resources = append(teardown, modify...)
+	for _, res := range teardown {
+		item.TotalMonthlySavings += res.EstimatedMonthlyWaste
+	}
+	for _, res := range modify {
+		item.ModifyMonthlySavings += res.EstimatedMonthlyWaste
+	}
+
+	for i, res := range item.Resources {
+		step := fmt.Sprintf("%d. %s %s %s", i+1, verbOf(res.ResourceType), strings.ToUpper(string(res.ResourceType)), res.ResourceID)
+		if i >= len(teardown) {
+			step = fmt.Sprintf("%d. Modify %s %s (%s)", i+1, strings.ToUpper(string(res.ResourceType)), res.ResourceID, res.FindingID)
+		}
+		item.TeardownSteps = append(item.TeardownSteps, step)
+	}
+
+	subject := workItemSubject(findings, members)
+	if len(teardown) == 0 {
+		item.Title = fmt.Sprintf("Modify %s: %s = $%.2f/month", subject, countByType(modify), item.ModifyMonthlySavings)
+		return item
+	}
+	item.Title = fmt.Sprintf("Teardown %s: %s = $%.2f/month", subject, countByType(teardown), item.TotalMonthlySavings)
+	if len(modify) > 0 {
+		item.Title += fmt.Sprintf(", modify %s = $%.2f/month", countByType(modify), item.ModifyMonthlySavings)
+	}
+	return item
+}
+
+// addWorkItemResources appends the members matching keep to resources, one entry per
+// resource ID carrying its largest saving.
+func addWorkItemResources(resources []WorkItemResource, findings []awstype.Finding, members []int, keep func(awstype.Finding) bool) []WorkItemResource {
+	index := make(map[string]int)
+	for _, idx := range members {
+		f := findings[idx]
+		if !keep(f) {
+			continue
+		}
+		res := WorkItemResource{
+			FindingID:             f.ID,
+			ResourceType:          f.ResourceType,
+			ResourceID:            f.ResourceID,
+			ResourceName:          f.ResourceName,
+			EstimatedMonthlyWaste: f.EstimatedMonthlyWaste,
+		}
+		if i, ok := index[f.ResourceID]; ok {
+			if res.EstimatedMonthlyWaste > resources[i].EstimatedMonthlyWaste {
+				resources[i] = res
+			}
+			continue
+		}
+		index[f.ResourceID] = len(resources)
+		resources = append(resources, res)
+	}
+	return resources
+}
+
+// countByType summarizes resources as e.g. "1 EC2 + 2 EBS", in first-seen order.
+func countByType(resources []WorkItemResource) string {
+	counts := make(map[awstype.ResourceType]int)
+	var typeOrder []awstype.ResourceType
+	for _, res := range resources {
+		if counts[res.ResourceType] == 0 {
+			typeOrder = append(typeOrder, res.ResourceType)
+		}
+		counts[res.ResourceType]++
+	}
+	parts := make([]string, 0, len(typeOrder))
+	for _, rt := range typeOrder {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[rt], strings.ToUpper(string(rt))))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// attachedVolumeIDs returns the volumes a finding's cost already includes, such as
+// the EBS volumes of a stopped instance.
+func attachedVolumeIDs(f awstype.Finding) []string {
+	var ids []string
+	vols, _ := f.Metadata["attached_volumes"].([]map[string]any)
+	for _, vol := range vols {
+		if s, ok := vol["volume_id"].(string); ok && s != "" {
+			ids = append(ids, s)
+		}
+	}
+	return ids
+}
+
+// relatedResourceIDs returns resource IDs a finding references through its metadata.
+func relatedResourceIDs(f awstype.Finding) []string {
+	var ids []string
+	switch v := f.Metadata["related_resources"].(type) {
+	case []string:
+		ids = append(ids, v...)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				ids = append(ids, s)
+			}
+		}
+	}
+	for _, key := range []string{"instance_id", "volume_id"} {
+		if s, ok := f.Metadata[key].(string); ok && s != "" {
+			ids = append(ids, s)
+		}
+	}
+	return append(ids, attachedVolumeIDs(f)...)
+}
+
+// workItemSubject names a work item after a shared tag value or its first resource.
+func workItemSubject(findings []awstype.Finding, members []int) string {
+	for _, k := range workItemTagKeys {
+		for _, idx := range members {
+			tags, _ := findings[idx].Metadata["tags"].(map[string]string)
+			if v := tags[k]; v != "" {
+				return v
+			}
+		}
+	}
+	first := findings[members[0]]
+	if first.ResourceName != "" {
+		return first.ResourceName
+	}
+	return first.ResourceID
+}
+
+func rankOf(rt awstype.ResourceType) int {
+	if r, ok := teardownRank[rt]; ok {
+		return r
+	}
+	return 80
+}
+
+func verbOf(rt awstype.ResourceType) string {
+	if v, ok := teardownVerb[rt]; ok {
+		return v
+	}
+	return "Delete"
+}
+
+// unionFind is a minimal disjoint-set used to merge linked findings.
+type unionFind struct {
+	parent []int
+}
+
+func newUnionFind(n int) *unionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &unionFind{parent: parent}
+}
+
+func (u *unionFind) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+func (u *unionFind) union(a, b int) {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return
+	}
+	if rb < ra {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+}
@@ -0,0 +1,275 @@
+package analyzer
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// fakeEC2 serves canned instances, volumes, and addresses to the EC2, EBS, and EIP scanners.
+type fakeEC2 struct {
+	instances []ec2types.Instance
+	volumes   []ec2types.Volume
+	addresses []ec2types.Address
+}
+
+func (f *fakeEC2) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{{Instances: f.instances}}}, nil
+}
+
+func (f *fakeEC2) DescribeVolumes(_ context.Context, _ *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	return &ec2.DescribeVolumesOutput{Volumes: f.volumes}, nil
+}
+
+func (f *fakeEC2) DescribeReservedInstances(_ context.Context, _ *ec2.DescribeReservedInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeSpotPriceHistory(_ context.Context, _ *ec2.DescribeSpotPriceHistoryInput, _ ...func(*ec2.Options)) (*ec2.DescribeSpotPriceHistoryOutput, error) {
+	return &ec2.DescribeSpotPriceHistoryOutput{}, nil
+}
+
+func (f *fakeEC2) LookupEvents(_ context.Context, _ *cloudtrail.LookupEventsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	return &cloudtrail.LookupEventsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeAddresses(_ context.Context, _ *ec2.DescribeAddressesInput, _ ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	return &ec2.DescribeAddressesOutput{Addresses: f.addresses}, nil
+}
+
+func TestBuildWorkItems_LinkedFindingsCollapse(t *testing.T) {
+	findings := []awstype.Finding{
+		{
+			ID:                    awstype.FindingUnusedEIP,
+			ResourceType:          awstype.ResourceEIP,
+			ResourceID:            "eipalloc-1",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 3.65,
+			Metadata:              map[string]any{"related_resources": []string{"i-1"}},
+		},
+		{
+			ID:                    awstype.FindingDetachedEBS,
+			ResourceType:          awstype.ResourceEBS,
+			ResourceID:            "vol-1",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 8,
+		},
+		{
+			ID:                    awstype.FindingStoppedEC2,
+			ResourceType:          awstype.ResourceEC2,
+			ResourceID:            "i-1",
+			ResourceName:          "app-x",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 10,
+			Metadata: map[string]any{
+				"attached_volumes": []map[string]any{{"volume_id": "vol-1"}},
+			},
+		},
+		{
+			ID:                    awstype.FindingIdleNATGateway,
+			ResourceType:          awstype.ResourceNATGateway,
+			ResourceID:            "nat-unrelated",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 32.85,
+		},
+	}
+
+	items := BuildWorkItems(findings)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 work items, got %d", len(items))
+	}
+
+	var item WorkItem
+	for _, it := range items {
+		if len(it.Resources) == 3 {
+			item = it
+		}
+	}
+	if item.Resources == nil {
+		t.Fatalf("expected a 3-resource work item, got %+v", items)
+	}
+
+	if math.Abs(item.TotalMonthlySavings-21.65) > 0.001 {
+		t.Fatalf("expected summed savings 21.65, got %f", item.TotalMonthlySavings)
+	}
+
+	wantOrder := []string{"i-1", "eipalloc-1", "vol-1"}
+	for i, id := range wantOrder {
+		if item.Resources[i].ResourceID != id {
+			t.Fatalf("expected teardown position %d to be %s, got %s", i, id, item.Resources[i].ResourceID)
+		}
+	}
+	if !strings.HasPrefix(item.TeardownSteps[0], "1. Terminate EC2 i-1") {
+		t.Fatalf("unexpected first teardown step %q", item.TeardownSteps[0])
+	}
+	if !strings.Contains(item.Title, "app-x") || !strings.Contains(item.Title, "1 EC2 + 1 EIP + 1 EBS") {
+		t.Fatalf("unexpected work item title %q", item.Title)
+	}
+}
+
+func TestBuildWorkItems_SharedTagGroups(t *testing.T) {
+	tags := map[string]string{"app": "billing"}
+	findings := []awstype.Finding{
+		{ResourceType: awstype.ResourceEC2, ResourceID: "i-1", Region: "us-east-1", EstimatedMonthlyWaste: 5, Metadata: map[string]any{"tags": tags}},
+		{ResourceType: awstype.ResourceALB, ResourceID: "arn:lb", Region: "us-east-1", EstimatedMonthlyWaste: 16, Metadata: map[string]any{"tags": tags}},
+		{ResourceType: awstype.ResourceEC2, ResourceID: "i-2", Region: "eu-west-1", EstimatedMonthlyWaste: 5, Metadata: map[string]any{"tags": tags}},
+	}
+
+	items := BuildWorkItems(findings)
+	if len(items) != 2 {
+		t.Fatalf("expected tag grouping to stay within a region, got %d items", len(items))
+	}
+	if items[0].Resources[0].ResourceType != awstype.ResourceALB {
+		t.Fatalf("expected load balancer to be torn down first, got %s", items[0].Resources[0].ResourceType)
+	}
+	if !strings.HasPrefix(items[0].Title, "Teardown billing:") {
+		t.Fatalf("expected title to use shared tag value, got %q", items[0].Title)
+	}
+}
+
+func TestBuildWorkItems_ScannerOutputGroupsByTag(t *testing.T) {
+	appTag := []ec2types.Tag{{Key: awssdk.String("app"), Value: awssdk.String("checkout")}}
+	longAgo := time.Now().Add(-60 * 24 * time.Hour)
+	client := &fakeEC2{
+		instances: []ec2types.Instance{{
+			InstanceId:   awssdk.String("i-1"),
+			InstanceType: ec2types.InstanceTypeT3Micro,
+			State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameStopped},
+			LaunchTime:   awssdk.Time(longAgo),
+			Tags:         appTag,
+		}},
+		volumes: []ec2types.Volume{{
+			VolumeId:   awssdk.String("vol-1"),
+			VolumeType: ec2types.VolumeTypeGp3,
+			Size:       awssdk.Int32(100),
+			CreateTime: awssdk.Time(longAgo),
+			Tags:       appTag,
+		}},
+		addresses: []ec2types.Address{{
+			AllocationId: awssdk.String("eipalloc-1"),
+			PublicIp:     awssdk.String("203.0.113.10"),
+			Tags:         appTag,
+		}},
+	}
+
+	cfg := awstype.ScanConfig{StoppedThresholdDays: 30}
+	scanners := []awstype.ResourceScanner{
+		awstype.NewEC2Scanner(client, awstype.NewMetricsFetcher(nil), "us-east-1"),
+		awstype.NewEBSScanner(client, client, awstype.NewMetricsFetcher(nil), "us-east-1"),
+		awstype.NewEIPScanner(client, "us-east-1"),
+	}
+	var findings []awstype.Finding
+	for _, s := range scanners {
+		result, err := s.Scan(context.Background(), cfg)
+		if err != nil {
+			t.Fatalf("%s scan: %v", s.Type(), err)
+		}
+		findings = append(findings, result.Findings...)
+	}
+	if len(findings) != 3 {
+		t.Fatalf("expected 3 findings from scanners, got %d", len(findings))
+	}
+
+	items := BuildWorkItems(findings)
+	if len(items) != 1 {
+		t.Fatalf("expected tagged findings to form 1 work item, got %d: %+v", len(items), items)
+	}
+	wantOrder := []string{"i-1", "eipalloc-1", "vol-1"}
+	for i, id := range wantOrder {
+		if items[0].Resources[i].ResourceID != id {
+			t.Fatalf("expected teardown position %d to be %s, got %s", i, id, items[0].Resources[i].ResourceID)
+		}
+	}
+	if !strings.HasPrefix(items[0].Title, "Teardown checkout:") {
+		t.Fatalf("expected title to use shared tag value, got %q", items[0].Title)
+	}
+}
+
+func TestBuildWorkItems_ModifyRecommendations(t *testing.T) {
+	findings := []awstype.Finding{
+		{
+			ID:                    awstype.FindingStoppedEC2,
+			ResourceType:          awstype.ResourceEC2,
+			ResourceID:            "i-stopped",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 20,
+			Metadata: map[string]any{
+				"attached_volumes": []map[string]any{{"volume_id": "vol-stopped"}},
+			},
+		},
+		// Already counted in the stopped instance's cost, which removes the volume.
+		{
+			ID:                    awstype.FindingEBSGp2ToGp3,
+			ResourceType:          awstype.ResourceEBS,
+			ResourceID:            "vol-stopped",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 2,
+			Metadata:              map[string]any{"instance_id": "i-stopped"},
+		},
+		{
+			ID:                    awstype.FindingRightsizeEC2,
+			ResourceType:          awstype.ResourceEC2,
+			ResourceID:            "i-busy",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 70,
+		},
+		{
+			ID:                    awstype.FindingEC2SpotCandidate,
+			ResourceType:          awstype.ResourceEC2,
+			ResourceID:            "i-busy",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 50,
+		},
+		{
+			ID:                    awstype.FindingEBSGp2ToGp3,
+			ResourceType:          awstype.ResourceEBS,
+			ResourceID:            "vol-busy",
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: 4,
+			Metadata:              map[string]any{"instance_id": "i-busy"},
+		},
+	}
+
+	items := BuildWorkItems(findings)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 work items, got %d: %+v", len(items), items)
+	}
+
+	stopped := items[0]
+	if stopped.TotalMonthlySavings != 20 || stopped.ModifyMonthlySavings != 0 {
+		t.Fatalf("expected $20 teardown and no modify savings, got %+v", stopped)
+	}
+	if len(stopped.TeardownSteps) != 1 || stopped.TeardownSteps[0] != "1. Terminate EC2 i-stopped" {
+		t.Fatalf("expected only the instance teardown, got %v", stopped.TeardownSteps)
+	}
+
+	busy := items[1]
+	if busy.TotalMonthlySavings != 0 {
+		t.Fatalf("expected no teardown savings for modify-only work, got %f", busy.TotalMonthlySavings)
+	}
+	// i-busy counts once, at its larger saving.
+	if busy.ModifyMonthlySavings != 74 {
+		t.Fatalf("expected $74 modify savings, got %f", busy.ModifyMonthlySavings)
+	}
+	want := []string{"1. Modify EC2 i-busy (RIGHTSIZE_EC2)", "2. Modify EBS vol-busy (EBS_GP2_TO_GP3)"}
+	if strings.Join(busy.TeardownSteps, "|") != strings.Join(want, "|") {
+		t.Fatalf("expected steps %v, got %v", want, busy.TeardownSteps)
+	}
+	if !strings.HasPrefix(busy.Title, "Modify ") {
+		t.Fatalf("expected a Modify title, got %q", busy.Title)
+	}
+}
+
+func TestBuildWorkItems_Empty(t *testing.T) {
+	if items := BuildWorkItems(nil); items != nil {
+		t.Fatalf("expected nil work items, got %v", items)
+	}
+}
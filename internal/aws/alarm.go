@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// AlarmAPI is the minimal interface for CloudWatch alarm operations.
+type AlarmAPI interface {
+	DescribeAlarms(ctx context.Context, input *cloudwatch.DescribeAlarmsInput, opts ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error)
+}
+
+// AlarmEC2API is the minimal interface for checking EC2 resources referenced by alarms.
+type AlarmEC2API interface {
+	DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+}
+
+// AlarmRDSAPI is the minimal interface for checking RDS resources referenced by alarms.
+type AlarmRDSAPI interface {
+	DescribeDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// alarmResourceNamespaces are the metric namespaces whose InstanceId, VolumeId, and
+// DBInstanceIdentifier dimensions name EC2 instances, EBS volumes, and RDS instances.
+// Other namespaces (OpsWorks, custom metrics) reuse those dimension names for their own IDs.
+var alarmResourceNamespaces = map[string]bool{
+	"AWS/EC2": true,
+	"CWAgent": true,
+	"AWS/EBS": true,
+	"AWS/RDS": true,
+}
+
+// AlarmScanner detects metric alarms whose dimensions reference deleted resources.
+// Only InstanceId, VolumeId, and DBInstanceIdentifier dimensions are checked.
+type AlarmScanner struct {
+	client    AlarmAPI
+	ec2Client AlarmEC2API
+	rdsClient AlarmRDSAPI
+	region    string
+}
+
+// NewAlarmScanner creates a scanner for CloudWatch metric alarms.
+func NewAlarmScanner(client AlarmAPI, ec2Client AlarmEC2API, rdsClient AlarmRDSAPI, region string) *AlarmScanner {
+	return &AlarmScanner{client: client, ec2Client: ec2Client, rdsClient: rdsClient, region: region}
+}
+
+// Type returns the resource type.
+func (s *AlarmScanner) Type() ResourceType {
+	return ResourceAlarm
+}
+
+// Scan examines single-metric alarms for references to resources that no longer exist.
+func (s *AlarmScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	alarms, err := s.listMetricAlarms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list CloudWatch alarms: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(alarms)}
+
+	// Existence sets are loaded lazily, keyed by dimension name. A nil set means
+	// the lookup failed and alarms on that dimension are not judged.
+	existing := make(map[string]map[string]bool)
+	lookup := map[string]func(context.Context) (map[string]bool, error){
+		"InstanceId":           s.liveInstances,
+		"VolumeId":             s.liveVolumes,
+		"DBInstanceIdentifier": s.liveDBInstances,
+	}
+
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	for _, alarm := range alarms {
+		name := deref(alarm.AlarmName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(alarm.AlarmArn), nil) {
+			continue
+		}
+
+		// A new alarm may be waiting on its first datapoint rather than on a deleted resource.
+		if alarm.StateValue == cwtypes.StateValueInsufficientData &&
+			alarm.AlarmConfigurationUpdatedTimestamp != nil && alarm.AlarmConfigurationUpdatedTimestamp.After(cutoff) {
+			continue
+		}
+
+		if !alarmResourceNamespaces[deref(alarm.Namespace)] {
+			continue
+		}
+
+		var missing []string
+		for _, dim := range alarm.Dimensions {
+			dimName := deref(dim.Name)
+			load, ok := lookup[dimName]
+			if !ok {
+				continue
+			}
+			set, loaded := existing[dimName]
+			if !loaded {
+				set, err = load(ctx)
+				if err != nil {
+					slog.Warn("Failed to list resources referenced by alarms", "dimension", dimName, "region", s.region, "error", err)
+					set = nil
+				}
+				existing[dimName] = set
+			}
+			if set != nil && !set[deref(dim.Value)] {
+				missing = append(missing, dimName+"="+deref(dim.Value))
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingOrphanedAlarm,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceAlarm,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Alarm references deleted resource %s", strings.Join(missing, ", ")),
+			EstimatedMonthlyWaste: pricing.MonthlyAlarmCost(),
+			Metadata: map[string]any{
+				"namespace":          deref(alarm.Namespace),
+				"metric_name":        deref(alarm.MetricName),
+				"dimensions":         alarmDimensions(alarm.Dimensions),
+				"missing_dimensions": missing,
+				"state":              string(alarm.StateValue),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// alarmDimensions renders an alarm's dimensions as sorted Name=Value strings.
+func alarmDimensions(dims []cwtypes.Dimension) []string {
+	out := make([]string, 0, len(dims))
+	for _, d := range dims {
+		out = append(out, deref(d.Name)+"="+deref(d.Value))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// liveInstances returns the IDs of EC2 instances that have not been terminated.
+func (s *AlarmScanner) liveInstances(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range page.Reservations {
+			for _, inst := range res.Instances {
+				if inst.State != nil && (inst.State.Name == ec2types.InstanceStateNameTerminated || inst.State.Name == ec2types.InstanceStateNameShuttingDown) {
+					continue
+				}
+				ids[deref(inst.InstanceId)] = true
+			}
+		}
+	}
+	return ids, nil
+}
+
+// liveVolumes returns the IDs of all EBS volumes.
+func (s *AlarmScanner) liveVolumes(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := ec2.NewDescribeVolumesPaginator(s.ec2Client, &ec2.DescribeVolumesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, vol := range page.Volumes {
+			ids[deref(vol.VolumeId)] = true
+		}
+	}
+	return ids, nil
+}
+
+// liveDBInstances returns the identifiers of all RDS DB instances.
+func (s *AlarmScanner) liveDBInstances(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := rds.NewDescribeDBInstancesPaginator(s.rdsClient, &rds.DescribeDBInstancesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range page.DBInstances {
+			ids[deref(inst.DBInstanceIdentifier)] = true
+		}
+	}
+	return ids, nil
+}
+
+func (s *AlarmScanner) listMetricAlarms(ctx context.Context) ([]cwtypes.MetricAlarm, error) {
+	var alarms []cwtypes.MetricAlarm
+	paginator := cloudwatch.NewDescribeAlarmsPaginator(s.client, &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []cwtypes.AlarmType{cwtypes.AlarmTypeMetricAlarm},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		alarms = append(alarms, page.MetricAlarms...)
+	}
+	return alarms, nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+type mockAlarmClient struct {
+	alarms []cwtypes.MetricAlarm
+}
+
+func (m *mockAlarmClient) DescribeAlarms(_ context.Context, _ *cloudwatch.DescribeAlarmsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+	return &cloudwatch.DescribeAlarmsOutput{MetricAlarms: m.alarms}, nil
+}
+
+func metricAlarm(name, dimName, dimValue string, state cwtypes.StateValue, updated time.Time) cwtypes.MetricAlarm {
+	return cwtypes.MetricAlarm{
+		AlarmName:                          awssdk.String(name),
+		Namespace:                          awssdk.String("AWS/EC2"),
+		MetricName:                         awssdk.String("CPUUtilization"),
+		Dimensions:                         []cwtypes.Dimension{{Name: awssdk.String(dimName), Value: awssdk.String(dimValue)}},
+		StateValue:                         state,
+		AlarmConfigurationUpdatedTimestamp: awssdk.Time(updated),
+	}
+}
+
+func TestAlarmScanner_OrphanedAlarms(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	alarmClient := &mockAlarmClient{
+		alarms: []cwtypes.MetricAlarm{
+			metricAlarm("cpu-gone", "InstanceId", "i-deleted", cwtypes.StateValueInsufficientData, old),
+			metricAlarm("cpu-live", "InstanceId", "i-live", cwtypes.StateValueOk, old),
+			metricAlarm("cpu-terminated", "InstanceId", "i-terminated", cwtypes.StateValueInsufficientData, old),
+			metricAlarm("cpu-new", "InstanceId", "i-pending-data", cwtypes.StateValueInsufficientData, time.Now()),
+			metricAlarm("db-gone", "DBInstanceIdentifier", "old-db", cwtypes.StateValueInsufficientData, old),
+			metricAlarm("queue-depth", "QueueName", "jobs", cwtypes.StateValueOk, old),
+		},
+	}
+	ec2Client := &mockEC2Client{
+		instances: []ec2types.Reservation{{Instances: []ec2types.Instance{
+			{InstanceId: awssdk.String("i-live"), State: &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning}},
+			{InstanceId: awssdk.String("i-terminated"), State: &ec2types.InstanceState{Name: ec2types.InstanceStateNameTerminated}},
+		}}},
+	}
+	rdsClient := &mockRDSClient{instances: []rdstypes.DBInstance{{DBInstanceIdentifier: awssdk.String("live-db")}}}
+
+	scanner := NewAlarmScanner(alarmClient, ec2Client, rdsClient, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 6 {
+		t.Fatalf("expected 6 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 3 {
+		t.Fatalf("expected 3 findings, got %d: %v", len(byID), result.Findings)
+	}
+	for _, name := range []string{"cpu-gone", "cpu-terminated", "db-gone"} {
+		if _, ok := byID[name]; !ok {
+			t.Fatalf("expected finding for %s", name)
+		}
+	}
+
+	f := byID["cpu-gone"]
+	if f.ID != FindingOrphanedAlarm || f.EstimatedMonthlyWaste != 0.10 {
+		t.Fatalf("unexpected finding: %s $%.2f", f.ID, f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["metric_name"] != "CPUUtilization" {
+		t.Fatalf("expected metric_name CPUUtilization, got %v", f.Metadata["metric_name"])
+	}
+	dims, ok := f.Metadata["dimensions"].([]string)
+	if !ok || len(dims) != 1 || dims[0] != "InstanceId=i-deleted" {
+		t.Fatalf("unexpected dimensions: %v", f.Metadata["dimensions"])
+	}
+}
+
+func TestAlarmScanner_IgnoresOtherNamespaces(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	opsworks := metricAlarm("stack-cpu", "InstanceId", "opsworks-instance-1", cwtypes.StateValueOk, old)
+	opsworks.Namespace = awssdk.String("AWS/OpsWorks")
+	custom := metricAlarm("app-latency", "InstanceId", "worker-7", cwtypes.StateValueOk, old)
+	custom.Namespace = awssdk.String("MyApp")
+	agent := metricAlarm("mem-gone", "InstanceId", "i-deleted", cwtypes.StateValueInsufficientData, old)
+	agent.Namespace = awssdk.String("CWAgent")
+
+	alarmClient := &mockAlarmClient{alarms: []cwtypes.MetricAlarm{opsworks, custom, agent}}
+	scanner := NewAlarmScanner(alarmClient, &mockEC2Client{}, &mockRDSClient{}, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ResourceID != "mem-gone" {
+		t.Fatalf("expected only the CWAgent alarm to be flagged, got %v", result.Findings)
+	}
+}
+
+func TestAlarmScanner_Excluded(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	alarmClient := &mockAlarmClient{
+		alarms: []cwtypes.MetricAlarm{metricAlarm("keep", "InstanceId", "i-deleted", cwtypes.StateValueInsufficientData, old)},
+	}
+
+	scanner := NewAlarmScanner(alarmClient, &mockEC2Client{}, &mockRDSClient{}, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"keep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
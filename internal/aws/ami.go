@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// AMIAPI is the minimal interface for AMI operations.
+type AMIAPI interface {
+	DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+}
+
+// AMIScanner detects old self-owned AMIs that no instance uses. SnapshotScanner
+// skips AMI-referenced snapshots, so the backing snapshots are priced here.
+// Launch templates and Auto Scaling groups are not checked.
+type AMIScanner struct {
+	client AMIAPI
+	region string
+}
+
+// NewAMIScanner creates a scanner for AMIs.
+func NewAMIScanner(client AMIAPI, region string) *AMIScanner {
+	return &AMIScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *AMIScanner) Type() ResourceType {
+	return ResourceAMI
+}
+
+// Scan examines all self-owned AMIs for stale, unreferenced images.
+func (s *AMIScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	images, err := s.listOwnedImages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list AMIs: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(images)}
+	if len(images) == 0 {
+		return result, nil
+	}
+
+	inUse, err := s.instanceImageIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, img := range images {
+		imageID := deref(img.ImageId)
+		if cfg.Exclude.ShouldExclude(imageID, ec2TagsToMap(img.Tags)) {
+			continue
+		}
+		if inUse[imageID] {
+			continue
+		}
+
+		created, err := time.Parse(time.RFC3339, deref(img.CreationDate))
+		if err != nil {
+			continue
+		}
+		ageDays := int(now.Sub(created).Hours() / 24)
+		if ageDays < cfg.StaleDays {
+			continue
+		}
+
+		var snapshotIDs []string
+		totalGiB := 0
+		for _, mapping := range img.BlockDeviceMappings {
+			if mapping.Ebs == nil || mapping.Ebs.SnapshotId == nil {
+				continue
+			}
+			snapshotIDs = append(snapshotIDs, *mapping.Ebs.SnapshotId)
+			totalGiB += int(derefInt32(mapping.Ebs.VolumeSize))
+		}
+		cost := pricing.MonthlySnapshotCost(totalGiB, s.region)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingStaleAMI,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceAMI,
+			ResourceID:            imageID,
+			ResourceName:          deref(img.Name),
+			Region:                s.region,
+			Message:               fmt.Sprintf("AMI %d days old, not used by any instance, %d GiB in %d backing snapshots", ageDays, totalGiB, len(snapshotIDs)),
+			EstimatedMonthlyWaste: cost,
+			Metadata: withTags(map[string]any{
+				"age_days":             ageDays,
+				"size_gib":             totalGiB,
+				"backing_snapshot_ids": snapshotIDs,
+				"architecture":         string(img.Architecture),
+			}, ec2TagsToMap(img.Tags)),
+		})
+	}
+
+	return result, nil
+}
+
+func (s *AMIScanner) listOwnedImages(ctx context.Context) ([]ec2types.Image, error) {
+	var images []ec2types.Image
+	paginator := ec2.NewDescribeImagesPaginator(s.client, &ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("state"), Values: []string{"available"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, page.Images...)
+	}
+	return images, nil
+}
+
+// instanceImageIDs returns the AMI IDs of all instances that have not been terminated.
+func (s *AMIScanner) instanceImageIDs(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := ec2.NewDescribeInstancesPaginator(s.client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("instance-state-name"), Values: []string{"pending", "running", "stopping", "stopped"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range page.Reservations {
+			for _, inst := range res.Instances {
+				if id := deref(inst.ImageId); id != "" {
+					ids[id] = true
+				}
+			}
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockAMIClient struct {
+	images       []ec2types.Image
+	reservations []ec2types.Reservation
+	instancesErr error
+}
+
+func (m *mockAMIClient) DescribeImages(_ context.Context, _ *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return &ec2.DescribeImagesOutput{Images: m.images}, nil
+}
+
+func (m *mockAMIClient) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	if m.instancesErr != nil {
+		return nil, m.instancesErr
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: m.reservations}, nil
+}
+
+func testAMI(id string, age time.Duration, snapSizes ...int32) ec2types.Image {
+	img := ec2types.Image{
+		ImageId:      awssdk.String(id),
+		Name:         awssdk.String(id + "-name"),
+		CreationDate: awssdk.String(time.Now().UTC().Add(-age).Format(time.RFC3339)),
+	}
+	for i, size := range snapSizes {
+		img.BlockDeviceMappings = append(img.BlockDeviceMappings, ec2types.BlockDeviceMapping{
+			Ebs: &ec2types.EbsBlockDevice{
+				SnapshotId: awssdk.String(id + "-snap-" + string(rune('a'+i))),
+				VolumeSize: awssdk.Int32(size),
+			},
+		})
+	}
+	return img
+}
+
+func TestAMIScanner_StaleUnusedAMI(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockAMIClient{
+		images: []ec2types.Image{
+			testAMI("ami-stale", 200*day, 30, 70),
+			testAMI("ami-inuse", 200*day, 30),
+			testAMI("ami-new", 10*day, 30),
+		},
+		reservations: []ec2types.Reservation{
+			{Instances: []ec2types.Instance{{InstanceId: awssdk.String("i-1"), ImageId: awssdk.String("ami-inuse")}}},
+		},
+	}
+
+	scanner := NewAMIScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingStaleAMI || f.ResourceID != "ami-stale" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	// 100 GiB * $0.05 = $5.00
+	if f.EstimatedMonthlyWaste < 4.99 || f.EstimatedMonthlyWaste > 5.01 {
+		t.Fatalf("expected ~$5.00, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	snaps, ok := f.Metadata["backing_snapshot_ids"].([]string)
+	if !ok || len(snaps) != 2 {
+		t.Fatalf("expected 2 backing snapshots, got %v", f.Metadata["backing_snapshot_ids"])
+	}
+}
+
+func TestAMIScanner_Excluded(t *testing.T) {
+	mock := &mockAMIClient{images: []ec2types.Image{testAMI("ami-keep", 200*24*time.Hour, 8)}}
+
+	scanner := NewAMIScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"ami-keep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestAMIScanner_InstanceListError(t *testing.T) {
+	mock := &mockAMIClient{
+		images:       []ec2types.Image{testAMI("ami-stale", 200*24*time.Hour, 8)},
+		instancesErr: errors.New("denied"),
+	}
+
+	scanner := NewAMIScanner(mock, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90}); err == nil {
+		t.Fatal("expected error when instances cannot be listed")
+	}
+}
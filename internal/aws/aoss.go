@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	aosstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	aossNamespace = "AWS/AOSS"
+	// aossBatchGetLimit is the maximum number of collection IDs per BatchGetCollection call.
+	aossBatchGetLimit = 100
+)
+
+// OpenSearchServerlessAPI is the minimal interface for OpenSearch Serverless operations.
+type OpenSearchServerlessAPI interface {
+	ListCollections(ctx context.Context, input *opensearchserverless.ListCollectionsInput, opts ...func(*opensearchserverless.Options)) (*opensearchserverless.ListCollectionsOutput, error)
+	BatchGetCollection(ctx context.Context, input *opensearchserverless.BatchGetCollectionInput, opts ...func(*opensearchserverless.Options)) (*opensearchserverless.BatchGetCollectionOutput, error)
+}
+
+// OpenSearchServerlessScanner detects serverless collections that nobody searches or indexes into.
+type OpenSearchServerlessScanner struct {
+	client  OpenSearchServerlessAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewOpenSearchServerlessScanner creates a scanner for OpenSearch Serverless collections.
+func NewOpenSearchServerlessScanner(client OpenSearchServerlessAPI, metrics *MetricsFetcher, region string) *OpenSearchServerlessScanner {
+	return &OpenSearchServerlessScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *OpenSearchServerlessScanner) Type() ResourceType {
+	return ResourceAOSS
+}
+
+// Scan examines active collections older than the idle window for zero search and
+// ingestion requests. An idle collection still holds its minimum OCUs: one indexing
+// and one search OCU with standby replicas, half of each without.
+func (s *OpenSearchServerlessScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	collections, err := s.listCollections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list OpenSearch Serverless collections: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(collections)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	for _, c := range collections {
+		id := deref(c.Id)
+		name := deref(c.Name)
+		if cfg.Exclude.ShouldExclude(id, nil) || cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+		if c.Status != aosstypes.CollectionStatusActive {
+			continue
+		}
+		created := time.UnixMilli(derefInt64(c.CreatedDate)).UTC()
+		if created.After(cutoff) {
+			continue
+		}
+
+		requests, err := s.fetchRequests(ctx, c, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch OpenSearch Serverless request metrics", "collection", name, "error", err)
+			continue
+		}
+		if requests > 0 {
+			continue
+		}
+
+		minimumOCUs := 2.0
+		if c.StandbyReplicas == aosstypes.StandbyReplicasDisabled {
+			minimumOCUs = 1.0
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingAOSSIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceAOSS,
+			ResourceID:            id,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero search and ingestion requests over %d days, still billing %.0f minimum OCUs", cfg.IdleDays, minimumOCUs),
+			EstimatedMonthlyWaste: pricing.MonthlyOCUCost(s.region) * minimumOCUs,
+			Metadata: map[string]any{
+				"collection_type":  string(c.Type),
+				"standby_replicas": string(c.StandbyReplicas),
+				"minimum_ocus":     minimumOCUs,
+				"created_date":     created.Format(time.RFC3339),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// fetchRequests returns the collection's total search and ingestion requests.
+// AOSS publishes both per collection with the collection name and owning account
+// as extra dimensions.
+func (s *OpenSearchServerlessScanner) fetchRequests(ctx context.Context, c aosstypes.CollectionDetail, days int) (float64, error) {
+	dims := []cwtypes.Dimension{
+		{Name: awssdk.String("CollectionName"), Value: c.Name},
+		{Name: awssdk.String("ClientId"), Value: awssdk.String(accountFromARN(deref(c.Arn)))},
+	}
+	id := deref(c.Id)
+
+	var total float64
+	for _, metric := range []string{"SearchRequestRate", "IngestionRequestSuccess"} {
+		sums, err := s.metrics.FetchSumWithStaticDim(ctx, aossNamespace, metric, "CollectionId", []string{id}, days, dims)
+		if err != nil {
+			return 0, err
+		}
+		total += sums[id]
+	}
+	return total, nil
+}
+
+// accountFromARN extracts the account ID from an ARN.
+// e.g., "arn:aws:aoss:us-east-1:123456789012:collection/abc" → "123456789012"
+func accountFromARN(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > 4 {
+		return parts[4]
+	}
+	return ""
+}
+
+// listCollections returns the details of every collection. ListCollections omits
+// type, standby replicas, and creation date, so they are fetched in batches.
+func (s *OpenSearchServerlessScanner) listCollections(ctx context.Context) ([]aosstypes.CollectionDetail, error) {
+	var ids []string
+	paginator := opensearchserverless.NewListCollectionsPaginator(s.client, &opensearchserverless.ListCollectionsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.CollectionSummaries {
+			ids = append(ids, deref(c.Id))
+		}
+	}
+
+	var details []aosstypes.CollectionDetail
+	for _, batch := range batchIDs(ids, aossBatchGetLimit) {
+		out, err := s.client.BatchGetCollection(ctx, &opensearchserverless.BatchGetCollectionInput{Ids: batch})
+		if err != nil {
+			return nil, fmt.Errorf("batch get collections: %w", err)
+		}
+		details = append(details, out.CollectionDetails...)
+	}
+	return details, nil
+}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	aosstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+)
+
+type mockAOSSClient struct {
+	collections []aosstypes.CollectionDetail
+}
+
+func (m *mockAOSSClient) ListCollections(_ context.Context, _ *opensearchserverless.ListCollectionsInput, _ ...func(*opensearchserverless.Options)) (*opensearchserverless.ListCollectionsOutput, error) {
+	var out []aosstypes.CollectionSummary
+	for _, c := range m.collections {
+		out = append(out, aosstypes.CollectionSummary{Id: c.Id, Name: c.Name, Arn: c.Arn, Status: c.Status})
+	}
+	return &opensearchserverless.ListCollectionsOutput{CollectionSummaries: out}, nil
+}
+
+func (m *mockAOSSClient) BatchGetCollection(_ context.Context, input *opensearchserverless.BatchGetCollectionInput, _ ...func(*opensearchserverless.Options)) (*opensearchserverless.BatchGetCollectionOutput, error) {
+	var out []aosstypes.CollectionDetail
+	for _, id := range input.Ids {
+		for _, c := range m.collections {
+			if deref(c.Id) == id {
+				out = append(out, c)
+			}
+		}
+	}
+	return &opensearchserverless.BatchGetCollectionOutput{CollectionDetails: out}, nil
+}
+
+func aossCollection(id string, age time.Duration, standby aosstypes.StandbyReplicas) aosstypes.CollectionDetail {
+	return aosstypes.CollectionDetail{
+		Id:              awssdk.String(id),
+		Name:            awssdk.String(id + "-logs"),
+		Arn:             awssdk.String("arn:aws:aoss:us-east-1:123456789012:collection/" + id),
+		Status:          aosstypes.CollectionStatusActive,
+		Type:            aosstypes.CollectionTypeTimeseries,
+		StandbyReplicas: standby,
+		CreatedDate:     awssdk.Int64(time.Now().Add(-age).UnixMilli()),
+	}
+}
+
+func TestOpenSearchServerlessScanner_IdleCollections(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockAOSSClient{
+		collections: []aosstypes.CollectionDetail{
+			aossCollection("idle", 60*day, aosstypes.StandbyReplicasEnabled),
+			aossCollection("nostandby", 60*day, aosstypes.StandbyReplicasDisabled),
+			aossCollection("searched", 60*day, aosstypes.StandbyReplicasEnabled),
+			aossCollection("ingesting", 60*day, aosstypes.StandbyReplicasEnabled),
+			aossCollection("new", 2*day, aosstypes.StandbyReplicasEnabled),
+		},
+	}
+	metrics := newMetricsByName(map[string]float64{
+		"SearchRequestRate/searched":        50,
+		"IngestionRequestSuccess/ingesting": 1000,
+	})
+
+	scanner := NewOpenSearchServerlessScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+
+	idle := byID["idle"]
+	if idle.ID != FindingAOSSIdle || idle.ResourceName != "idle-logs" {
+		t.Fatalf("unexpected finding: %s %s", idle.ID, idle.ResourceName)
+	}
+	// 2 OCUs at $0.24/hour: $0.24 * 730 * 2 = $350.40
+	if idle.EstimatedMonthlyWaste < 350.39 || idle.EstimatedMonthlyWaste > 350.41 {
+		t.Fatalf("expected ~$350.40, got $%.2f", idle.EstimatedMonthlyWaste)
+	}
+	if idle.Metadata["collection_type"] != "TIMESERIES" {
+		t.Fatalf("expected collection_type TIMESERIES, got %v", idle.Metadata["collection_type"])
+	}
+
+	// Without standby replicas the minimum is half an indexing and half a search OCU.
+	if cost := byID["nostandby"].EstimatedMonthlyWaste; cost < 175.19 || cost > 175.21 {
+		t.Fatalf("expected ~$175.20, got $%.2f", cost)
+	}
+}
+
+func TestOpenSearchServerlessScanner_Excluded(t *testing.T) {
+	mock := &mockAOSSClient{
+		collections: []aosstypes.CollectionDetail{aossCollection("keep", 60*24*time.Hour, aosstypes.StandbyReplicasEnabled)},
+	}
+
+	scanner := NewOpenSearchServerlessScanner(mock, newMetricsByName(nil), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"keep-logs": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	apigwv2types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	apiGatewayNamespace = "AWS/ApiGateway"
+	apiGatewayStageDim  = "Stage"
+)
+
+// APIGatewayAPI is the minimal interface for API Gateway (REST API) operations.
+type APIGatewayAPI interface {
+	GetRestApis(ctx context.Context, input *apigateway.GetRestApisInput, opts ...func(*apigateway.Options)) (*apigateway.GetRestApisOutput, error)
+	GetStages(ctx context.Context, input *apigateway.GetStagesInput, opts ...func(*apigateway.Options)) (*apigateway.GetStagesOutput, error)
+}
+
+// APIGatewayV2API is the minimal interface for API Gateway v2 (HTTP API) operations.
+type APIGatewayV2API interface {
+	GetApis(ctx context.Context, input *apigatewayv2.GetApisInput, opts ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error)
+	GetStages(ctx context.Context, input *apigatewayv2.GetStagesInput, opts ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error)
+}
+
+// APIGatewayScanner detects API Gateway stages with no requests and idle stage caches.
+type APIGatewayScanner struct {
+	client   APIGatewayAPI
+	v2Client APIGatewayV2API
+	metrics  *MetricsFetcher
+	region   string
+}
+
+// NewAPIGatewayScanner creates a scanner for REST and HTTP API stages.
+func NewAPIGatewayScanner(client APIGatewayAPI, v2Client APIGatewayV2API, metrics *MetricsFetcher, region string) *APIGatewayScanner {
+	return &APIGatewayScanner{client: client, v2Client: v2Client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *APIGatewayScanner) Type() ResourceType {
+	return ResourceAPIGateway
+}
+
+// apiStage is one deployed stage of a REST or HTTP API.
+type apiStage struct {
+	apiID        string
+	apiName      string
+	protocol     string
+	stage        string
+	created      *time.Time
+	cacheEnabled bool
+	cacheSize    string
+	tags         map[string]string
+}
+
+// apiGroup is an API and its stages. Stage metrics are keyed by the API name for
+// REST APIs and by the API ID for HTTP APIs.
+type apiGroup struct {
+	metricDim   string
+	metricValue string
+	stages      []apiStage
+}
+
+// Scan examines every stage of REST and HTTP APIs for zero requests over the idle window.
+func (s *APIGatewayScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	restGroups, err := s.listRestStages(ctx, cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("list REST APIs: %w", err)
+	}
+	httpGroups, err := s.listHTTPStages(ctx, cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("list HTTP APIs: %w", err)
+	}
+	groups := append(restGroups, httpGroups...)
+
+	result := &ScanResult{}
+	for _, g := range groups {
+		result.ResourcesScanned += len(g.stages)
+	}
+
+	lookbackStart := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	for _, g := range groups {
+		var names []string
+		for _, st := range g.stages {
+			names = append(names, st.stage)
+		}
+
+		staticDims := []cwtypes.Dimension{{Name: awssdk.String(g.metricDim), Value: awssdk.String(g.metricValue)}}
+		counts, err := s.metrics.FetchSumWithStaticDim(ctx, apiGatewayNamespace, "Count", apiGatewayStageDim, names, cfg.IdleDays, staticDims)
+		if err != nil {
+			slog.Warn("Failed to fetch API Gateway request metrics", "api", g.metricValue, "error", err)
+			continue
+		}
+
+		for _, st := range g.stages {
+			if counts[st.stage] > 0 {
+				continue
+			}
+			// Stages deployed within the idle window have not had time to see traffic.
+			if st.created != nil && st.created.After(lookbackStart) {
+				continue
+			}
+			result.Findings = append(result.Findings, s.stageFinding(st, cfg.IdleDays))
+		}
+	}
+
+	return result, nil
+}
+
+func (s *APIGatewayScanner) stageFinding(st apiStage, idleDays int) Finding {
+	meta := map[string]any{
+		"api_id":        st.apiID,
+		"api_name":      st.apiName,
+		"protocol_type": st.protocol,
+		"stage":         st.stage,
+		"cache_enabled": st.cacheEnabled,
+	}
+	setTagsMetadata(meta, st.tags)
+	f := Finding{
+		ResourceType: ResourceAPIGateway,
+		ResourceID:   st.apiID + "/" + st.stage,
+		ResourceName: st.apiName + "/" + st.stage,
+		Region:       s.region,
+		Metadata:     meta,
+	}
+
+	// APIGW_UNUSED_CACHE: the stage cache bills per hour regardless of traffic
+	if st.cacheEnabled {
+		meta["cache_size_gb"] = st.cacheSize
+		f.ID = FindingAPIGWUnusedCache
+		f.Severity = SeverityMedium
+		f.Message = fmt.Sprintf("Zero requests over %d days with a %s GB stage cache provisioned", idleDays, st.cacheSize)
+		f.EstimatedMonthlyWaste = pricing.MonthlyApiGatewayCacheCost(st.cacheSize, s.region)
+		return f
+	}
+
+	// APIGW_IDLE_STAGE: requests are billed per call, so an idle stage costs nothing
+	f.ID = FindingAPIGWIdleStage
+	f.Severity = SeverityLow
+	f.Message = fmt.Sprintf("Zero requests over %d days", idleDays)
+	f.Hygiene = true
+	return f
+}
+
+func (s *APIGatewayScanner) listRestStages(ctx context.Context, exclude ExcludeConfig) ([]apiGroup, error) {
+	var groups []apiGroup
+	paginator := apigateway.NewGetRestApisPaginator(s.client, &apigateway.GetRestApisInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, api := range page.Items {
+			id, name := deref(api.Id), deref(api.Name)
+			if exclude.ShouldExclude(id, api.Tags) || exclude.ShouldExclude(name, nil) {
+				continue
+			}
+
+			out, err := s.client.GetStages(ctx, &apigateway.GetStagesInput{RestApiId: api.Id})
+			if err != nil {
+				slog.Warn("Failed to list REST API stages", "api", id, "error", err)
+				continue
+			}
+
+			g := apiGroup{metricDim: "ApiName", metricValue: name}
+			for _, st := range out.Item {
+				g.stages = append(g.stages, apiStage{
+					apiID:        id,
+					apiName:      name,
+					protocol:     "REST",
+					stage:        deref(st.StageName),
+					created:      st.CreatedDate,
+					cacheEnabled: st.CacheClusterEnabled,
+					cacheSize:    string(st.CacheClusterSize),
+					tags:         api.Tags,
+				})
+			}
+			if len(g.stages) > 0 {
+				groups = append(groups, g)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func (s *APIGatewayScanner) listHTTPStages(ctx context.Context, exclude ExcludeConfig) ([]apiGroup, error) {
+	var groups []apiGroup
+	var next *string
+	for {
+		page, err := s.v2Client.GetApis(ctx, &apigatewayv2.GetApisInput{NextToken: next})
+		if err != nil {
+			return nil, err
+		}
+		for _, api := range page.Items {
+			// WebSocket APIs publish message and connection metrics rather than Count.
+			if api.ProtocolType != apigwv2types.ProtocolTypeHttp {
+				continue
+			}
+			id, name := deref(api.ApiId), deref(api.Name)
+			if exclude.ShouldExclude(id, api.Tags) || exclude.ShouldExclude(name, nil) {
+				continue
+			}
+
+			stages, err := s.httpStages(ctx, id)
+			if err != nil {
+				slog.Warn("Failed to list HTTP API stages", "api", id, "error", err)
+				continue
+			}
+
+			g := apiGroup{metricDim: "ApiId", metricValue: id}
+			for _, st := range stages {
+				g.stages = append(g.stages, apiStage{
+					apiID:    id,
+					apiName:  name,
+					protocol: string(api.ProtocolType),
+					stage:    deref(st.StageName),
+					created:  st.CreatedDate,
+					tags:     api.Tags,
+				})
+			}
+			if len(g.stages) > 0 {
+				groups = append(groups, g)
+			}
+		}
+		if page.NextToken == nil {
+			break
+		}
+		next = page.NextToken
+	}
+	return groups, nil
+}
+
+func (s *APIGatewayScanner) httpStages(ctx context.Context, apiID string) ([]apigwv2types.Stage, error) {
+	var stages []apigwv2types.Stage
+	var next *string
+	for {
+		out, err := s.v2Client.GetStages(ctx, &apigatewayv2.GetStagesInput{ApiId: &apiID, NextToken: next})
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, out.Items...)
+		if out.NextToken == nil {
+			break
+		}
+		next = out.NextToken
+	}
+	return stages, nil
+}
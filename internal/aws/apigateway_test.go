@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigwtypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	apigwv2types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+type mockAPIGatewayClient struct {
+	apis   []apigwtypes.RestApi
+	stages map[string][]apigwtypes.Stage
+}
+
+func (m *mockAPIGatewayClient) GetRestApis(_ context.Context, _ *apigateway.GetRestApisInput, _ ...func(*apigateway.Options)) (*apigateway.GetRestApisOutput, error) {
+	return &apigateway.GetRestApisOutput{Items: m.apis}, nil
+}
+
+func (m *mockAPIGatewayClient) GetStages(_ context.Context, input *apigateway.GetStagesInput, _ ...func(*apigateway.Options)) (*apigateway.GetStagesOutput, error) {
+	return &apigateway.GetStagesOutput{Item: m.stages[*input.RestApiId]}, nil
+}
+
+type mockAPIGatewayV2Client struct {
+	apis   []apigwv2types.Api
+	stages map[string][]apigwv2types.Stage
+}
+
+func (m *mockAPIGatewayV2Client) GetApis(_ context.Context, _ *apigatewayv2.GetApisInput, _ ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error) {
+	return &apigatewayv2.GetApisOutput{Items: m.apis}, nil
+}
+
+func (m *mockAPIGatewayV2Client) GetStages(_ context.Context, input *apigatewayv2.GetStagesInput, _ ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error) {
+	return &apigatewayv2.GetStagesOutput{Items: m.stages[*input.ApiId]}, nil
+}
+
+func restStage(name string, cacheSize apigwtypes.CacheClusterSize, age time.Duration) apigwtypes.Stage {
+	return apigwtypes.Stage{
+		StageName:           awssdk.String(name),
+		CreatedDate:         awssdk.Time(time.Now().Add(-age)),
+		CacheClusterEnabled: cacheSize != "",
+		CacheClusterSize:    cacheSize,
+	}
+}
+
+func TestAPIGatewayScanner_RestStages(t *testing.T) {
+	old := 60 * 24 * time.Hour
+	rest := &mockAPIGatewayClient{
+		apis: []apigwtypes.RestApi{{Id: awssdk.String("abc123"), Name: awssdk.String("orders")}},
+		stages: map[string][]apigwtypes.Stage{
+			"abc123": {
+				restStage("prod", "", old),
+				restStage("staging", "", old),
+				restStage("cached", apigwtypes.CacheClusterSizeSize6Point1Gb, old),
+				restStage("new", "", time.Hour),
+			},
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"prod": 1500})
+	scanner := NewAPIGatewayScanner(rest, &mockAPIGatewayV2Client{}, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 stages scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	idle := byID["abc123/staging"]
+	if idle.ID != FindingAPIGWIdleStage || !idle.Hygiene || idle.EstimatedMonthlyWaste != 0 {
+		t.Fatalf("unexpected idle stage finding: %+v", idle)
+	}
+	cache := byID["abc123/cached"]
+	if cache.ID != FindingAPIGWUnusedCache {
+		t.Fatalf("expected APIGW_UNUSED_CACHE, got %s", cache.ID)
+	}
+	// 6.1 GB cache $0.20/hr * 730 = $146.00
+	if cache.EstimatedMonthlyWaste < 145.9 || cache.EstimatedMonthlyWaste > 146.1 {
+		t.Fatalf("expected ~$146.00, got $%.2f", cache.EstimatedMonthlyWaste)
+	}
+}
+
+func TestAPIGatewayScanner_HTTPStages(t *testing.T) {
+	v2 := &mockAPIGatewayV2Client{
+		apis: []apigwv2types.Api{
+			{ApiId: awssdk.String("http1"), Name: awssdk.String("webhooks"), ProtocolType: apigwv2types.ProtocolTypeHttp},
+			{ApiId: awssdk.String("ws1"), Name: awssdk.String("chat"), ProtocolType: apigwv2types.ProtocolTypeWebsocket},
+		},
+		stages: map[string][]apigwv2types.Stage{
+			"http1": {{StageName: awssdk.String("$default")}},
+			"ws1":   {{StageName: awssdk.String("prod")}},
+		},
+	}
+	scanner := NewAPIGatewayScanner(&mockAPIGatewayClient{}, v2, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ResourceID != "http1/$default" || f.Metadata["protocol_type"] != "HTTP" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+}
+
+func TestAPIGatewayScanner_ExcludedByName(t *testing.T) {
+	rest := &mockAPIGatewayClient{
+		apis:   []apigwtypes.RestApi{{Id: awssdk.String("abc123"), Name: awssdk.String("orders")}},
+		stages: map[string][]apigwtypes.Stage{"abc123": {restStage("prod", "", 60*24*time.Hour)}},
+	}
+	scanner := NewAPIGatewayScanner(rest, &mockAPIGatewayV2Client{}, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 30,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"orders": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 0 || len(result.Findings) != 0 {
+		t.Fatalf("expected excluded API to be skipped, got %d scanned, %d findings", result.ResourcesScanned, len(result.Findings))
+	}
+}
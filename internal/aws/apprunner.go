@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	artypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// AppRunnerAPI is the minimal interface for App Runner operations.
+type AppRunnerAPI interface {
+	ListServices(ctx context.Context, input *apprunner.ListServicesInput, opts ...func(*apprunner.Options)) (*apprunner.ListServicesOutput, error)
+	DescribeService(ctx context.Context, input *apprunner.DescribeServiceInput, opts ...func(*apprunner.Options)) (*apprunner.DescribeServiceOutput, error)
+	DescribeAutoScalingConfiguration(ctx context.Context, input *apprunner.DescribeAutoScalingConfigurationInput, opts ...func(*apprunner.Options)) (*apprunner.DescribeAutoScalingConfigurationOutput, error)
+}
+
+// AppRunnerScanner detects running App Runner services that receive no requests.
+type AppRunnerScanner struct {
+	client  AppRunnerAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewAppRunnerScanner creates a scanner for App Runner services.
+func NewAppRunnerScanner(client AppRunnerAPI, metrics *MetricsFetcher, region string) *AppRunnerScanner {
+	return &AppRunnerScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *AppRunnerScanner) Type() ResourceType {
+	return ResourceAppRunner
+}
+
+// Scan examines running services older than the idle window for zero requests. An idle
+// service still pays for the memory of its minimum provisioned instances.
+func (s *AppRunnerScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	summaries, err := s.listServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list App Runner services: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(summaries)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	for _, sum := range summaries {
+		name := deref(sum.ServiceName)
+		serviceID := deref(sum.ServiceId)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(sum.ServiceArn), nil) {
+			continue
+		}
+		// Paused services bill nothing; other states are transitional.
+		if sum.Status != artypes.ServiceStatusRunning {
+			continue
+		}
+		// Services created within the idle window have not had time to see traffic.
+		if sum.CreatedAt != nil && sum.CreatedAt.After(cutoff) {
+			continue
+		}
+
+		// App Runner metrics carry both ServiceName and ServiceID, so each service is queried on its own.
+		serviceDim := []cwtypes.Dimension{{Name: awssdk.String("ServiceID"), Value: awssdk.String(serviceID)}}
+		requests, err := s.metrics.FetchSumWithStaticDim(ctx, "AWS/AppRunner", "Requests", "ServiceName", []string{name}, cfg.IdleDays, serviceDim)
+		if err != nil {
+			slog.Warn("Failed to fetch App Runner request metrics", "service", name, "error", err)
+			continue
+		}
+		if requests[name] > 0 {
+			continue
+		}
+
+		out, err := s.client.DescribeService(ctx, &apprunner.DescribeServiceInput{ServiceArn: sum.ServiceArn})
+		if err != nil || out.Service == nil {
+			slog.Warn("Failed to describe App Runner service", "service", name, "error", err)
+			continue
+		}
+		svc := out.Service
+
+		var cpu, memory string
+		if svc.InstanceConfiguration != nil {
+			cpu = deref(svc.InstanceConfiguration.Cpu)
+			memory = deref(svc.InstanceConfiguration.Memory)
+		}
+		vcpu := appRunnerVCPU(cpu)
+		memGiB := appRunnerMemoryGiB(memory)
+
+		meta := map[string]any{
+			"cpu":    cpu,
+			"memory": memory,
+		}
+
+		minSize := 1
+		if asc := svc.AutoScalingConfigurationSummary; asc != nil {
+			meta["auto_scaling_config"] = fmt.Sprintf("%s:%d", deref(asc.AutoScalingConfigurationName), asc.AutoScalingConfigurationRevision)
+			if n, err := s.minInstances(ctx, asc.AutoScalingConfigurationArn); err != nil {
+				slog.Warn("Failed to describe App Runner auto scaling configuration", "service", name, "error", err)
+			} else {
+				minSize = n
+			}
+		}
+		meta["min_instances"] = minSize
+
+		active, err := s.metrics.FetchAverageWithStaticDim(ctx, "AWS/AppRunner", "ActiveInstances", "ServiceName", []string{name}, cfg.IdleDays, serviceDim)
+		if err != nil {
+			slog.Warn("Failed to fetch App Runner instance metrics", "service", name, "error", err)
+		} else {
+			meta["avg_active_instances"] = active[name]
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingAppRunnerIdle,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceAppRunner,
+			ResourceID:            name,
+			ResourceName:          deref(sum.ServiceArn),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero requests over %d days (%d provisioned instances of %.2g vCPU / %.2g GB)", cfg.IdleDays, minSize, vcpu, memGiB),
+			EstimatedMonthlyWaste: pricing.MonthlyAppRunnerProvisionedCost(memGiB, minSize, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// minInstances returns the minimum provisioned instance count of an auto scaling configuration.
+func (s *AppRunnerScanner) minInstances(ctx context.Context, arn *string) (int, error) {
+	out, err := s.client.DescribeAutoScalingConfiguration(ctx, &apprunner.DescribeAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: arn,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if out.AutoScalingConfiguration == nil || out.AutoScalingConfiguration.MinSize == nil {
+		return 1, nil
+	}
+	return int(*out.AutoScalingConfiguration.MinSize), nil
+}
+
+func (s *AppRunnerScanner) listServices(ctx context.Context) ([]artypes.ServiceSummary, error) {
+	var services []artypes.ServiceSummary
+	paginator := apprunner.NewListServicesPaginator(s.client, &apprunner.ListServicesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, page.ServiceSummaryList...)
+	}
+	return services, nil
+}
+
+// appRunnerVCPU parses App Runner CPU settings, which are either CPU units ("1024")
+// or a vCPU string ("1 vCPU", "0.25 vCPU").
+func appRunnerVCPU(cpu string) float64 {
+	fields := strings.Fields(cpu)
+	if len(fields) == 0 {
+		return 0
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	if len(fields) == 1 {
+		return v / 1024
+	}
+	return v
+}
+
+// appRunnerMemoryGiB parses App Runner memory settings, which are either MB ("2048")
+// or a GB string ("2 GB", "0.5 GB").
+func appRunnerMemoryGiB(memory string) float64 {
+	fields := strings.Fields(memory)
+	if len(fields) == 0 {
+		return 0
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	if len(fields) == 1 {
+		return v / 1024
+	}
+	return v
+}
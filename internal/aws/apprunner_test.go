@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	artypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+)
+
+type mockAppRunnerClient struct {
+	services []artypes.Service
+	minSize  int32
+	err      error
+}
+
+func (m *mockAppRunnerClient) ListServices(_ context.Context, _ *apprunner.ListServicesInput, _ ...func(*apprunner.Options)) (*apprunner.ListServicesOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var list []artypes.ServiceSummary
+	for _, svc := range m.services {
+		list = append(list, artypes.ServiceSummary{
+			ServiceArn:  svc.ServiceArn,
+			ServiceId:   svc.ServiceId,
+			ServiceName: svc.ServiceName,
+			Status:      svc.Status,
+			CreatedAt:   svc.CreatedAt,
+		})
+	}
+	return &apprunner.ListServicesOutput{ServiceSummaryList: list}, nil
+}
+
+func (m *mockAppRunnerClient) DescribeService(_ context.Context, input *apprunner.DescribeServiceInput, _ ...func(*apprunner.Options)) (*apprunner.DescribeServiceOutput, error) {
+	for i := range m.services {
+		if *m.services[i].ServiceArn == *input.ServiceArn {
+			return &apprunner.DescribeServiceOutput{Service: &m.services[i]}, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockAppRunnerClient) DescribeAutoScalingConfiguration(_ context.Context, _ *apprunner.DescribeAutoScalingConfigurationInput, _ ...func(*apprunner.Options)) (*apprunner.DescribeAutoScalingConfigurationOutput, error) {
+	return &apprunner.DescribeAutoScalingConfigurationOutput{
+		AutoScalingConfiguration: &artypes.AutoScalingConfiguration{MinSize: awssdk.Int32(m.minSize)},
+	}, nil
+}
+
+func appRunnerService(name string, status artypes.ServiceStatus, cpu, memory string) artypes.Service {
+	return artypes.Service{
+		ServiceArn:            awssdk.String("arn:aws:apprunner:us-east-1:123456789012:service/" + name + "/id-" + name),
+		ServiceId:             awssdk.String("id-" + name),
+		ServiceName:           awssdk.String(name),
+		Status:                status,
+		CreatedAt:             awssdk.Time(time.Now().Add(-60 * 24 * time.Hour)),
+		InstanceConfiguration: &artypes.InstanceConfiguration{Cpu: awssdk.String(cpu), Memory: awssdk.String(memory)},
+		AutoScalingConfigurationSummary: &artypes.AutoScalingConfigurationSummary{
+			AutoScalingConfigurationArn:      awssdk.String("arn:aws:apprunner:us-east-1:123456789012:autoscalingconfiguration/DefaultConfiguration/1/abc"),
+			AutoScalingConfigurationName:     awssdk.String("DefaultConfiguration"),
+			AutoScalingConfigurationRevision: 1,
+		},
+	}
+}
+
+func TestAppRunnerScanner_IdleService(t *testing.T) {
+	client := &mockAppRunnerClient{
+		minSize: 2,
+		services: []artypes.Service{
+			appRunnerService("idle-api", artypes.ServiceStatusRunning, "1024", "2048"),
+			appRunnerService("busy-api", artypes.ServiceStatusRunning, "1 vCPU", "2 GB"),
+			appRunnerService("paused-api", artypes.ServiceStatusPaused, "1024", "2048"),
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"idle-api": 0, "busy-api": 1500})
+	scanner := NewAppRunnerScanner(client, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingAppRunnerIdle || f.ResourceID != "idle-api" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 2 GiB * $0.007 * 2 instances * 730h = $20.44
+	if f.EstimatedMonthlyWaste < 20.43 || f.EstimatedMonthlyWaste > 20.45 {
+		t.Fatalf("expected ~$20.44, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["cpu"] != "1024" || f.Metadata["memory"] != "2048" || f.Metadata["auto_scaling_config"] != "DefaultConfiguration:1" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestAppRunnerResourceParsing(t *testing.T) {
+	tests := []struct {
+		cpu, memory string
+		vcpu, mem   float64
+	}{
+		{"1024", "2048", 1, 2},
+		{"0.25 vCPU", "0.5 GB", 0.25, 0.5},
+		{"4 vCPU", "12 GB", 4, 12},
+		{"", "", 0, 0},
+	}
+	for _, tt := range tests {
+		if got := appRunnerVCPU(tt.cpu); got != tt.vcpu {
+			t.Errorf("appRunnerVCPU(%q) = %v, want %v", tt.cpu, got, tt.vcpu)
+		}
+		if got := appRunnerMemoryGiB(tt.memory); got != tt.mem {
+			t.Errorf("appRunnerMemoryGiB(%q) = %v, want %v", tt.memory, got, tt.mem)
+		}
+	}
+}
+
+func TestAppRunnerScanner_APIError(t *testing.T) {
+	scanner := NewAppRunnerScanner(&mockAppRunnerClient{err: errors.New("denied")}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14}); err == nil {
+		t.Fatal("expected error")
+	}
+}
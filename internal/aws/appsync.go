@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	appsynctypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	appSyncNamespace = "AWS/AppSync"
+	appSyncAPIDim    = "GraphQLAPIId"
+)
+
+// AppSyncAPI is the minimal interface for AppSync operations.
+type AppSyncAPI interface {
+	ListGraphqlApis(ctx context.Context, input *appsync.ListGraphqlApisInput, opts ...func(*appsync.Options)) (*appsync.ListGraphqlApisOutput, error)
+	GetApiCache(ctx context.Context, input *appsync.GetApiCacheInput, opts ...func(*appsync.Options)) (*appsync.GetApiCacheOutput, error)
+}
+
+// AppSyncScanner detects GraphQL APIs that receive no requests.
+type AppSyncScanner struct {
+	client  AppSyncAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewAppSyncScanner creates a scanner for AppSync GraphQL APIs.
+func NewAppSyncScanner(client AppSyncAPI, metrics *MetricsFetcher, region string) *AppSyncScanner {
+	return &AppSyncScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *AppSyncScanner) Type() ResourceType {
+	return ResourceAppSync
+}
+
+// Scan examines GraphQL APIs for zero requests over the idle window. AppSync does
+// not publish a per-API request count, so requests are the Latency sample count
+// plus 4XX errors, which are rejected before a latency sample is recorded.
+func (s *AppSyncScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	apis, err := s.listAPIs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list AppSync APIs: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(apis)}
+
+	var ids []string
+	for _, api := range apis {
+		if cfg.Exclude.ShouldExclude(deref(api.ApiId), api.Tags) {
+			continue
+		}
+		ids = append(ids, deref(api.ApiId))
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	latency, err := s.metrics.FetchSeries(ctx, appSyncNamespace, "Latency", appSyncAPIDim, ids, cfg.IdleDays, "SampleCount")
+	if err != nil {
+		slog.Warn("Failed to fetch AppSync latency metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	clientErrors, err := s.metrics.FetchSum(ctx, appSyncNamespace, "4XXError", appSyncAPIDim, ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch AppSync 4XX metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, api := range apis {
+		id := deref(api.ApiId)
+		if cfg.Exclude.ShouldExclude(id, api.Tags) {
+			continue
+		}
+		requests := clientErrors[id]
+		for _, p := range latency[id] {
+			requests += p.Value
+		}
+		if requests > 0 {
+			continue
+		}
+
+		cache, err := s.apiCache(ctx, id)
+		if err != nil {
+			slog.Warn("Failed to get AppSync API cache", "api", id, "error", err)
+			continue
+		}
+		result.Findings = append(result.Findings, s.apiFinding(api, cache, cfg.IdleDays))
+	}
+
+	return result, nil
+}
+
+func (s *AppSyncScanner) apiFinding(api appsynctypes.GraphqlApi, cache *appsynctypes.ApiCache, idleDays int) Finding {
+	meta := map[string]any{
+		"api_type":            string(api.ApiType),
+		"authentication_type": string(api.AuthenticationType),
+		"cache_enabled":       cache != nil,
+	}
+	setTagsMetadata(meta, api.Tags)
+	f := Finding{
+		ResourceType: ResourceAppSync,
+		ResourceID:   deref(api.ApiId),
+		ResourceName: deref(api.Name),
+		Region:       s.region,
+		Metadata:     meta,
+	}
+
+	// APPSYNC_UNUSED_CACHE: the cache instance bills per hour regardless of traffic
+	if cache != nil {
+		meta["cache_type"] = string(cache.Type)
+		meta["cache_ttl_seconds"] = cache.Ttl
+		meta["caching_behavior"] = string(cache.ApiCachingBehavior)
+		f.ID = FindingAppSyncUnusedCache
+		f.Severity = SeverityMedium
+		f.Message = fmt.Sprintf("Zero requests over %d days with a %s API cache provisioned", idleDays, cache.Type)
+		f.EstimatedMonthlyWaste = pricing.MonthlyAppSyncCacheCost(string(cache.Type), s.region)
+		return f
+	}
+
+	// APPSYNC_IDLE: requests are billed per call, so an idle API costs nothing
+	f.ID = FindingAppSyncIdle
+	f.Severity = SeverityLow
+	f.Message = fmt.Sprintf("Zero requests over %d days", idleDays)
+	f.Hygiene = true
+	return f
+}
+
+// apiCache returns the API's provisioned cache, or nil when it has none.
+func (s *AppSyncScanner) apiCache(ctx context.Context, apiID string) (*appsynctypes.ApiCache, error) {
+	out, err := s.client.GetApiCache(ctx, &appsync.GetApiCacheInput{ApiId: &apiID})
+	if err != nil {
+		var notFound *appsynctypes.NotFoundException
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if out.ApiCache == nil || out.ApiCache.Status == appsynctypes.ApiCacheStatusDeleting {
+		return nil, nil
+	}
+	return out.ApiCache, nil
+}
+
+func (s *AppSyncScanner) listAPIs(ctx context.Context) ([]appsynctypes.GraphqlApi, error) {
+	var apis []appsynctypes.GraphqlApi
+	paginator := appsync.NewListGraphqlApisPaginator(s.client, &appsync.ListGraphqlApisInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, page.GraphqlApis...)
+	}
+	return apis, nil
+}
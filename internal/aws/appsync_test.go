@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	appsynctypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type mockAppSyncClient struct {
+	apis   []appsynctypes.GraphqlApi
+	caches map[string]appsynctypes.ApiCache
+}
+
+func (m *mockAppSyncClient) ListGraphqlApis(_ context.Context, _ *appsync.ListGraphqlApisInput, _ ...func(*appsync.Options)) (*appsync.ListGraphqlApisOutput, error) {
+	return &appsync.ListGraphqlApisOutput{GraphqlApis: m.apis}, nil
+}
+
+func (m *mockAppSyncClient) GetApiCache(_ context.Context, input *appsync.GetApiCacheInput, _ ...func(*appsync.Options)) (*appsync.GetApiCacheOutput, error) {
+	cache, ok := m.caches[deref(input.ApiId)]
+	if !ok {
+		return nil, &appsynctypes.NotFoundException{Message: awssdk.String("no cache")}
+	}
+	return &appsync.GetApiCacheOutput{ApiCache: &cache}, nil
+}
+
+func appSyncAPI(id string) appsynctypes.GraphqlApi {
+	return appsynctypes.GraphqlApi{
+		ApiId:              awssdk.String(id),
+		Name:               awssdk.String(id + "-api"),
+		ApiType:            appsynctypes.GraphQLApiTypeGraphql,
+		AuthenticationType: appsynctypes.AuthenticationTypeApiKey,
+	}
+}
+
+func TestAppSyncScanner_IdleAndUnusedCache(t *testing.T) {
+	mock := &mockAppSyncClient{
+		apis: []appsynctypes.GraphqlApi{
+			appSyncAPI("idle"),
+			appSyncAPI("cached"),
+			appSyncAPI("busy"),
+			appSyncAPI("rejected"),
+		},
+		caches: map[string]appsynctypes.ApiCache{
+			"cached": {
+				Type:               appsynctypes.ApiCacheTypeSmall,
+				Status:             appsynctypes.ApiCacheStatusAvailable,
+				Ttl:                300,
+				ApiCachingBehavior: appsynctypes.ApiCachingBehaviorFullRequestCaching,
+			},
+		},
+	}
+	metrics := newMetricsByName(map[string]float64{
+		"Latency/busy":      120,
+		"4XXError/rejected": 5,
+	})
+
+	scanner := NewAppSyncScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+
+	idle := byID["idle"]
+	if idle.ID != FindingAppSyncIdle || idle.EstimatedMonthlyWaste != 0 || !idle.Hygiene {
+		t.Fatalf("expected $0 APPSYNC_IDLE hygiene finding, got %s $%.2f hygiene=%t", idle.ID, idle.EstimatedMonthlyWaste, idle.Hygiene)
+	}
+	if idle.Metadata["authentication_type"] != "API_KEY" || idle.Metadata["cache_enabled"] != false {
+		t.Fatalf("unexpected metadata: %v", idle.Metadata)
+	}
+
+	cached := byID["cached"]
+	if cached.ID != FindingAppSyncUnusedCache || cached.Hygiene {
+		t.Fatalf("expected APPSYNC_UNUSED_CACHE, got %s hygiene=%t", cached.ID, cached.Hygiene)
+	}
+	// SMALL cache: $0.044 * 730 = $32.12
+	if cached.EstimatedMonthlyWaste < 32.11 || cached.EstimatedMonthlyWaste > 32.13 {
+		t.Fatalf("expected ~$32.12, got $%.2f", cached.EstimatedMonthlyWaste)
+	}
+	if cached.Metadata["cache_type"] != "SMALL" || cached.Metadata["cache_ttl_seconds"] != int64(300) {
+		t.Fatalf("unexpected metadata: %v", cached.Metadata)
+	}
+}
+
+func TestAppSyncScanner_ExcludedByTag(t *testing.T) {
+	api := appSyncAPI("keep")
+	api.Tags = map[string]string{"env": "prod"}
+	mock := &mockAppSyncClient{apis: []appsynctypes.GraphqlApi{api}}
+
+	scanner := NewAppSyncScanner(mock, newMetricsByName(nil), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"env": "prod"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
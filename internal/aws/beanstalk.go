@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// beanstalkEnvTag is the tag Elastic Beanstalk puts on every instance it launches.
+const beanstalkEnvTag = "elasticbeanstalk:environment-id"
+
+// BeanstalkAPI is the minimal interface for Elastic Beanstalk operations.
+type BeanstalkAPI interface {
+	DescribeEnvironments(ctx context.Context, input *elasticbeanstalk.DescribeEnvironmentsInput, opts ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentsOutput, error)
+	DescribeEnvironmentResources(ctx context.Context, input *elasticbeanstalk.DescribeEnvironmentResourcesInput, opts ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentResourcesOutput, error)
+}
+
+// BeanstalkInstanceAPI is the minimal EC2 interface for resolving environment instance types.
+type BeanstalkInstanceAPI interface {
+	DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+}
+
+// BeanstalkScanner detects Elastic Beanstalk environments whose instances sit idle.
+type BeanstalkScanner struct {
+	client    BeanstalkAPI
+	ec2Client BeanstalkInstanceAPI
+	metrics   *MetricsFetcher
+	region    string
+}
+
+// NewBeanstalkScanner creates a scanner for Elastic Beanstalk environments.
+func NewBeanstalkScanner(client BeanstalkAPI, ec2Client BeanstalkInstanceAPI, metrics *MetricsFetcher, region string) *BeanstalkScanner {
+	return &BeanstalkScanner{client: client, ec2Client: ec2Client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *BeanstalkScanner) Type() ResourceType {
+	return ResourceBeanstalk
+}
+
+// beanstalkEnv is a ready environment with the resources it provisioned.
+type beanstalkEnv struct {
+	env           ebtypes.EnvironmentDescription
+	instanceIDs   []string
+	loadBalancers []string
+}
+
+// Scan examines ready environments and flags those where every instance averaged CPU
+// below the idle threshold. Waste is the environment's instances plus its load balancer.
+func (s *BeanstalkScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	envs, err := s.listEnvironments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Beanstalk environments: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(envs)}
+
+	var candidates []beanstalkEnv
+	var allInstanceIDs []string
+	for _, env := range envs {
+		name := deref(env.EnvironmentName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(env.EnvironmentId), nil) {
+			continue
+		}
+		if env.Status != ebtypes.EnvironmentStatusReady {
+			continue
+		}
+
+		out, err := s.client.DescribeEnvironmentResources(ctx, &elasticbeanstalk.DescribeEnvironmentResourcesInput{
+			EnvironmentId: env.EnvironmentId,
+		})
+		if err != nil {
+			slog.Warn("Failed to describe Beanstalk environment resources", "environment", name, "error", err)
+			continue
+		}
+		if out.EnvironmentResources == nil || len(out.EnvironmentResources.Instances) == 0 {
+			continue
+		}
+
+		c := beanstalkEnv{env: env}
+		for _, inst := range out.EnvironmentResources.Instances {
+			c.instanceIDs = append(c.instanceIDs, deref(inst.Id))
+		}
+		for _, lb := range out.EnvironmentResources.LoadBalancers {
+			c.loadBalancers = append(c.loadBalancers, deref(lb.Name))
+		}
+		candidates = append(candidates, c)
+		allInstanceIDs = append(allInstanceIDs, c.instanceIDs...)
+	}
+
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	instanceTypes, err := s.instanceTypes(ctx)
+	if err != nil {
+		slog.Warn("Failed to describe Beanstalk instances", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	cpuMap, err := s.metrics.FetchAverage(ctx, "AWS/EC2", "CPUUtilization", "InstanceId", allInstanceIDs, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch Beanstalk instance CPU metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, c := range candidates {
+		maxCPU := 0.0
+		idle := true
+		for _, id := range c.instanceIDs {
+			cpu, ok := cpuMap[id]
+			if !ok || cpu >= cfg.IdleCPUFor(ResourceBeanstalk) {
+				idle = false
+				break
+			}
+			maxCPU = max(maxCPU, cpu)
+		}
+		if !idle {
+			continue
+		}
+
+		var instanceCost, lbCost float64
+		for _, id := range c.instanceIDs {
+			instanceCost += pricing.MonthlyEC2Cost(instanceTypes[id], s.region)
+		}
+		for _, lb := range c.loadBalancers {
+			lbCost += beanstalkLoadBalancerCost(lb, s.region)
+		}
+
+		env := c.env
+		meta := map[string]any{
+			"application_name":    deref(env.ApplicationName),
+			"health":              string(env.Health),
+			"health_status":       string(env.HealthStatus),
+			"solution_stack":      deref(env.SolutionStackName),
+			"instance_ids":        c.instanceIDs,
+			"load_balancers":      c.loadBalancers,
+			"max_avg_cpu_percent": maxCPU,
+			"instance_cost":       instanceCost,
+			"load_balancer_cost":  lbCost,
+		}
+		if env.Tier != nil {
+			meta["tier"] = deref(env.Tier.Name)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingBeanstalkIdleEnv,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceBeanstalk,
+			ResourceID:            deref(env.EnvironmentName),
+			ResourceName:          deref(env.EnvironmentId),
+			Region:                s.region,
+			Message:               fmt.Sprintf("All %d instances below %.0f%% CPU over %d days", len(c.instanceIDs), cfg.IdleCPUFor(ResourceBeanstalk), cfg.IdleDays),
+			EstimatedMonthlyWaste: instanceCost + lbCost,
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// instanceTypes returns the instance type of every running Beanstalk-managed instance.
+func (s *BeanstalkScanner) instanceTypes(ctx context.Context) (map[string]string, error) {
+	types := make(map[string]string)
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("tag-key"), Values: []string{beanstalkEnvTag}},
+			{Name: awssdk.String("instance-state-name"), Values: []string{"pending", "running"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page.Reservations {
+			for _, inst := range r.Instances {
+				types[deref(inst.InstanceId)] = string(inst.InstanceType)
+			}
+		}
+	}
+	return types, nil
+}
+
+func (s *BeanstalkScanner) listEnvironments(ctx context.Context) ([]ebtypes.EnvironmentDescription, error) {
+	var envs []ebtypes.EnvironmentDescription
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{IncludeDeleted: awssdk.Bool(false)}
+	for {
+		out, err := s.client.DescribeEnvironments(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, out.Environments...)
+		if out.NextToken == nil {
+			return envs, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// beanstalkLoadBalancerCost prices a load balancer by name. Beanstalk reports ALBs and
+// NLBs by ARN and Classic Load Balancers by name; Classic bills at the ALB base rate.
+func beanstalkLoadBalancerCost(name, region string) float64 {
+	if strings.Contains(name, ":loadbalancer/net/") {
+		return pricing.MonthlyNLBCost(region)
+	}
+	return pricing.MonthlyALBCost(region)
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+)
+
+type mockBeanstalkClient struct {
+	envs      []ebtypes.EnvironmentDescription
+	resources map[string]ebtypes.EnvironmentResourceDescription
+	err       error
+}
+
+func (m *mockBeanstalkClient) DescribeEnvironments(_ context.Context, _ *elasticbeanstalk.DescribeEnvironmentsInput, _ ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &elasticbeanstalk.DescribeEnvironmentsOutput{Environments: m.envs}, nil
+}
+
+func (m *mockBeanstalkClient) DescribeEnvironmentResources(_ context.Context, input *elasticbeanstalk.DescribeEnvironmentResourcesInput, _ ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentResourcesOutput, error) {
+	res := m.resources[*input.EnvironmentId]
+	return &elasticbeanstalk.DescribeEnvironmentResourcesOutput{EnvironmentResources: &res}, nil
+}
+
+func beanstalkEnvironment(id, name string, status ebtypes.EnvironmentStatus) ebtypes.EnvironmentDescription {
+	return ebtypes.EnvironmentDescription{
+		EnvironmentId:     awssdk.String(id),
+		EnvironmentName:   awssdk.String(name),
+		ApplicationName:   awssdk.String("shop"),
+		Status:            status,
+		Health:            ebtypes.EnvironmentHealthGreen,
+		HealthStatus:      ebtypes.EnvironmentHealthStatusOk,
+		SolutionStackName: awssdk.String("64bit Amazon Linux 2023 v4.0.0 running Python 3.11"),
+		Tier:              &ebtypes.EnvironmentTier{Name: awssdk.String("WebServer")},
+	}
+}
+
+func beanstalkResources(lb string, instanceIDs ...string) ebtypes.EnvironmentResourceDescription {
+	res := ebtypes.EnvironmentResourceDescription{}
+	for _, id := range instanceIDs {
+		res.Instances = append(res.Instances, ebtypes.Instance{Id: awssdk.String(id)})
+	}
+	if lb != "" {
+		res.LoadBalancers = []ebtypes.LoadBalancer{{Name: awssdk.String(lb)}}
+	}
+	return res
+}
+
+func beanstalkEC2(types map[string]ec2types.InstanceType) *mockEC2Client {
+	var instances []ec2types.Instance
+	for id, t := range types {
+		instances = append(instances, ec2types.Instance{InstanceId: awssdk.String(id), InstanceType: t})
+	}
+	return &mockEC2Client{instances: []ec2types.Reservation{{Instances: instances}}}
+}
+
+func TestBeanstalkScanner_IdleEnvironment(t *testing.T) {
+	albArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/awseb-idle/abc"
+	client := &mockBeanstalkClient{
+		envs: []ebtypes.EnvironmentDescription{
+			beanstalkEnvironment("e-idle", "shop-staging", ebtypes.EnvironmentStatusReady),
+			beanstalkEnvironment("e-busy", "shop-prod", ebtypes.EnvironmentStatusReady),
+			beanstalkEnvironment("e-updating", "shop-dev", ebtypes.EnvironmentStatusUpdating),
+		},
+		resources: map[string]ebtypes.EnvironmentResourceDescription{
+			"e-idle": beanstalkResources(albArn, "i-idle1", "i-idle2"),
+			"e-busy": beanstalkResources("awseb-busy", "i-busy1", "i-busy2"),
+		},
+	}
+	ec2Client := beanstalkEC2(map[string]ec2types.InstanceType{
+		"i-idle1": ec2types.InstanceTypeT3Small,
+		"i-idle2": ec2types.InstanceTypeT3Small,
+		"i-busy1": ec2types.InstanceTypeT3Small,
+		"i-busy2": ec2types.InstanceTypeT3Small,
+	})
+	// One busy instance keeps its environment active.
+	metrics := newMockMetricsFetcher(map[string]float64{"i-idle1": 1.2, "i-idle2": 0.8, "i-busy1": 0.5, "i-busy2": 40})
+	scanner := NewBeanstalkScanner(client, ec2Client, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingBeanstalkIdleEnv || f.ResourceID != "shop-staging" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 2 x t3.small ($0.0208/hr * 730) + ALB $16.43 = $46.80
+	if f.EstimatedMonthlyWaste < 46.79 || f.EstimatedMonthlyWaste > 46.81 {
+		t.Fatalf("expected ~$46.80, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["health"] != "Green" || f.Metadata["solution_stack"] != "64bit Amazon Linux 2023 v4.0.0 running Python 3.11" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestBeanstalkScanner_MissingMetricsNotIdle(t *testing.T) {
+	client := &mockBeanstalkClient{
+		envs:      []ebtypes.EnvironmentDescription{beanstalkEnvironment("e-1", "env-1", ebtypes.EnvironmentStatusReady)},
+		resources: map[string]ebtypes.EnvironmentResourceDescription{"e-1": beanstalkResources("", "i-1", "i-2")},
+	}
+	ec2Client := beanstalkEC2(map[string]ec2types.InstanceType{"i-1": ec2types.InstanceTypeT3Small, "i-2": ec2types.InstanceTypeT3Small})
+	scanner := NewBeanstalkScanner(client, ec2Client, newMockMetricsFetcher(map[string]float64{"i-1": 0.5}), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings when an instance has no CPU data, got %d", len(result.Findings))
+	}
+}
+
+func TestBeanstalkScanner_Excluded(t *testing.T) {
+	client := &mockBeanstalkClient{
+		envs:      []ebtypes.EnvironmentDescription{beanstalkEnvironment("e-1", "env-1", ebtypes.EnvironmentStatusReady)},
+		resources: map[string]ebtypes.EnvironmentResourceDescription{"e-1": beanstalkResources("", "i-1")},
+	}
+	ec2Client := beanstalkEC2(map[string]ec2types.InstanceType{"i-1": ec2types.InstanceTypeT3Small})
+	scanner := NewBeanstalkScanner(client, ec2Client, newMockMetricsFetcher(map[string]float64{"i-1": 0.5}), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays:         7,
+		IdleCPUThreshold: 5,
+		Exclude:          ExcludeConfig{ResourceIDs: map[string]bool{"env-1": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestBeanstalkScanner_APIError(t *testing.T) {
+	scanner := NewBeanstalkScanner(&mockBeanstalkClient{err: errors.New("denied")}, &mockEC2Client{}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -7,7 +7,11 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awspricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
 // Client wraps the AWS SDK configuration for creating service clients.
@@ -48,23 +52,103 @@ func (c *Client) ConfigForRegion(region string) aws.Config {
 	return cfg
 }
 
+// priceListRegion is a region that serves the Price List Query API, which is only
+// available in a few regions regardless of which regions are scanned.
+const priceListRegion = "us-east-1"
+
+// NewPriceListClient returns a client for the Price List Query API.
+func (c *Client) NewPriceListClient() *awspricing.Client {
+	return awspricing.NewFromConfig(c.ConfigForRegion(priceListRegion))
+}
+
+// NewCloudWatchClient returns a CloudWatch client for region.
+func (c *Client) NewCloudWatchClient(region string) *cloudwatch.Client {
+	return cloudwatch.NewFromConfig(c.ConfigForRegion(region))
+}
+
+// NewS3Client returns an S3 client for region.
+func (c *Client) NewS3Client(region string) *s3.Client {
+	return s3.NewFromConfig(c.ConfigForRegion(region))
+}
+
+// Region opt-in statuses reported by DescribeRegions.
+const (
+	optInNotRequired = "opt-in-not-required"
+	optInOptedIn     = "opted-in"
+	optInNotOptedIn  = "not-opted-in"
+)
+
+// RegionsAPI is the minimal interface for region discovery.
+type RegionsAPI interface {
+	DescribeRegions(ctx context.Context, input *ec2.DescribeRegionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+}
+
 // ListEnabledRegions returns all enabled regions for the account.
-func (c *Client) ListEnabledRegions(ctx context.Context) ([]string, error) {
-	svc := ec2.NewFromConfig(c.cfg)
-	out, err := svc.DescribeRegions(ctx, &ec2.DescribeRegionsInput{
-		AllRegions: aws.Bool(false),
+// Opted-in opt-in regions (e.g. ap-east-1, me-south-1) are included only when includeOptIn is true.
+func (c *Client) ListEnabledRegions(ctx context.Context, includeOptIn bool) ([]string, error) {
+	all, err := describeAllRegions(ctx, ec2.NewFromConfig(c.cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	regions := enabledRegions(all, includeOptIn)
+	slog.Debug("Discovered enabled regions", "count", len(regions), "include_opt_in", includeOptIn)
+	return regions, nil
+}
+
+// DisabledRegions returns the requested regions that are opt-in regions the account has not enabled.
+// Scanning them fails with authorization errors, so callers should warn about them.
+func (c *Client) DisabledRegions(ctx context.Context, requested []string) ([]string, error) {
+	all, err := describeAllRegions(ctx, ec2.NewFromConfig(c.cfg))
+	if err != nil {
+		return nil, err
+	}
+	return notOptedInRegions(all, requested), nil
+}
+
+// describeAllRegions lists every region with its opt-in status.
+// AllRegions is required: without it DescribeRegions hides not-opted-in regions entirely.
+func describeAllRegions(ctx context.Context, api RegionsAPI) ([]ec2types.Region, error) {
+	out, err := api.DescribeRegions(ctx, &ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("describe regions: %w", err)
 	}
+	return out.Regions, nil
+}
 
-	regions := make([]string, 0, len(out.Regions))
-	for _, r := range out.Regions {
-		if r.RegionName != nil {
+func enabledRegions(all []ec2types.Region, includeOptIn bool) []string {
+	regions := make([]string, 0, len(all))
+	for _, r := range all {
+		if r.RegionName == nil {
+			continue
+		}
+		switch deref(r.OptInStatus) {
+		case optInNotRequired, "":
 			regions = append(regions, *r.RegionName)
+		case optInOptedIn:
+			if includeOptIn {
+				regions = append(regions, *r.RegionName)
+			}
 		}
 	}
+	return regions
+}
 
-	slog.Debug("Discovered enabled regions", "count", len(regions))
-	return regions, nil
+func notOptedInRegions(all []ec2types.Region, requested []string) []string {
+	status := make(map[string]string, len(all))
+	for _, r := range all {
+		if r.RegionName != nil {
+			status[*r.RegionName] = deref(r.OptInStatus)
+		}
+	}
+
+	var disabled []string
+	for _, region := range requested {
+		if status[region] == optInNotOptedIn {
+			disabled = append(disabled, region)
+		}
+	}
+	return disabled
 }
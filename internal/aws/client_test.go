@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockRegionsClient struct {
+	regions []ec2types.Region
+	input   *ec2.DescribeRegionsInput
+}
+
+func (m *mockRegionsClient) DescribeRegions(_ context.Context, input *ec2.DescribeRegionsInput, _ ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	m.input = input
+	return &ec2.DescribeRegionsOutput{Regions: m.regions}, nil
+}
+
+func regionsFixture() []ec2types.Region {
+	region := func(name, status string) ec2types.Region {
+		return ec2types.Region{RegionName: awssdk.String(name), OptInStatus: awssdk.String(status)}
+	}
+	return []ec2types.Region{
+		region("us-east-1", "opt-in-not-required"),
+		region("eu-west-1", "opt-in-not-required"),
+		region("ap-east-1", "opted-in"),
+		region("me-south-1", "not-opted-in"),
+		region("af-south-1", "not-opted-in"),
+	}
+}
+
+func TestDescribeAllRegions_RequestsAllRegions(t *testing.T) {
+	mock := &mockRegionsClient{regions: regionsFixture()}
+	regions, err := describeAllRegions(context.Background(), mock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.input.AllRegions == nil || !*mock.input.AllRegions {
+		t.Fatal("expected DescribeRegions to be called with AllRegions=true")
+	}
+	if len(regions) != 5 {
+		t.Fatalf("expected 5 regions, got %d", len(regions))
+	}
+}
+
+func TestEnabledRegions_OptInStatuses(t *testing.T) {
+	tests := []struct {
+		name         string
+		includeOptIn bool
+		want         []string
+	}{
+		{"include opted-in", true, []string{"us-east-1", "eu-west-1", "ap-east-1"}},
+		{"default regions only", false, []string{"us-east-1", "eu-west-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := enabledRegions(regionsFixture(), tt.includeOptIn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNotOptedInRegions(t *testing.T) {
+	got := notOptedInRegions(regionsFixture(), []string{"us-east-1", "ap-east-1", "me-south-1"})
+	if !reflect.DeepEqual(got, []string{"me-south-1"}) {
+		t.Fatalf("expected [me-south-1], got %v", got)
+	}
+}
@@ -173,8 +173,13 @@ func cloudFrontOldEnoughForIdle(distribution cftypes.DistributionSummary, lookba
 
 func cloudFrontMetadata(distribution cftypes.DistributionSummary) map[string]any {
 	metadata := map[string]any{
-		"domain_name": awssdk.ToString(distribution.DomainName),
-		"status":      awssdk.ToString(distribution.Status),
+		"domain_name":  awssdk.ToString(distribution.DomainName),
+		"status":       awssdk.ToString(distribution.Status),
+		"enabled":      awssdk.ToBool(distribution.Enabled),
+		"origin_count": 0,
+	}
+	if distribution.Origins != nil {
+		metadata["origin_count"] = int(awssdk.ToInt32(distribution.Origins.Quantity))
 	}
 	if distribution.LastModifiedTime != nil {
 		metadata["last_modified"] = distribution.LastModifiedTime.UTC().Format(time.RFC3339)
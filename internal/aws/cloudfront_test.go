@@ -358,6 +358,10 @@ func cloudFrontDistributionWithLastModified(id string, enabled bool, lastModifie
 		Aliases: &cftypes.Aliases{
 			Items: []string{id + ".example.com"},
 		},
+		Origins: &cftypes.Origins{
+			Quantity: awssdk.Int32(1),
+			Items:    []cftypes.Origin{{Id: awssdk.String(id + "-origin"), DomainName: awssdk.String(id + ".s3.amazonaws.com")}},
+		},
 	}
 }
 
@@ -413,6 +417,12 @@ func assertCloudFrontFinding(t *testing.T, finding Finding, id FindingID, severi
 	if finding.Metadata["status"] == "" {
 		t.Fatalf("expected status metadata")
 	}
+	if _, ok := finding.Metadata["enabled"].(bool); !ok {
+		t.Fatalf("expected enabled metadata")
+	}
+	if finding.Metadata["origin_count"] != 1 {
+		t.Fatalf("expected origin_count 1, got %v", finding.Metadata["origin_count"])
+	}
 }
 
 func assertCloudFrontMetricQuery(t *testing.T, input *cloudwatch.GetMetricDataInput, distributionID string) {
@@ -51,7 +51,46 @@ func (f *MetricsFetcher) FetchSumWithStaticDim(ctx context.Context, namespace, m
 	return f.fetchMetric(ctx, namespace, metricName, dimensionName, ids, lookbackDays, "Sum", staticDims)
 }
 
+// FetchAverageWithStaticDim retrieves the average of a metric with per-resource and static dimensions.
+func (f *MetricsFetcher) FetchAverageWithStaticDim(ctx context.Context, namespace, metricName, dimensionName string, ids []string, lookbackDays int, staticDims []cwtypes.Dimension) (map[string]float64, error) {
+	return f.fetchMetric(ctx, namespace, metricName, dimensionName, ids, lookbackDays, "Average", staticDims)
+}
+
+// MetricPoint is a single hourly datapoint of a metric series.
+type MetricPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// FetchSeries retrieves the hourly datapoints of a metric for a set of resource IDs,
+// oldest first. Returns a map of resource ID to its series.
+func (f *MetricsFetcher) FetchSeries(ctx context.Context, namespace, metricName, dimensionName string, ids []string, lookbackDays int, stat string) (map[string][]MetricPoint, error) {
+	return f.fetchPoints(ctx, namespace, metricName, dimensionName, ids, lookbackDays, stat, nil)
+}
+
 func (f *MetricsFetcher) fetchMetric(ctx context.Context, namespace, metricName, dimensionName string, ids []string, lookbackDays int, stat string, staticDims []cwtypes.Dimension) (map[string]float64, error) {
+	series, err := f.fetchPoints(ctx, namespace, metricName, dimensionName, ids, lookbackDays, stat, staticDims)
+	if err != nil || series == nil {
+		return nil, err
+	}
+
+	results := make(map[string]float64, len(series))
+	for id, points := range series {
+		// Compute the aggregate (average of averages, or total sum)
+		var total float64
+		for _, p := range points {
+			total += p.Value
+		}
+		if stat == "Average" {
+			results[id] = total / float64(len(points))
+		} else {
+			results[id] = total
+		}
+	}
+	return results, nil
+}
+
+func (f *MetricsFetcher) fetchPoints(ctx context.Context, namespace, metricName, dimensionName string, ids []string, lookbackDays int, stat string, staticDims []cwtypes.Dimension) (map[string][]MetricPoint, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -59,7 +98,7 @@ func (f *MetricsFetcher) fetchMetric(ctx context.Context, namespace, metricName,
 	now := time.Now().UTC()
 	startTime := now.Add(-time.Duration(lookbackDays) * 24 * time.Hour)
 
-	results := make(map[string]float64, len(ids))
+	results := make(map[string][]MetricPoint, len(ids))
 	batches := batchIDs(ids, maxMetricDataQueries)
 
 	for batchIdx, batch := range batches {
@@ -93,6 +132,7 @@ func (f *MetricsFetcher) fetchMetric(ctx context.Context, namespace, metricName,
 			MetricDataQueries: queries,
 			StartTime:         awssdk.Time(startTime),
 			EndTime:           awssdk.Time(now),
+			ScanBy:            cwtypes.ScanByTimestampAscending,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("get metric data (%s/%s): %w", namespace, metricName, err)
@@ -112,16 +152,14 @@ func (f *MetricsFetcher) fetchMetric(ctx context.Context, namespace, metricName,
 				continue
 			}
 
-			// Compute the aggregate (average of averages, or total sum)
-			var total float64
-			for _, v := range result.Values {
-				total += v
-			}
-			if stat == "Average" && len(result.Values) > 0 {
-				results[batch[idx]] = total / float64(len(result.Values))
-			} else {
-				results[batch[idx]] = total
+			points := make([]MetricPoint, len(result.Values))
+			for i, v := range result.Values {
+				points[i].Value = v
+				if i < len(result.Timestamps) {
+					points[i].Timestamp = result.Timestamps[i]
+				}
 			}
+			results[batch[idx]] = points
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
@@ -74,6 +75,38 @@ func TestMetricsFetcher_FetchSum(t *testing.T) {
 	}
 }
 
+func TestMetricsFetcher_FetchSeries(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var gotScanBy cwtypes.ScanBy
+	mock := &mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			gotScanBy = input.ScanBy
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: []cwtypes.MetricDataResult{{
+				Id:         awssdk.String("m0"),
+				Values:     []float64{1, 0},
+				Timestamps: []time.Time{t0, t0.Add(time.Hour)},
+			}}}, nil
+		},
+	}
+
+	fetcher := NewMetricsFetcher(mock)
+	result, err := fetcher.FetchSeries(context.Background(), "AWS/Redshift", "DatabaseConnections", "ClusterIdentifier", []string{"c-001"}, 7, "Maximum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotScanBy != cwtypes.ScanByTimestampAscending {
+		t.Fatalf("expected ascending scan order, got %q", gotScanBy)
+	}
+
+	points := result["c-001"]
+	if len(points) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(points))
+	}
+	if points[1].Value != 0 || !points[1].Timestamp.Equal(t0.Add(time.Hour)) {
+		t.Fatalf("unexpected second point: %+v", points[1])
+	}
+}
+
 func TestMetricsFetcher_FetchSumWithStaticDim(t *testing.T) {
 	var captured *cloudwatch.GetMetricDataInput
 	mock := &mockCloudWatchClient{
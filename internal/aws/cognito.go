@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	cognitotypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+)
+
+// cognitoNearZeroMAU is the monthly active user estimate at or below which a
+// pool without sign-ins is still considered unused.
+const cognitoNearZeroMAU = 1.0
+
+// CognitoAPI is the minimal interface for Cognito user pool operations.
+type CognitoAPI interface {
+	ListUserPools(ctx context.Context, input *cognitoidentityprovider.ListUserPoolsInput, opts ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolsOutput, error)
+	DescribeUserPool(ctx context.Context, input *cognitoidentityprovider.DescribeUserPoolInput, opts ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolOutput, error)
+	ListUserPoolClients(ctx context.Context, input *cognitoidentityprovider.ListUserPoolClientsInput, opts ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolClientsOutput, error)
+}
+
+// CognitoScanner detects Cognito user pools that nobody signs in to.
+type CognitoScanner struct {
+	client  CognitoAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewCognitoScanner creates a scanner for Cognito user pools.
+func NewCognitoScanner(client CognitoAPI, metrics *MetricsFetcher, region string) *CognitoScanner {
+	return &CognitoScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *CognitoScanner) Type() ResourceType {
+	return ResourceCognito
+}
+
+// Scan examines user pools older than the stale window for zero sign-ins across
+// all app clients. Cognito bills per monthly active user, so an unused pool costs
+// nothing and is reported as hygiene; pools with advanced security enabled are
+// flagged in metadata because that add-on is the part that bills per MAU.
+func (s *CognitoScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	pools, err := s.listUserPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Cognito user pools: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(pools)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, p := range pools {
+		id := deref(p.Id)
+		if cfg.Exclude.ShouldExclude(id, nil) {
+			continue
+		}
+		if p.CreationDate != nil && p.CreationDate.After(cutoff) {
+			continue
+		}
+
+		clientIDs, err := s.listClientIDs(ctx, id)
+		if err != nil {
+			slog.Warn("Failed to list Cognito app clients", "user_pool", id, "error", err)
+			continue
+		}
+
+		signIns, signUps, err := s.fetchActivity(ctx, id, clientIDs, cfg.StaleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch Cognito activity metrics", "user_pool", id, "error", err)
+			continue
+		}
+		if signIns > 0 {
+			continue
+		}
+		// Without sign-ins, the only users active in a month are new sign-ups.
+		estimatedMAU := signUps * 30 / float64(max(cfg.StaleDays, 1))
+		if estimatedMAU > cognitoNearZeroMAU {
+			continue
+		}
+
+		out, err := s.client.DescribeUserPool(ctx, &cognitoidentityprovider.DescribeUserPoolInput{UserPoolId: p.Id})
+		if err != nil {
+			slog.Warn("Failed to describe Cognito user pool", "user_pool", id, "error", err)
+			continue
+		}
+		pool := out.UserPool
+		if pool == nil || cfg.Exclude.ShouldExclude(id, pool.UserPoolTags) {
+			continue
+		}
+
+		securityMode := string(cognitotypes.AdvancedSecurityModeTypeOff)
+		if pool.UserPoolAddOns != nil {
+			securityMode = string(pool.UserPoolAddOns.AdvancedSecurityMode)
+		}
+		meta := map[string]any{
+			"estimated_mau":          math.Round(estimatedMAU*10) / 10,
+			"estimated_users":        int(pool.EstimatedNumberOfUsers),
+			"app_client_count":       len(clientIDs),
+			"advanced_security_mode": securityMode,
+		}
+		if pool.CreationDate != nil {
+			meta["created_date"] = pool.CreationDate.UTC().Format(time.RFC3339)
+		}
+		setTagsMetadata(meta, pool.UserPoolTags)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:           FindingCognitoUnusedPool,
+			Severity:     SeverityLow,
+			ResourceType: ResourceCognito,
+			ResourceID:   id,
+			ResourceName: deref(p.Name),
+			Region:       s.region,
+			Message:      fmt.Sprintf("Zero sign-ins over %d days across %d app clients", cfg.StaleDays, len(clientIDs)),
+			Hygiene:      true,
+			Metadata:     meta,
+		})
+	}
+
+	return result, nil
+}
+
+// fetchActivity returns the pool's total sign-in and sign-up successes. Cognito
+// publishes both per app client, with the pool ID as a second dimension.
+func (s *CognitoScanner) fetchActivity(ctx context.Context, poolID string, clientIDs []string, days int) (float64, float64, error) {
+	if len(clientIDs) == 0 {
+		return 0, 0, nil
+	}
+	poolDim := []cwtypes.Dimension{{Name: awssdk.String("UserPool"), Value: awssdk.String(poolID)}}
+
+	var totals [2]float64
+	for i, metric := range []string{"SignInSuccesses", "SignUpSuccesses"} {
+		sums, err := s.metrics.FetchSumWithStaticDim(ctx, "AWS/Cognito", metric, "UserPoolClient", clientIDs, days, poolDim)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, v := range sums {
+			totals[i] += v
+		}
+	}
+	return totals[0], totals[1], nil
+}
+
+func (s *CognitoScanner) listClientIDs(ctx context.Context, poolID string) ([]string, error) {
+	var ids []string
+	paginator := cognitoidentityprovider.NewListUserPoolClientsPaginator(s.client, &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: awssdk.String(poolID),
+		MaxResults: awssdk.Int32(60),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.UserPoolClients {
+			ids = append(ids, deref(c.ClientId))
+		}
+	}
+	return ids, nil
+}
+
+func (s *CognitoScanner) listUserPools(ctx context.Context) ([]cognitotypes.UserPoolDescriptionType, error) {
+	var pools []cognitotypes.UserPoolDescriptionType
+	paginator := cognitoidentityprovider.NewListUserPoolsPaginator(s.client, &cognitoidentityprovider.ListUserPoolsInput{
+		MaxResults: awssdk.Int32(60),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, page.UserPools...)
+	}
+	return pools, nil
+}
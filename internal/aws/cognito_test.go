@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	cognitotypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+)
+
+type mockCognitoClient struct {
+	pools   []cognitotypes.UserPoolType
+	clients map[string][]string
+}
+
+func (m *mockCognitoClient) ListUserPools(_ context.Context, _ *cognitoidentityprovider.ListUserPoolsInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolsOutput, error) {
+	var out []cognitotypes.UserPoolDescriptionType
+	for _, p := range m.pools {
+		out = append(out, cognitotypes.UserPoolDescriptionType{Id: p.Id, Name: p.Name, CreationDate: p.CreationDate})
+	}
+	return &cognitoidentityprovider.ListUserPoolsOutput{UserPools: out}, nil
+}
+
+func (m *mockCognitoClient) DescribeUserPool(_ context.Context, input *cognitoidentityprovider.DescribeUserPoolInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolOutput, error) {
+	for _, p := range m.pools {
+		if deref(p.Id) == deref(input.UserPoolId) {
+			return &cognitoidentityprovider.DescribeUserPoolOutput{UserPool: &p}, nil
+		}
+	}
+	return nil, fmt.Errorf("ResourceNotFoundException")
+}
+
+func (m *mockCognitoClient) ListUserPoolClients(_ context.Context, input *cognitoidentityprovider.ListUserPoolClientsInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolClientsOutput, error) {
+	var out []cognitotypes.UserPoolClientDescription
+	for _, id := range m.clients[deref(input.UserPoolId)] {
+		out = append(out, cognitotypes.UserPoolClientDescription{ClientId: awssdk.String(id), UserPoolId: input.UserPoolId})
+	}
+	return &cognitoidentityprovider.ListUserPoolClientsOutput{UserPoolClients: out}, nil
+}
+
+func cognitoPool(id string, age time.Duration, mode cognitotypes.AdvancedSecurityModeType) cognitotypes.UserPoolType {
+	return cognitotypes.UserPoolType{
+		Id:                     awssdk.String(id),
+		Name:                   awssdk.String(id + "-name"),
+		CreationDate:           awssdk.Time(time.Now().Add(-age)),
+		EstimatedNumberOfUsers: 42,
+		UserPoolAddOns:         &cognitotypes.UserPoolAddOnsType{AdvancedSecurityMode: mode},
+	}
+}
+
+func TestCognitoScanner_UnusedPool(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockCognitoClient{
+		pools: []cognitotypes.UserPoolType{
+			cognitoPool("unused", 200*day, cognitotypes.AdvancedSecurityModeTypeEnforced),
+			cognitoPool("signins", 200*day, cognitotypes.AdvancedSecurityModeTypeOff),
+			cognitoPool("signups", 200*day, cognitotypes.AdvancedSecurityModeTypeOff),
+			cognitoPool("new", 10*day, cognitotypes.AdvancedSecurityModeTypeOff),
+		},
+		clients: map[string][]string{
+			"unused":  {"c-unused"},
+			"signins": {"c-web", "c-mobile"},
+			"signups": {"c-signups"},
+			"new":     {"c-new"},
+		},
+	}
+	metrics := newMetricsByName(map[string]float64{
+		"SignInSuccesses/c-mobile":  3,
+		"SignUpSuccesses/c-signups": 30,
+	})
+
+	scanner := NewCognitoScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingCognitoUnusedPool || f.ResourceID != "unused" || f.ResourceName != "unused-name" {
+		t.Fatalf("unexpected finding: %s %s %s", f.ID, f.ResourceID, f.ResourceName)
+	}
+	if f.EstimatedMonthlyWaste != 0 || !f.Hygiene {
+		t.Fatalf("expected $0 hygiene finding, got $%.2f hygiene=%t", f.EstimatedMonthlyWaste, f.Hygiene)
+	}
+	if f.Metadata["advanced_security_mode"] != "ENFORCED" || f.Metadata["estimated_users"] != 42 || f.Metadata["estimated_mau"] != 0.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if f.Metadata["created_date"] == nil {
+		t.Fatal("expected created_date metadata")
+	}
+}
+
+func TestCognitoScanner_NoAppClients(t *testing.T) {
+	mock := &mockCognitoClient{
+		pools: []cognitotypes.UserPoolType{cognitoPool("orphan", 200*24*time.Hour, "")},
+	}
+
+	scanner := NewCognitoScanner(mock, newMetricsByName(nil), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	if result.Findings[0].Metadata["app_client_count"] != 0 {
+		t.Fatalf("expected app_client_count 0, got %v", result.Findings[0].Metadata["app_client_count"])
+	}
+}
+
+func TestCognitoScanner_ExcludedByTag(t *testing.T) {
+	pool := cognitoPool("keep", 200*24*time.Hour, cognitotypes.AdvancedSecurityModeTypeOff)
+	pool.UserPoolTags = map[string]string{"env": "prod"}
+	mock := &mockCognitoClient{
+		pools:   []cognitotypes.UserPoolType{pool},
+		clients: map[string][]string{"keep": {"c-keep"}},
+	}
+
+	scanner := NewCognitoScanner(mock, newMetricsByName(nil), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{Tags: map[string]string{"env": "prod"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,35 @@
+package aws
+
+// applyReservedCoverage marks idle findings whose instance an active reservation pays
+// for. A reservation is billed whether or not a matching instance runs, so deleting an
+// idle instance saves its on-demand cost only while more instances of its kind run
+// than are reserved. keyOf returns the reservation key of a finding (for example the
+// instance type), or false for findings reservations do not apply to; running and
+// reserved count instances and reservations per key.
+//
+// Findings beyond the surplus keep their on-demand cost in on_demand_monthly_cost
+// metadata and are reported as $0 hygiene findings, since the reservation could
+// still be reassigned to a workload that needs it.
+func applyReservedCoverage(findings []Finding, keyOf func(Finding) (string, bool), running, reserved map[string]int) {
+	surplus := make(map[string]int, len(running))
+	for key, n := range running {
+		surplus[key] = n - reserved[key]
+	}
+
+	for i := range findings {
+		f := &findings[i]
+		key, ok := keyOf(*f)
+		if !ok || reserved[key] == 0 {
+			continue
+		}
+		if surplus[key] > 0 {
+			surplus[key]--
+			f.Metadata["covered_by_commitment"] = false
+			continue
+		}
+		f.Metadata["covered_by_commitment"] = true
+		f.Metadata["on_demand_monthly_cost"] = f.EstimatedMonthlyWaste
+		f.EstimatedMonthlyWaste = 0
+		f.Hygiene = true
+	}
+}
@@ -0,0 +1,84 @@
+package aws
+
+// costUncertainty is the relative spread below and above a point estimate.
+type costUncertainty struct {
+	low  float64
+	high float64
+}
+
+// defaultCostUncertainty applies to findings without a more specific entry.
+var defaultCostUncertainty = costUncertainty{low: 0.20, high: 0.20}
+
+// findingCostUncertainty reflects how each finding's estimate is derived: fixed hourly
+// rates are tight, metric-extrapolated and size-derived estimates are wide.
+var findingCostUncertainty = map[FindingID]costUncertainty{
+	FindingUnusedEIP:                 {low: 0.02, high: 0.02},
+	FindingIdleNATGateway:            {low: 0.05, high: 0.05},
+	FindingIdleTGWPeering:            {low: 0.05, high: 0.05},
+	FindingTGWIdleAttachment:         {low: 0.05, high: 0.05},
+	FindingVPNIdle:                   {low: 0.05, high: 0.05},
+	FindingEKSEmptyCluster:           {low: 0.02, high: 0.02},
+	FindingGAIdle:                    {low: 0.02, high: 0.02}, // fixed fee only; idle accelerators carry no data transfer
+	FindingSecretUnused:              {low: 0.02, high: 0.02},
+	FindingKMSUnusedKey:              {low: 0, high: 1.0}, // each rotated key version adds another $1, up to two
+	FindingDetachedEBS:               {low: 0.05, high: 0.05},
+	FindingEBSGp2ToGp3:               {low: 0.50, high: 0}, // volumes over 1 TiB may need extra gp3 IOPS to match gp2
+	FindingEBSOverProvisionedIOPS:    {low: 0.50, high: 0}, // hourly peaks hide bursts; some headroom above them is worth keeping
+	FindingStoppedEC2:                {low: 0.05, high: 0.05},
+	FindingKinesisOverProvisioned:    {low: 0.05, high: 0.05},
+	FindingKinesisStreamIdle:         {low: 0.05, high: 0.05},
+	FindingKinesisFirehoseIdle:       {low: 0.05, high: 0.05},
+	FindingIdleEC2:                   {low: 0.10, high: 0.10},
+	FindingRightsizeEC2:              {low: 0.05, high: 0.05},
+	FindingOldGenerationEC2:          {low: 0.05, high: 0.05},
+	FindingEC2SpotCandidate:          {low: 0.30, high: 0.30}, // Spot prices move; the average hides AZ spread
+	FindingIdleRDS:                   {low: 0.10, high: 0.10},
+	FindingDocDBIdle:                 {low: 0.10, high: 0.10},
+	FindingNeptuneIdle:               {low: 0.10, high: 0.10},
+	FindingIdleALB:                   {low: 0.05, high: 0.15}, // LCUs priced from the lookback average
+	FindingIdleNLB:                   {low: 0.05, high: 0.15},
+	FindingIdleGWLB:                  {low: 0.05, high: 0.15},
+	FindingLowTrafficNATGateway:      {low: 0.20, high: 0.20},
+	FindingNATGatewayConsolidation:   {low: 0.10, high: 0.10}, // inter-AZ transfer is extrapolated from the idle window
+	FindingRDSUnnecessaryMonitoring:  {low: 0.20, high: 0.20},
+	FindingRDSIdleReadReplica:        {low: 0.10, high: 0.10},
+	FindingLambdaIdleConcurrency:     {low: 0.05, high: 0.05},
+	FindingLambdaOverProvisioned:     {low: 0.60, high: 0}, // arm64 bills 20% less per GB-second; CPU-bound functions may run longer
+	FindingRDSStorageOverProvisioned: {low: 0.50, high: 0}, // a smaller allocation still needs headroom above used storage
+	FindingStaleSnapshot:             {low: 0.50, high: 1}, // incremental share is a fixed-fraction guess
+	FindingRDSStaleSnapshot:          {low: 0.50, high: 0}, // allocated storage is an upper bound on snapshot size
+	FindingS3NoLifecycle:             {low: 0.60, high: 0}, // full Standard cost; lifecycle rules recover only part of it
+	FindingS3StorageClassOpportunity: {low: 0.30, high: 0}, // IA retrieval fees offset part of the storage saving
+	FindingEFSNoLifecycle:            {low: 0.60, high: 0},
+	FindingRoute53UnusedHealthCheck:  {low: 0, high: 0.50}, // basic AWS-endpoint rate; other endpoints and options cost more
+	FindingECRStaleImages:            {low: 0.50, high: 0}, // image sizes double-count layers shared between images
+	FindingLogsNoRetention:           {low: 0.50, high: 0}, // a retention policy removes only data older than its window
+	FindingRoute53EmptyZone:          {low: 0.80, high: 0}, // zones beyond the first 25 bill at $0.10
+	FindingPublicIPv4Unneeded:        {low: 0.02, high: 0.02},
+	FindingStaleAMI:                  {low: 0.60, high: 0}, // backing volume sizes are an upper bound; snapshots are incremental
+	FindingDMSIdle:                   {low: 0.05, high: 0.05},
+	FindingMQIdle:                    {low: 0.05, high: 0.05},
+	FindingMemoryDBIdle:              {low: 0.05, high: 0.05},
+	FindingRDSProxyIdle:              {low: 0.20, high: 0.20}, // vCPUs inferred from target instance class names
+	FindingOrphanedAlarm:             {low: 0.02, high: 0.02},
+	FindingUnusedDashboard:           {low: 0.02, high: 0.02},
+	FindingAppSyncUnusedCache:        {low: 0.05, high: 0.05},
+	FindingTimestreamIdle:            {low: 0.30, high: 0.30}, // storage sizes averaged over the window; the memory store drains as data ages out
+	FindingAOSSIdle:                  {low: 0.50, high: 0},    // collections sharing a KMS key also share their minimum OCUs
+}
+
+// applyCostRange fills the low/high bounds of a finding that has not set its own range.
+func applyCostRange(f *Finding) {
+	if f.EstimatedMonthlyWasteLow != 0 || f.EstimatedMonthlyWasteHigh != 0 {
+		return
+	}
+	if f.EstimatedMonthlyWaste == 0 {
+		return
+	}
+	u, ok := findingCostUncertainty[f.ID]
+	if !ok {
+		u = defaultCostUncertainty
+	}
+	f.EstimatedMonthlyWasteLow = f.EstimatedMonthlyWaste * (1 - u.low)
+	f.EstimatedMonthlyWasteHigh = f.EstimatedMonthlyWaste * (1 + u.high)
+}
@@ -0,0 +1,47 @@
+package aws
+
+import "testing"
+
+func TestApplyCostRange_BracketsPointEstimate(t *testing.T) {
+	ids := []FindingID{
+		FindingUnusedEIP, FindingIdleNATGateway, FindingIdleEC2, FindingIdleALB,
+		FindingLowTrafficNATGateway, FindingStaleSnapshot, "FUTURE_FINDING",
+	}
+	for _, id := range ids {
+		f := Finding{ID: id, EstimatedMonthlyWaste: 45}
+		applyCostRange(&f)
+		if f.EstimatedMonthlyWasteLow > f.EstimatedMonthlyWaste || f.EstimatedMonthlyWasteHigh < f.EstimatedMonthlyWaste {
+			t.Fatalf("%s: range $%.2f–$%.2f does not bracket $%.2f", id, f.EstimatedMonthlyWasteLow, f.EstimatedMonthlyWasteHigh, f.EstimatedMonthlyWaste)
+		}
+		if f.EstimatedMonthlyWasteHigh == 0 {
+			t.Fatalf("%s: expected range to be set", id)
+		}
+	}
+}
+
+func TestApplyCostRange_FixedCostTighterThanExtrapolated(t *testing.T) {
+	eip := Finding{ID: FindingUnusedEIP, EstimatedMonthlyWaste: 100}
+	nat := Finding{ID: FindingLowTrafficNATGateway, EstimatedMonthlyWaste: 100}
+	applyCostRange(&eip)
+	applyCostRange(&nat)
+
+	eipSpread := eip.EstimatedMonthlyWasteHigh - eip.EstimatedMonthlyWasteLow
+	natSpread := nat.EstimatedMonthlyWasteHigh - nat.EstimatedMonthlyWasteLow
+	if eipSpread >= natSpread {
+		t.Fatalf("expected EIP spread ($%.2f) to be tighter than NAT traffic spread ($%.2f)", eipSpread, natSpread)
+	}
+}
+
+func TestApplyCostRange_KeepsScannerRangeAndSkipsZeroWaste(t *testing.T) {
+	f := Finding{ID: FindingIdleEC2, EstimatedMonthlyWaste: 50, EstimatedMonthlyWasteLow: 40, EstimatedMonthlyWasteHigh: 70}
+	applyCostRange(&f)
+	if f.EstimatedMonthlyWasteLow != 40 || f.EstimatedMonthlyWasteHigh != 70 {
+		t.Fatalf("expected scanner-provided range to be kept, got $%.2f–$%.2f", f.EstimatedMonthlyWasteLow, f.EstimatedMonthlyWasteHigh)
+	}
+
+	hygiene := Finding{ID: FindingIdleLambda, Hygiene: true}
+	applyCostRange(&hygiene)
+	if hygiene.EstimatedMonthlyWasteLow != 0 || hygiene.EstimatedMonthlyWasteHigh != 0 {
+		t.Fatal("expected zero-waste finding to have no range")
+	}
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	dashboardFindingRegion = "global"
+	// dashboardFreeTier is the number of dashboards per account that carry no charge.
+	dashboardFreeTier = 3
+)
+
+// DashboardAPI is the minimal interface for CloudWatch dashboard operations.
+type DashboardAPI interface {
+	ListDashboards(ctx context.Context, input *cloudwatch.ListDashboardsInput, opts ...func(*cloudwatch.Options)) (*cloudwatch.ListDashboardsOutput, error)
+	GetDashboard(ctx context.Context, input *cloudwatch.GetDashboardInput, opts ...func(*cloudwatch.Options)) (*cloudwatch.GetDashboardOutput, error)
+}
+
+// DashboardScanner detects CloudWatch dashboards that are empty or untouched.
+// Dashboards are account-wide, so the scanner runs once outside the per-region loop.
+type DashboardScanner struct {
+	client DashboardAPI
+}
+
+// NewDashboardScanner creates a scanner for CloudWatch dashboards.
+func NewDashboardScanner(client DashboardAPI) *DashboardScanner {
+	return &DashboardScanner{client: client}
+}
+
+// Type returns the resource type.
+func (s *DashboardScanner) Type() ResourceType {
+	return ResourceDashboard
+}
+
+// Scan flags dashboards with no widgets or not modified within StaleDays. Only
+// dashboards beyond the free tier are billed, so waste is assigned to at most
+// (total - 3) findings, oldest first; the rest are reported as hygiene.
+func (s *DashboardScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	dashboards, err := s.listDashboards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list CloudWatch dashboards: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(dashboards)}
+
+	type unusedDashboard struct {
+		entry   cwtypes.DashboardEntry
+		widgets int
+		reason  string
+	}
+	var unused []unusedDashboard
+
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+	for _, d := range dashboards {
+		name := deref(d.DashboardName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(d.DashboardArn), nil) {
+			continue
+		}
+
+		widgets, err := s.widgetCount(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to read CloudWatch dashboard", "dashboard", name, "error", err)
+			widgets = -1
+		}
+
+		modified := awssdk.ToTime(d.LastModified)
+		switch {
+		case widgets == 0:
+			unused = append(unused, unusedDashboard{entry: d, widgets: widgets, reason: "has no widgets"})
+		case !modified.IsZero() && modified.Before(cutoff):
+			unused = append(unused, unusedDashboard{entry: d, widgets: widgets,
+				reason: fmt.Sprintf("not modified in %d days", int(time.Since(modified).Hours()/24))})
+		}
+	}
+
+	sort.SliceStable(unused, func(i, j int) bool {
+		return awssdk.ToTime(unused[i].entry.LastModified).Before(awssdk.ToTime(unused[j].entry.LastModified))
+	})
+	billable := max(len(dashboards)-dashboardFreeTier, 0)
+
+	for i, u := range unused {
+		var cost float64
+		if i < billable {
+			cost = pricing.MonthlyDashboardCost()
+		}
+		name := deref(u.entry.DashboardName)
+		lastModified := ""
+		if u.entry.LastModified != nil {
+			lastModified = u.entry.LastModified.UTC().Format(time.RFC3339)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingUnusedDashboard,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceDashboard,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                dashboardFindingRegion,
+			Message:               fmt.Sprintf("Dashboard %q %s", name, u.reason),
+			EstimatedMonthlyWaste: cost,
+			Hygiene:               cost == 0, // within the free tier
+			Metadata: map[string]any{
+				"last_modified":    lastModified,
+				"widget_count":     u.widgets,
+				"total_dashboards": len(dashboards),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// widgetCount returns the number of widgets in a dashboard's body.
+func (s *DashboardScanner) widgetCount(ctx context.Context, name string) (int, error) {
+	out, err := s.client.GetDashboard(ctx, &cloudwatch.GetDashboardInput{DashboardName: &name})
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Widgets []json.RawMessage `json:"widgets"`
+	}
+	if err := json.Unmarshal([]byte(deref(out.DashboardBody)), &body); err != nil {
+		return 0, fmt.Errorf("parse dashboard body: %w", err)
+	}
+	return len(body.Widgets), nil
+}
+
+func (s *DashboardScanner) listDashboards(ctx context.Context) ([]cwtypes.DashboardEntry, error) {
+	var dashboards []cwtypes.DashboardEntry
+	paginator := cloudwatch.NewListDashboardsPaginator(s.client, &cloudwatch.ListDashboardsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dashboards = append(dashboards, page.DashboardEntries...)
+	}
+	return dashboards, nil
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+type mockDashboardClient struct {
+	dashboards []cwtypes.DashboardEntry
+	bodies     map[string]string
+}
+
+func (m *mockDashboardClient) ListDashboards(_ context.Context, _ *cloudwatch.ListDashboardsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.ListDashboardsOutput, error) {
+	return &cloudwatch.ListDashboardsOutput{DashboardEntries: m.dashboards}, nil
+}
+
+func (m *mockDashboardClient) GetDashboard(_ context.Context, input *cloudwatch.GetDashboardInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetDashboardOutput, error) {
+	body, ok := m.bodies[awssdk.ToString(input.DashboardName)]
+	if !ok {
+		return nil, errors.New("ResourceNotFound")
+	}
+	return &cloudwatch.GetDashboardOutput{DashboardBody: awssdk.String(body)}, nil
+}
+
+func dashboardEntry(name string, modified time.Time) cwtypes.DashboardEntry {
+	return cwtypes.DashboardEntry{
+		DashboardName: awssdk.String(name),
+		DashboardArn:  awssdk.String("arn:aws:cloudwatch::123456789012:dashboard/" + name),
+		LastModified:  awssdk.Time(modified),
+	}
+}
+
+func TestDashboardScanner_UnusedDashboards(t *testing.T) {
+	now := time.Now()
+	oneWidget := `{"widgets":[{"type":"metric"}]}`
+	client := &mockDashboardClient{
+		dashboards: []cwtypes.DashboardEntry{
+			dashboardEntry("empty", now.Add(-24*time.Hour)),
+			dashboardEntry("stale-oldest", now.Add(-400*24*time.Hour)),
+			dashboardEntry("stale", now.Add(-200*24*time.Hour)),
+			dashboardEntry("active-1", now),
+			dashboardEntry("active-2", now),
+		},
+		bodies: map[string]string{
+			"empty":        `{"widgets":[]}`,
+			"stale-oldest": oneWidget,
+			"stale":        oneWidget,
+			"active-1":     oneWidget,
+			"active-2":     oneWidget,
+		},
+	}
+
+	scanner := NewDashboardScanner(client)
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 3 {
+		t.Fatalf("expected 3 findings, got %d: %v", len(byID), result.Findings)
+	}
+
+	// Five dashboards leave two billable slots, assigned oldest first.
+	for _, name := range []string{"stale-oldest", "stale"} {
+		f := byID[name]
+		if f.ID != FindingUnusedDashboard || f.EstimatedMonthlyWaste != 3.00 || f.Hygiene {
+			t.Fatalf("%s: expected $3.00 billable finding, got %s $%.2f hygiene=%t", name, f.ID, f.EstimatedMonthlyWaste, f.Hygiene)
+		}
+		if f.Region != "global" {
+			t.Fatalf("%s: expected global region, got %s", name, f.Region)
+		}
+	}
+
+	empty := byID["empty"]
+	if empty.EstimatedMonthlyWaste != 0 || !empty.Hygiene {
+		t.Fatalf("expected $0 hygiene finding for empty dashboard, got $%.2f hygiene=%t", empty.EstimatedMonthlyWaste, empty.Hygiene)
+	}
+	if empty.Metadata["widget_count"] != 0 {
+		t.Fatalf("expected widget_count 0, got %v", empty.Metadata["widget_count"])
+	}
+	if empty.Metadata["last_modified"] == "" {
+		t.Fatal("expected last_modified metadata")
+	}
+}
+
+func TestDashboardScanner_WithinFreeTier(t *testing.T) {
+	client := &mockDashboardClient{
+		dashboards: []cwtypes.DashboardEntry{dashboardEntry("old", time.Now().Add(-400*24*time.Hour))},
+		bodies:     map[string]string{"old": `{"widgets":[{"type":"text"}]}`},
+	}
+
+	scanner := NewDashboardScanner(client)
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	if f := result.Findings[0]; f.EstimatedMonthlyWaste != 0 || !f.Hygiene {
+		t.Fatalf("expected $0 hygiene finding, got $%.2f hygiene=%t", f.EstimatedMonthlyWaste, f.Hygiene)
+	}
+}
+
+func TestDashboardScanner_Excluded(t *testing.T) {
+	client := &mockDashboardClient{
+		dashboards: []cwtypes.DashboardEntry{dashboardEntry("keep", time.Now())},
+		bodies:     map[string]string{"keep": `{"widgets":[]}`},
+	}
+
+	scanner := NewDashboardScanner(client)
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"keep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
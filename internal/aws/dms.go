@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	dmstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// dmsActiveTaskStatuses are replication task states that keep an instance busy.
+var dmsActiveTaskStatuses = map[string]bool{
+	"running":  true,
+	"starting": true,
+	"resuming": true,
+}
+
+// dmsThroughputMetrics are the task-level row counters checked for running tasks.
+// CDCLatencySource is not used: it keeps reporting while CDC is caught up with no
+// changes flowing, so it does not distinguish a busy task from an idle one.
+var dmsThroughputMetrics = []string{"FullLoadThroughputRowsSource", "CDCThroughputRowsSource"}
+
+// DMSAPI is the minimal interface for Database Migration Service operations.
+type DMSAPI interface {
+	DescribeReplicationInstances(ctx context.Context, input *dms.DescribeReplicationInstancesInput, opts ...func(*dms.Options)) (*dms.DescribeReplicationInstancesOutput, error)
+	DescribeReplicationTasks(ctx context.Context, input *dms.DescribeReplicationTasksInput, opts ...func(*dms.Options)) (*dms.DescribeReplicationTasksOutput, error)
+}
+
+// DMSScanner detects DMS replication instances with no replication work.
+type DMSScanner struct {
+	client  DMSAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewDMSScanner creates a scanner for DMS replication instances.
+func NewDMSScanner(client DMSAPI, metrics *MetricsFetcher, region string) *DMSScanner {
+	return &DMSScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *DMSScanner) Type() ResourceType {
+	return ResourceDMS
+}
+
+// Scan examines available replication instances. An instance is idle when no task
+// has run on it for StaleDays, or when its running tasks moved no rows in that window.
+func (s *DMSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	instances, err := s.listReplicationInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list DMS replication instances: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(instances)}
+	if len(instances) == 0 {
+		return result, nil
+	}
+
+	tasks, err := s.listReplicationTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list DMS replication tasks: %w", err)
+	}
+	tasksByInstance := make(map[string][]dmstypes.ReplicationTask)
+	for _, task := range tasks {
+		arn := deref(task.ReplicationInstanceArn)
+		tasksByInstance[arn] = append(tasksByInstance[arn], task)
+	}
+
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+	for _, inst := range instances {
+		id := deref(inst.ReplicationInstanceIdentifier)
+		arn := deref(inst.ReplicationInstanceArn)
+		if cfg.Exclude.ShouldExclude(id, nil) || cfg.Exclude.ShouldExclude(arn, nil) {
+			continue
+		}
+		if deref(inst.ReplicationInstanceStatus) != "available" {
+			continue
+		}
+
+		instTasks := tasksByInstance[arn]
+		var activeIDs []string
+		lastActivity := awssdk.ToTime(inst.InstanceCreateTime)
+		for _, task := range instTasks {
+			if dmsActiveTaskStatuses[deref(task.Status)] {
+				activeIDs = append(activeIDs, dmsTaskResourceID(deref(task.ReplicationTaskArn)))
+			}
+			for _, t := range dmsTaskTimes(task) {
+				if t.After(lastActivity) {
+					lastActivity = t
+				}
+			}
+		}
+
+		var msg string
+		if len(activeIDs) > 0 {
+			moved, err := s.rowsMoved(ctx, id, activeIDs, cfg.StaleDays)
+			if err != nil {
+				slog.Warn("Failed to fetch DMS task metrics", "instance", id, "error", err)
+				continue
+			}
+			if moved > 0 {
+				continue
+			}
+			msg = fmt.Sprintf("%d running tasks replicated no rows over %d days", len(activeIDs), cfg.StaleDays)
+		} else {
+			if lastActivity.IsZero() || lastActivity.After(cutoff) {
+				continue
+			}
+			msg = fmt.Sprintf("No replication task has run for %d days (%d attached tasks)",
+				int(time.Since(lastActivity).Hours()/24), len(instTasks))
+		}
+
+		instanceClass := deref(inst.ReplicationInstanceClass)
+		cost := pricing.MonthlyDMSCost(instanceClass, s.region, inst.MultiAZ)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingDMSIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceDMS,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                s.region,
+			Message:               msg,
+			EstimatedMonthlyWaste: cost,
+			Metadata: map[string]any{
+				"instance_class": instanceClass,
+				"multi_az":       inst.MultiAZ,
+				"task_count":     len(instTasks),
+				"running_tasks":  len(activeIDs),
+				"engine_version": deref(inst.EngineVersion),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// rowsMoved sums source-side row throughput across the given tasks on one instance.
+// Task metrics carry both the instance and task identifier dimensions.
+func (s *DMSScanner) rowsMoved(ctx context.Context, instanceID string, taskIDs []string, days int) (float64, error) {
+	instanceDim := []cwtypes.Dimension{{Name: awssdk.String("ReplicationInstanceIdentifier"), Value: awssdk.String(instanceID)}}
+	var total float64
+	for _, metric := range dmsThroughputMetrics {
+		sums, err := s.metrics.FetchSumWithStaticDim(ctx, "AWS/DMS", metric, "ReplicationTaskIdentifier", taskIDs, days, instanceDim)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range sums {
+			total += v
+		}
+	}
+	return total, nil
+}
+
+// dmsTaskTimes returns the known creation, start, and stop times of a task.
+func dmsTaskTimes(task dmstypes.ReplicationTask) []time.Time {
+	times := []time.Time{awssdk.ToTime(task.ReplicationTaskCreationDate), awssdk.ToTime(task.ReplicationTaskStartDate)}
+	if stats := task.ReplicationTaskStats; stats != nil {
+		times = append(times, awssdk.ToTime(stats.StartDate), awssdk.ToTime(stats.StopDate))
+	}
+	return times
+}
+
+// dmsTaskResourceID extracts the resource ID that CloudWatch uses as the
+// ReplicationTaskIdentifier dimension.
+// Input:  arn:aws:dms:us-east-1:123456789012:task:ABCDEFG
+// Output: ABCDEFG
+func dmsTaskResourceID(arn string) string {
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
+
+func (s *DMSScanner) listReplicationInstances(ctx context.Context) ([]dmstypes.ReplicationInstance, error) {
+	var instances []dmstypes.ReplicationInstance
+	paginator := dms.NewDescribeReplicationInstancesPaginator(s.client, &dms.DescribeReplicationInstancesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, page.ReplicationInstances...)
+	}
+	return instances, nil
+}
+
+func (s *DMSScanner) listReplicationTasks(ctx context.Context) ([]dmstypes.ReplicationTask, error) {
+	var tasks []dmstypes.ReplicationTask
+	paginator := dms.NewDescribeReplicationTasksPaginator(s.client, &dms.DescribeReplicationTasksInput{
+		WithoutSettings: awssdk.Bool(true),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, page.ReplicationTasks...)
+	}
+	return tasks, nil
+}
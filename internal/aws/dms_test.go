@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	dmstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+)
+
+type mockDMSClient struct {
+	instances []dmstypes.ReplicationInstance
+	tasks     []dmstypes.ReplicationTask
+}
+
+func (m *mockDMSClient) DescribeReplicationInstances(_ context.Context, _ *dms.DescribeReplicationInstancesInput, _ ...func(*dms.Options)) (*dms.DescribeReplicationInstancesOutput, error) {
+	return &dms.DescribeReplicationInstancesOutput{ReplicationInstances: m.instances}, nil
+}
+
+func (m *mockDMSClient) DescribeReplicationTasks(_ context.Context, _ *dms.DescribeReplicationTasksInput, _ ...func(*dms.Options)) (*dms.DescribeReplicationTasksOutput, error) {
+	return &dms.DescribeReplicationTasksOutput{ReplicationTasks: m.tasks}, nil
+}
+
+func dmsInstance(id string, created time.Time, multiAZ bool) dmstypes.ReplicationInstance {
+	return dmstypes.ReplicationInstance{
+		ReplicationInstanceIdentifier: awssdk.String(id),
+		ReplicationInstanceArn:        awssdk.String("arn:aws:dms:us-east-1:123456789012:rep:" + id),
+		ReplicationInstanceClass:      awssdk.String("dms.t3.medium"),
+		ReplicationInstanceStatus:     awssdk.String("available"),
+		InstanceCreateTime:            awssdk.Time(created),
+		MultiAZ:                       multiAZ,
+	}
+}
+
+func dmsTask(taskID, instanceID, status string, stopped time.Time) dmstypes.ReplicationTask {
+	return dmstypes.ReplicationTask{
+		ReplicationTaskArn:     awssdk.String("arn:aws:dms:us-east-1:123456789012:task:" + taskID),
+		ReplicationInstanceArn: awssdk.String("arn:aws:dms:us-east-1:123456789012:rep:" + instanceID),
+		Status:                 awssdk.String(status),
+		ReplicationTaskStats:   &dmstypes.ReplicationTaskStats{StopDate: awssdk.Time(stopped)},
+	}
+}
+
+func TestDMSScanner_NoRecentTasks(t *testing.T) {
+	old := time.Now().Add(-200 * 24 * time.Hour)
+	recent := time.Now().Add(-5 * 24 * time.Hour)
+	mock := &mockDMSClient{
+		instances: []dmstypes.ReplicationInstance{
+			dmsInstance("stale-rep", old, true),
+			dmsInstance("recent-rep", old, false),
+			dmsInstance("empty-new-rep", recent, false),
+		},
+		tasks: []dmstypes.ReplicationTask{
+			dmsTask("TASKOLD", "stale-rep", "stopped", old),
+			dmsTask("TASKRECENT", "recent-rep", "stopped", recent),
+		},
+	}
+
+	scanner := NewDMSScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingDMSIdle || f.ResourceID != "stale-rep" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	// dms.t3.medium Multi-AZ: $0.073 * 730 * 2 = $106.58
+	if f.EstimatedMonthlyWaste < 106.57 || f.EstimatedMonthlyWaste > 106.59 {
+		t.Fatalf("expected ~$106.58, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["multi_az"] != true || f.Metadata["task_count"] != 1 || f.Metadata["instance_class"] != "dms.t3.medium" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestDMSScanner_RunningTaskNoRows(t *testing.T) {
+	old := time.Now().Add(-200 * 24 * time.Hour)
+	mock := &mockDMSClient{
+		instances: []dmstypes.ReplicationInstance{dmsInstance("cdc-rep", old, false)},
+		tasks:     []dmstypes.ReplicationTask{dmsTask("TASKCDC", "cdc-rep", "running", time.Time{})},
+	}
+
+	scanner := NewDMSScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	if result.Findings[0].Metadata["running_tasks"] != 1 {
+		t.Fatalf("expected running_tasks=1, got %v", result.Findings[0].Metadata["running_tasks"])
+	}
+}
+
+func TestDMSScanner_RunningTaskActive(t *testing.T) {
+	old := time.Now().Add(-200 * 24 * time.Hour)
+	mock := &mockDMSClient{
+		instances: []dmstypes.ReplicationInstance{dmsInstance("busy-rep", old, false)},
+		tasks:     []dmstypes.ReplicationTask{dmsTask("TASKBUSY", "busy-rep", "running", time.Time{})},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"TASKBUSY": 5000})
+
+	scanner := NewDMSScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestDMSScanner_Excluded(t *testing.T) {
+	old := time.Now().Add(-200 * 24 * time.Hour)
+	mock := &mockDMSClient{instances: []dmstypes.ReplicationInstance{dmsInstance("keep-rep", old, false)}}
+
+	scanner := NewDMSScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"keep-rep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestDMSTaskResourceID(t *testing.T) {
+	if got := dmsTaskResourceID("arn:aws:dms:us-east-1:123456789012:task:ABCDEFG"); got != "ABCDEFG" {
+		t.Fatalf("expected ABCDEFG, got %s", got)
+	}
+}
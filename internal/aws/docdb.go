@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	docdbtypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// DocumentDBAPI is the minimal interface for DocumentDB operations.
+type DocumentDBAPI interface {
+	DescribeDBClusters(ctx context.Context, input *docdb.DescribeDBClustersInput, opts ...func(*docdb.Options)) (*docdb.DescribeDBClustersOutput, error)
+	DescribeDBInstances(ctx context.Context, input *docdb.DescribeDBInstancesInput, opts ...func(*docdb.Options)) (*docdb.DescribeDBInstancesOutput, error)
+	ListTagsForResource(ctx context.Context, input *docdb.ListTagsForResourceInput, opts ...func(*docdb.Options)) (*docdb.ListTagsForResourceOutput, error)
+}
+
+// DocumentDBScanner detects idle DocumentDB clusters.
+type DocumentDBScanner struct {
+	client  DocumentDBAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewDocumentDBScanner creates a scanner for DocumentDB clusters.
+func NewDocumentDBScanner(client DocumentDBAPI, metrics *MetricsFetcher, region string) *DocumentDBScanner {
+	return &DocumentDBScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *DocumentDBScanner) Type() ResourceType {
+	return ResourceDocumentDB
+}
+
+// Scan examines available DocumentDB clusters. Idle detection runs on cluster-level
+// metrics so a multi-instance cluster yields one finding priced across all members.
+func (s *DocumentDBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list DocumentDB clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(clusters)}
+	if len(clusters) == 0 {
+		return result, nil
+	}
+
+	instances, err := s.listInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list DocumentDB instances: %w", err)
+	}
+	membersByCluster := make(map[string][]docdbtypes.DBInstance)
+	for _, inst := range instances {
+		id := deref(inst.DBClusterIdentifier)
+		membersByCluster[id] = append(membersByCluster[id], inst)
+	}
+
+	var ids []string
+	clusterMap := make(map[string]docdbtypes.DBCluster, len(clusters))
+	tagsByID := make(map[string]map[string]string, len(clusters))
+	for _, c := range clusters {
+		id := deref(c.DBClusterIdentifier)
+		if cfg.Exclude.ShouldExclude(id, nil) {
+			continue
+		}
+		if deref(c.Status) != "available" {
+			continue
+		}
+		tags, err := s.clusterTags(ctx, deref(c.DBClusterArn))
+		if err != nil {
+			slog.Debug("Failed to list DocumentDB cluster tags", "cluster", id, "error", err)
+		}
+		if cfg.Exclude.ShouldExclude(id, tags) {
+			continue
+		}
+		ids = append(ids, id)
+		clusterMap[id] = c
+		tagsByID[id] = tags
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	cpuMap, err := s.metrics.FetchAverage(ctx, "AWS/DocDB", "CPUUtilization", "DBClusterIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch DocumentDB CPU metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	connMap, err := s.metrics.FetchSum(ctx, "AWS/DocDB", "DatabaseConnections", "DBClusterIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch DocumentDB connection metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, id := range ids {
+		avgCPU, hasCPU := cpuMap[id]
+		totalConns := connMap[id]
+		if !hasCPU || avgCPU >= cfg.IdleCPUFor(ResourceDocumentDB) || totalConns > 0 {
+			continue
+		}
+
+		c := clusterMap[id]
+		members := membersByCluster[id]
+		var cost float64
+		classes := make([]string, 0, len(members))
+		for _, inst := range members {
+			class := deref(inst.DBInstanceClass)
+			classes = append(classes, class)
+			cost += pricing.MonthlyDocumentDBCost(class, s.region)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingDocDBIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceDocumentDB,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero connections over %d days, CPU %.1f%% (%d instances)", cfg.IdleDays, avgCPU, len(members)),
+			EstimatedMonthlyWaste: cost,
+			Metadata: withTags(map[string]any{
+				"engine_version":    deref(c.EngineVersion),
+				"member_count":      len(members),
+				"instance_classes":  classes,
+				"avg_cpu_percent":   avgCPU,
+				"total_connections": totalConns,
+			}, tagsByID[id]),
+		})
+	}
+
+	return result, nil
+}
+
+// docdbEngineFilter restricts the shared RDS control-plane APIs to DocumentDB resources.
+func docdbEngineFilter() []docdbtypes.Filter {
+	return []docdbtypes.Filter{{Name: awssdk.String("engine"), Values: []string{"docdb"}}}
+}
+
+func (s *DocumentDBScanner) listClusters(ctx context.Context) ([]docdbtypes.DBCluster, error) {
+	var clusters []docdbtypes.DBCluster
+	paginator := docdb.NewDescribeDBClustersPaginator(s.client, &docdb.DescribeDBClustersInput{
+		Filters: docdbEngineFilter(),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.DBClusters...)
+	}
+	return clusters, nil
+}
+
+func (s *DocumentDBScanner) listInstances(ctx context.Context) ([]docdbtypes.DBInstance, error) {
+	var instances []docdbtypes.DBInstance
+	paginator := docdb.NewDescribeDBInstancesPaginator(s.client, &docdb.DescribeDBInstancesInput{
+		Filters: docdbEngineFilter(),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, page.DBInstances...)
+	}
+	return instances, nil
+}
+
+func (s *DocumentDBScanner) clusterTags(ctx context.Context, arn string) (map[string]string, error) {
+	if arn == "" {
+		return nil, nil
+	}
+	out, err := s.client.ListTagsForResource(ctx, &docdb.ListTagsForResourceInput{
+		ResourceName: awssdk.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(out.TagList))
+	for _, t := range out.TagList {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	docdbtypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+)
+
+type mockDocumentDBClient struct {
+	clusters  []docdbtypes.DBCluster
+	instances []docdbtypes.DBInstance
+}
+
+func (m *mockDocumentDBClient) DescribeDBClusters(_ context.Context, _ *docdb.DescribeDBClustersInput, _ ...func(*docdb.Options)) (*docdb.DescribeDBClustersOutput, error) {
+	return &docdb.DescribeDBClustersOutput{DBClusters: m.clusters}, nil
+}
+
+func (m *mockDocumentDBClient) DescribeDBInstances(_ context.Context, _ *docdb.DescribeDBInstancesInput, _ ...func(*docdb.Options)) (*docdb.DescribeDBInstancesOutput, error) {
+	return &docdb.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
+}
+
+func (m *mockDocumentDBClient) ListTagsForResource(_ context.Context, _ *docdb.ListTagsForResourceInput, _ ...func(*docdb.Options)) (*docdb.ListTagsForResourceOutput, error) {
+	return &docdb.ListTagsForResourceOutput{}, nil
+}
+
+func docdbCluster(id string) docdbtypes.DBCluster {
+	return docdbtypes.DBCluster{
+		DBClusterIdentifier: awssdk.String(id),
+		DBClusterArn:        awssdk.String("arn:aws:rds:us-east-1:123456789012:cluster:" + id),
+		Status:              awssdk.String("available"),
+		EngineVersion:       awssdk.String("5.0.0"),
+	}
+}
+
+func docdbInstance(id, cluster, class string) docdbtypes.DBInstance {
+	return docdbtypes.DBInstance{
+		DBInstanceIdentifier: awssdk.String(id),
+		DBClusterIdentifier:  awssdk.String(cluster),
+		DBInstanceClass:      awssdk.String(class),
+	}
+}
+
+func TestDocumentDBScanner_IdleClusterSingleFinding(t *testing.T) {
+	mock := &mockDocumentDBClient{
+		clusters: []docdbtypes.DBCluster{docdbCluster("catalog")},
+		instances: []docdbtypes.DBInstance{
+			docdbInstance("catalog-1", "catalog", "db.r5.large"),
+			docdbInstance("catalog-2", "catalog", "db.r5.large"),
+			docdbInstance("catalog-3", "catalog", "db.r5.large"),
+		},
+	}
+	metrics := newRDSMockMetrics([]float64{1.0, 2.0}, []float64{0, 0}, 0)
+	scanner := NewDocumentDBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding per cluster, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingDocDBIdle {
+		t.Fatalf("expected DOCDB_IDLE, got %s", f.ID)
+	}
+	// 3 * db.r5.large ($0.277/hr) * 730 = ~$606.63
+	if f.EstimatedMonthlyWaste < 606 || f.EstimatedMonthlyWaste > 607 {
+		t.Fatalf("expected ~$606.63, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["member_count"] != 3 || f.Metadata["engine_version"] != "5.0.0" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestDocumentDBScanner_ActiveConnections(t *testing.T) {
+	mock := &mockDocumentDBClient{
+		clusters:  []docdbtypes.DBCluster{docdbCluster("orders")},
+		instances: []docdbtypes.DBInstance{docdbInstance("orders-1", "orders", "db.r5.large")},
+	}
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{12, 8}, 0)
+	scanner := NewDocumentDBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for cluster with connections, got %d", len(result.Findings))
+	}
+}
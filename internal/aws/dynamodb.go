@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// dynamoDBOverProvisionedPct is the consumed/provisioned percentage below which a table is over-provisioned.
+const dynamoDBOverProvisionedPct = 10.0
+
+// DynamoDBAPI is the minimal interface for DynamoDB operations.
+type DynamoDBAPI interface {
+	ListTables(ctx context.Context, input *dynamodb.ListTablesInput, opts ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error)
+	DescribeTable(ctx context.Context, input *dynamodb.DescribeTableInput, opts ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
+	ListTagsOfResource(ctx context.Context, input *dynamodb.ListTagsOfResourceInput, opts ...func(*dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error)
+}
+
+// DynamoDBScanner detects idle and over-provisioned DynamoDB tables.
+type DynamoDBScanner struct {
+	client  DynamoDBAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewDynamoDBScanner creates a scanner for DynamoDB tables.
+func NewDynamoDBScanner(client DynamoDBAPI, metrics *MetricsFetcher, region string) *DynamoDBScanner {
+	return &DynamoDBScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *DynamoDBScanner) Type() ResourceType {
+	return ResourceDynamoDB
+}
+
+// tableInfo holds the fields of DescribeTable needed for capacity analysis.
+type tableInfo struct {
+	name        string
+	arn         string
+	billingMode string
+	rcu         int64
+	wcu         int64
+	sizeBytes   int64
+	itemCount   int64
+	tags        map[string]string
+}
+
+// Scan examines all active DynamoDB tables for zero or low consumed capacity.
+func (s *DynamoDBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	tableNames, err := s.listTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list DynamoDB tables: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(tableNames)}
+	if len(tableNames) == 0 {
+		return result, nil
+	}
+
+	var tables []tableInfo
+	var names []string
+	for _, name := range tableNames {
+		if cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+
+		info, ok, err := s.describeTable(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to describe DynamoDB table", "table", name, "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		tags, err := s.tableTags(ctx, info.arn)
+		if err != nil {
+			slog.Debug("Failed to list DynamoDB table tags", "table", name, "error", err)
+		}
+		if cfg.Exclude.ShouldExclude(name, tags) {
+			continue
+		}
+
+		info.tags = tags
+		tables = append(tables, info)
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	consumedRead, err := s.metrics.FetchSum(ctx, "AWS/DynamoDB", "ConsumedReadCapacityUnits", "TableName", names, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch DynamoDB read metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	consumedWrite, err := s.metrics.FetchSum(ctx, "AWS/DynamoDB", "ConsumedWriteCapacityUnits", "TableName", names, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch DynamoDB write metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	lookbackSeconds := float64(cfg.IdleDays) * 86400
+	for _, info := range tables {
+		reads := consumedRead[info.name]
+		writes := consumedWrite[info.name]
+		isProvisioned := info.billingMode == string(ddbtypes.BillingModeProvisioned)
+
+		provisionedCost := 0.0
+		if isProvisioned {
+			provisionedCost = pricing.MonthlyDynamoDBProvisionedCost(info.rcu, info.wcu, s.region)
+		}
+
+		meta := map[string]any{
+			"billing_mode":         info.billingMode,
+			"provisioned_rcu":      info.rcu,
+			"provisioned_wcu":      info.wcu,
+			"table_size_bytes":     info.sizeBytes,
+			"item_count":           info.itemCount,
+			"consumed_read_units":  reads,
+			"consumed_write_units": writes,
+		}
+		setTagsMetadata(meta, info.tags)
+
+		// DYNAMODB_IDLE: zero reads and writes
+		if reads == 0 && writes == 0 {
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingDynamoDBIdle,
+				Severity:              SeverityHigh,
+				ResourceType:          ResourceDynamoDB,
+				ResourceID:            info.name,
+				ResourceName:          info.arn,
+				Region:                s.region,
+				Message:               fmt.Sprintf("Zero reads and writes over %d days (%s mode)", cfg.IdleDays, info.billingMode),
+				EstimatedMonthlyWaste: provisionedCost,
+				Hygiene:               !isProvisioned, // on-demand idle tables bill storage only but should stay visible
+				Metadata:              meta,
+			})
+			continue
+		}
+
+		// DYNAMODB_OVER_PROVISIONED: consumed capacity well below provisioned (provisioned mode only)
+		if !isProvisioned || info.rcu == 0 || info.wcu == 0 {
+			continue
+		}
+		avgRead := reads / lookbackSeconds
+		avgWrite := writes / lookbackSeconds
+		readPct := avgRead / float64(info.rcu) * 100
+		writePct := avgWrite / float64(info.wcu) * 100
+		if readPct >= dynamoDBOverProvisionedPct || writePct >= dynamoDBOverProvisionedPct {
+			continue
+		}
+
+		// Waste is the capacity above what was actually consumed.
+		unusedRCU := info.rcu - int64(math.Ceil(avgRead))
+		unusedWCU := info.wcu - int64(math.Ceil(avgWrite))
+		meta["read_utilization_pct"] = readPct
+		meta["write_utilization_pct"] = writePct
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingDynamoDBOverProvisioned,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceDynamoDB,
+			ResourceID:            info.name,
+			ResourceName:          info.arn,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Read %.1f%% / write %.1f%% of provisioned capacity over %d days (%d RCU, %d WCU)", readPct, writePct, cfg.IdleDays, info.rcu, info.wcu),
+			EstimatedMonthlyWaste: pricing.MonthlyDynamoDBProvisionedCost(unusedRCU, unusedWCU, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *DynamoDBScanner) listTables(ctx context.Context) ([]string, error) {
+	var names []string
+	paginator := dynamodb.NewListTablesPaginator(s.client, &dynamodb.ListTablesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, page.TableNames...)
+	}
+	return names, nil
+}
+
+// describeTable returns table details; ok is false for tables that are not ACTIVE.
+func (s *DynamoDBScanner) describeTable(ctx context.Context, name string) (tableInfo, bool, error) {
+	out, err := s.client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: &name,
+	})
+	if err != nil {
+		return tableInfo{}, false, err
+	}
+
+	table := out.Table
+	if table == nil || table.TableStatus != ddbtypes.TableStatusActive {
+		return tableInfo{}, false, nil
+	}
+
+	// Tables created before on-demand existed report no BillingModeSummary and are provisioned.
+	mode := string(ddbtypes.BillingModeProvisioned)
+	if table.BillingModeSummary != nil && table.BillingModeSummary.BillingMode != "" {
+		mode = string(table.BillingModeSummary.BillingMode)
+	}
+
+	info := tableInfo{
+		name:        name,
+		arn:         deref(table.TableArn),
+		billingMode: mode,
+		sizeBytes:   derefInt64(table.TableSizeBytes),
+		itemCount:   derefInt64(table.ItemCount),
+	}
+	if table.ProvisionedThroughput != nil {
+		info.rcu = derefInt64(table.ProvisionedThroughput.ReadCapacityUnits)
+		info.wcu = derefInt64(table.ProvisionedThroughput.WriteCapacityUnits)
+	}
+	return info, true, nil
+}
+
+func (s *DynamoDBScanner) tableTags(ctx context.Context, arn string) (map[string]string, error) {
+	if arn == "" {
+		return nil, nil
+	}
+
+	var tags []ddbtypes.Tag
+	var next *string
+	for {
+		out, err := s.client.ListTagsOfResource(ctx, &dynamodb.ListTagsOfResourceInput{
+			ResourceArn: &arn,
+			NextToken:   next,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, out.Tags...)
+		if out.NextToken == nil {
+			break
+		}
+		next = out.NextToken
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m, nil
+}
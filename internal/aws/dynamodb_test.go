@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+type mockDynamoDBClient struct {
+	tables map[string]ddbtypes.TableDescription
+	tags   map[string][]ddbtypes.Tag
+}
+
+func (m *mockDynamoDBClient) ListTables(_ context.Context, _ *dynamodb.ListTablesInput, _ ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error) {
+	var names []string
+	for name := range m.tables {
+		names = append(names, name)
+	}
+	return &dynamodb.ListTablesOutput{TableNames: names}, nil
+}
+
+func (m *mockDynamoDBClient) DescribeTable(_ context.Context, input *dynamodb.DescribeTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
+	table := m.tables[*input.TableName]
+	return &dynamodb.DescribeTableOutput{Table: &table}, nil
+}
+
+func (m *mockDynamoDBClient) ListTagsOfResource(_ context.Context, input *dynamodb.ListTagsOfResourceInput, _ ...func(*dynamodb.Options)) (*dynamodb.ListTagsOfResourceOutput, error) {
+	return &dynamodb.ListTagsOfResourceOutput{Tags: m.tags[*input.ResourceArn]}, nil
+}
+
+func provisionedTable(name string, rcu, wcu int64) ddbtypes.TableDescription {
+	return ddbtypes.TableDescription{
+		TableName:          awssdk.String(name),
+		TableArn:           awssdk.String("arn:aws:dynamodb:us-east-1:123456789012:table/" + name),
+		TableStatus:        ddbtypes.TableStatusActive,
+		BillingModeSummary: &ddbtypes.BillingModeSummary{BillingMode: ddbtypes.BillingModeProvisioned},
+		ProvisionedThroughput: &ddbtypes.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  awssdk.Int64(rcu),
+			WriteCapacityUnits: awssdk.Int64(wcu),
+		},
+	}
+}
+
+func TestDynamoDBScanner_IdleProvisionedTable(t *testing.T) {
+	mock := &mockDynamoDBClient{tables: map[string]ddbtypes.TableDescription{
+		"orders-old": provisionedTable("orders-old", 100, 50),
+	}}
+	scanner := NewDynamoDBScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingDynamoDBIdle {
+		t.Fatalf("expected DYNAMODB_IDLE, got %s", f.ID)
+	}
+	if f.EstimatedMonthlyWaste == 0 {
+		t.Fatal("expected non-zero waste for idle provisioned table")
+	}
+	if f.Metadata["billing_mode"] != "PROVISIONED" {
+		t.Fatalf("expected billing_mode PROVISIONED, got %v", f.Metadata["billing_mode"])
+	}
+}
+
+func TestDynamoDBScanner_IdleOnDemandTableIsHygiene(t *testing.T) {
+	table := provisionedTable("sessions", 0, 0)
+	table.BillingModeSummary = &ddbtypes.BillingModeSummary{BillingMode: ddbtypes.BillingModePayPerRequest}
+	mock := &mockDynamoDBClient{tables: map[string]ddbtypes.TableDescription{"sessions": table}}
+	scanner := NewDynamoDBScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.EstimatedMonthlyWaste != 0 || !f.Hygiene {
+		t.Fatalf("expected $0 hygiene finding, got waste=%.2f hygiene=%t", f.EstimatedMonthlyWaste, f.Hygiene)
+	}
+}
+
+func TestDynamoDBScanner_OverProvisioned(t *testing.T) {
+	mock := &mockDynamoDBClient{tables: map[string]ddbtypes.TableDescription{
+		"catalog": provisionedTable("catalog", 100, 100),
+	}}
+	// One unit per second over 7 days is 1% of 100 provisioned units.
+	metrics := newMockMetricsFetcher(map[string]float64{"catalog": 7 * 86400})
+	scanner := NewDynamoDBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingDynamoDBOverProvisioned {
+		t.Fatalf("expected DYNAMODB_OVER_PROVISIONED, got %s", f.ID)
+	}
+	full := pricing.MonthlyDynamoDBProvisionedCost(100, 100, "us-east-1")
+	if f.EstimatedMonthlyWaste <= 0 || f.EstimatedMonthlyWaste >= full {
+		t.Fatalf("expected waste below full provisioned cost %.2f, got %.2f", full, f.EstimatedMonthlyWaste)
+	}
+}
+
+func TestDynamoDBScanner_WellUtilizedTable(t *testing.T) {
+	mock := &mockDynamoDBClient{tables: map[string]ddbtypes.TableDescription{
+		"events": provisionedTable("events", 10, 10),
+	}}
+	// Five units per second is 50% of provisioned capacity.
+	metrics := newMockMetricsFetcher(map[string]float64{"events": 5 * 7 * 86400})
+	scanner := NewDynamoDBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(result.Findings))
+	}
+}
+
+func TestDynamoDBScanner_ExcludedByTag(t *testing.T) {
+	table := provisionedTable("audit", 100, 50)
+	mock := &mockDynamoDBClient{
+		tables: map[string]ddbtypes.TableDescription{"audit": table},
+		tags: map[string][]ddbtypes.Tag{
+			*table.TableArn: {{Key: awssdk.String("keep"), Value: awssdk.String("true")}},
+		},
+	}
+	scanner := NewDynamoDBScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"keep": "true"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded table to produce no findings, got %d", len(result.Findings))
+	}
+}
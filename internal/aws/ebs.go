@@ -3,31 +3,65 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
-// detachedThresholdDays is the minimum days a volume must be detached to be flagged.
-const detachedThresholdDays = 7
+const (
+	// detachedThresholdDays is the minimum days a volume must be detached to be flagged.
+	detachedThresholdDays = 7
+	// ebsLookupInterval spaces the per-volume DetachVolume lookups at half of CloudTrail's
+	// 2 requests per second, leaving the rest for the KMS scanner in the same region.
+	ebsLookupInterval = time.Second
+	// ebsMaxDetachEventPages caps the CloudTrail pages read per volume. Volumes with
+	// that much recent activity fall back to CreateTime.
+	ebsMaxDetachEventPages = 2
+)
+
+const (
+	// iopsOverProvisionedRatio flags volumes whose observed peak IOPS stays below this
+	// fraction of their provisioned IOPS. Peaks are hourly averages, which smooth out
+	// short bursts, so the ratio leaves generous headroom.
+	iopsOverProvisionedRatio = 0.25
+	// ebsMinProvisionedIOPS is the lowest IOPS setting io1 and io2 volumes accept.
+	ebsMinProvisionedIOPS = 100
+)
 
 // EBSAPI is the minimal interface for EBS volume operations.
 type EBSAPI interface {
 	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
 }
 
-// EBSScanner detects detached EBS volumes.
+// EBSActivityAPI is the minimal CloudTrail interface for finding volume detach events.
+type EBSActivityAPI interface {
+	LookupEvents(ctx context.Context, input *cloudtrail.LookupEventsInput, opts ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// EBSScanner detects detached EBS volumes, gp2 volumes worth migrating to gp3, and
+// volumes with far more provisioned IOPS than they use.
 type EBSScanner struct {
-	client EBSAPI
-	region string
+	client  EBSAPI
+	trail   *pacedTrail
+	metrics *MetricsFetcher
+	region  string
 }
 
 // NewEBSScanner creates a scanner for EBS volumes.
-func NewEBSScanner(client EBSAPI, region string) *EBSScanner {
-	return &EBSScanner{client: client, region: region}
+func NewEBSScanner(client EBSAPI, trail EBSActivityAPI, metrics *MetricsFetcher, region string) *EBSScanner {
+	return &EBSScanner{
+		client:  client,
+		trail:   &pacedTrail{client: trail, interval: ebsLookupInterval},
+		metrics: metrics,
+		region:  region,
+	}
 }
 
 // Type returns the resource type.
@@ -35,14 +69,27 @@ func (s *EBSScanner) Type() ResourceType {
 	return ResourceEBS
 }
 
-// Scan examines all EBS volumes in the region for detached volumes.
+// Scan examines all EBS volumes in the region for detached volumes, in-use gp2
+// volumes that would be cheaper as gp3, and in-use volumes with idle provisioned IOPS.
 func (s *EBSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
-	volumes, err := s.listAvailableVolumes(ctx)
+	volumes, err := s.listVolumes(ctx, ec2types.Filter{
+		Name:   awssdk.String("status"),
+		Values: []string{"available"},
+	})
 	if err != nil {
 		return nil, fmt.Errorf("list EBS volumes: %w", err)
 	}
+	// Detached volumes are already priced in full by DETACHED_EBS, so the gp2-to-gp3
+	// and IOPS passes look only at attached volumes.
+	attached, err := s.listVolumes(ctx,
+		ec2types.Filter{Name: awssdk.String("status"), Values: []string{"in-use"}},
+		ec2types.Filter{Name: awssdk.String("volume-type"), Values: []string{"gp2", "gp3", "io1", "io2"}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list attached EBS volumes: %w", err)
+	}
 
-	result := &ScanResult{ResourcesScanned: len(volumes)}
+	result := &ScanResult{ResourcesScanned: len(volumes) + len(attached)}
 	now := time.Now().UTC()
 
 	for _, vol := range volumes {
@@ -51,22 +98,33 @@ func (s *EBSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 			continue
 		}
 
-		// Volume is in "available" state (not attached)
-		// Use CreateTime as a proxy for when it became detached
-		// (accurate for volumes that were created detached or detached recently)
+		// A volume cannot have been detached before it was created, so young
+		// volumes are skipped before any CloudTrail lookup.
 		createTime := vol.CreateTime
 		if createTime == nil {
 			continue
 		}
+		if int(now.Sub(*createTime).Hours()/24) < detachedThresholdDays {
+			continue
+		}
 
-		daysSinceCreate := int(now.Sub(*createTime).Hours() / 24)
-		if daysSinceCreate < detachedThresholdDays {
+		detachedAt, detachSource := s.detachTime(ctx, vol, now)
+		daysDetached := int(now.Sub(detachedAt).Hours() / 24)
+		if daysDetached < detachedThresholdDays {
 			continue
 		}
 
 		volumeType := string(vol.VolumeType)
 		sizeGiB := int(derefInt32(vol.Size))
 		cost := pricing.MonthlyEBSCost(volumeType, sizeGiB, s.region)
+		meta := map[string]any{
+			"volume_type":       volumeType,
+			"size_gib":          sizeGiB,
+			"days_detached":     daysDetached,
+			"detach_source":     detachSource,
+			"availability_zone": deref(vol.AvailabilityZone),
+		}
+		setTagsMetadata(meta, ec2TagsToMap(vol.Tags))
 
 		result.Findings = append(result.Findings, Finding{
 			ID:                    FindingDetachedEBS,
@@ -75,29 +133,204 @@ func (s *EBSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 			ResourceID:            volID,
 			ResourceName:          volumeName(vol),
 			Region:                s.region,
-			Message:               fmt.Sprintf("Detached %d days, %s %d GiB", daysSinceCreate, volumeType, sizeGiB),
+			Message:               fmt.Sprintf("Detached %d days, %s %d GiB", daysDetached, volumeType, sizeGiB),
 			EstimatedMonthlyWaste: cost,
-			Metadata: map[string]any{
-				"volume_type":       volumeType,
-				"size_gib":          sizeGiB,
-				"days_detached":     daysSinceCreate,
-				"availability_zone": deref(vol.AvailabilityZone),
-			},
+			Metadata:              meta,
 		})
 	}
 
+	iopsVolumes := make(map[string]ec2types.Volume)
+	for _, vol := range attached {
+		if vol.State != ec2types.VolumeStateInUse {
+			continue
+		}
+		volID := deref(vol.VolumeId)
+		if cfg.Exclude.ShouldExclude(volID, ec2TagsToMap(vol.Tags)) {
+			continue
+		}
+		switch vol.VolumeType {
+		case ec2types.VolumeTypeGp2:
+			result.Findings = append(result.Findings, s.gp2Finding(vol))
+		case ec2types.VolumeTypeGp3, ec2types.VolumeTypeIo1, ec2types.VolumeTypeIo2:
+			if pricing.MonthlyEBSIOPSCost(string(vol.VolumeType), int(derefInt32(vol.Iops)), s.region) > 0 {
+				iopsVolumes[volID] = vol
+			}
+		}
+	}
+	s.appendIOPSFindings(ctx, result, cfg, iopsVolumes)
+
 	return result, nil
 }
 
-func (s *EBSScanner) listAvailableVolumes(ctx context.Context) ([]ec2types.Volume, error) {
+// appendIOPSFindings flags volumes whose observed peak IOPS stays below
+// iopsOverProvisionedRatio of their provisioned IOPS. Waste is the price of the
+// provisioned IOPS above the observed peak.
+func (s *EBSScanner) appendIOPSFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, volumes map[string]ec2types.Volume) {
+	if len(volumes) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(volumes))
+	for id := range volumes {
+		ids = append(ids, id)
+	}
+
+	peaks, err := s.fetchPeakIOPS(ctx, ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EBS IOPS metrics", "region", s.region, "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		peak, ok := peaks[id]
+		if !ok {
+			continue
+		}
+		vol := volumes[id]
+		provisioned := int(derefInt32(vol.Iops))
+		if peak >= float64(provisioned)*iopsOverProvisionedRatio {
+			continue
+		}
+
+		volumeType := string(vol.VolumeType)
+		needed := max(int(math.Ceil(peak)), ebsMinProvisionedIOPS)
+		waste := pricing.MonthlyEBSIOPSCost(volumeType, provisioned, s.region) - pricing.MonthlyEBSIOPSCost(volumeType, needed, s.region)
+		if waste <= 0 {
+			continue
+		}
+
+		meta := map[string]any{
+			"volume_type":        volumeType,
+			"size_gib":           int(derefInt32(vol.Size)),
+			"provisioned_iops":   provisioned,
+			"observed_peak_iops": math.Round(peak),
+			"availability_zone":  deref(vol.AvailabilityZone),
+		}
+		if len(vol.Attachments) > 0 {
+			meta["instance_id"] = deref(vol.Attachments[0].InstanceId)
+		}
+		setTagsMetadata(meta, ec2TagsToMap(vol.Tags))
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingEBSOverProvisionedIOPS,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceEBS,
+			ResourceID:            id,
+			ResourceName:          volumeName(vol),
+			Region:                s.region,
+			Message:               fmt.Sprintf("%s provisioned %d IOPS, peak %.0f over %d days", volumeType, provisioned, peak, cfg.IdleDays),
+			EstimatedMonthlyWaste: waste,
+			Metadata:              meta,
+		})
+	}
+}
+
+// fetchPeakIOPS returns each volume's highest hourly IOPS, read plus write, over
+// the lookback window. VolumeReadOps and VolumeWriteOps are operation counts per period.
+func (s *EBSScanner) fetchPeakIOPS(ctx context.Context, ids []string, days int) (map[string]float64, error) {
+	ops := make(map[string]map[time.Time]float64, len(ids))
+	for _, metric := range []string{"VolumeReadOps", "VolumeWriteOps"} {
+		series, err := s.metrics.FetchSeries(ctx, "AWS/EBS", metric, "VolumeId", ids, days, "Sum")
+		if err != nil {
+			return nil, err
+		}
+		for id, points := range series {
+			if ops[id] == nil {
+				ops[id] = make(map[time.Time]float64, len(points))
+			}
+			for _, p := range points {
+				ops[id][p.Timestamp] += p.Value
+			}
+		}
+	}
+
+	peaks := make(map[string]float64, len(ops))
+	for id, byHour := range ops {
+		var peak float64
+		for _, v := range byHour {
+			peak = max(peak, v/metricPeriodSeconds)
+		}
+		peaks[id] = peak
+	}
+	return peaks, nil
+}
+
+// detachTime returns when the volume was detached and which signal it came from.
+// DescribeVolumes keeps an attachment record only briefly after a detach, so a
+// remaining record means the volume was detached just now; its AttachTime says how
+// long it was attached, not how long it has been detached. Otherwise the most recent
+// DetachVolume event in CloudTrail's 90-day history is used, and volumes without one
+// fall back to CreateTime, which is exact for volumes that were never attached.
+func (s *EBSScanner) detachTime(ctx context.Context, vol ec2types.Volume, now time.Time) (time.Time, string) {
+	if len(vol.Attachments) > 0 {
+		return now, "attachment"
+	}
+
+	detached, err := s.lastDetachEvent(ctx, deref(vol.VolumeId))
+	if err != nil {
+		slog.Warn("Failed to look up EBS detach event", "volume", deref(vol.VolumeId), "error", err)
+	} else if !detached.IsZero() {
+		return detached, "cloudtrail"
+	}
+	return *vol.CreateTime, "create_time"
+}
+
+// lastDetachEvent returns the time of the most recent DetachVolume event for the
+// volume, or the zero time if CloudTrail has none. Events are returned newest first.
+func (s *EBSScanner) lastDetachEvent(ctx context.Context, volID string) (time.Time, error) {
+	paginator := cloudtrail.NewLookupEventsPaginator(s.trail, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{
+			{AttributeKey: cttypes.LookupAttributeKeyResourceName, AttributeValue: awssdk.String(volID)},
+		},
+	})
+
+	for page := 0; paginator.HasMorePages() && page < ebsMaxDetachEventPages; page++ {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, e := range out.Events {
+			if deref(e.EventName) == "DetachVolume" && e.EventTime != nil {
+				return *e.EventTime, nil
+			}
+		}
+	}
+	return time.Time{}, nil
+}
+
+// gp2Finding prices the move of a gp2 volume to gp3 at the same size. gp3's 3,000
+// IOPS baseline matches gp2 up to 1 TiB; larger volumes may need extra gp3 IOPS.
+func (s *EBSScanner) gp2Finding(vol ec2types.Volume) Finding {
+	sizeGiB := int(derefInt32(vol.Size))
+	savings := pricing.MonthlyEBSCost("gp2", sizeGiB, s.region) - pricing.MonthlyEBSCost("gp3", sizeGiB, s.region)
+	meta := map[string]any{
+		"current_type":      "gp2",
+		"target_type":       "gp3",
+		"size_gib":          sizeGiB,
+		"projected_savings": savings,
+		"availability_zone": deref(vol.AvailabilityZone),
+	}
+	if len(vol.Attachments) > 0 {
+		meta["instance_id"] = deref(vol.Attachments[0].InstanceId)
+	}
+	setTagsMetadata(meta, ec2TagsToMap(vol.Tags))
+
+	return Finding{
+		ID:                    FindingEBSGp2ToGp3,
+		Severity:              SeverityLow,
+		ResourceType:          ResourceEBS,
+		ResourceID:            deref(vol.VolumeId),
+		ResourceName:          volumeName(vol),
+		Region:                s.region,
+		Message:               fmt.Sprintf("gp2 %d GiB, $%.2f/month cheaper as gp3", sizeGiB, savings),
+		EstimatedMonthlyWaste: savings,
+		Metadata:              meta,
+	}
+}
+
+func (s *EBSScanner) listVolumes(ctx context.Context, filters ...ec2types.Filter) ([]ec2types.Volume, error) {
 	var volumes []ec2types.Volume
 	paginator := ec2.NewDescribeVolumesPaginator(s.client, &ec2.DescribeVolumesInput{
-		Filters: []ec2types.Filter{
-			{
-				Name:   awssdk.String("status"),
-				Values: []string{"available"},
-			},
-		},
+		Filters: filters,
 	})
 
 	for paginator.HasMorePages() {
@@ -125,3 +358,10 @@ func derefInt32(v *int32) int32 {
 	}
 	return *v
 }
+
+func derefInt64(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -2,10 +2,13 @@ package aws
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
@@ -14,8 +17,46 @@ type mockEBSClient struct {
 	volumes []ec2types.Volume
 }
 
-func (m *mockEBSClient) DescribeVolumes(_ context.Context, _ *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
-	return &ec2.DescribeVolumesOutput{Volumes: m.volumes}, nil
+// DescribeVolumes applies the status and volume-type filters the scanner sends.
+func (m *mockEBSClient) DescribeVolumes(_ context.Context, input *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	var out []ec2types.Volume
+	for _, v := range m.volumes {
+		match := true
+		for _, f := range input.Filters {
+			switch deref(f.Name) {
+			case "status":
+				match = match && slices.Contains(f.Values, string(v.State))
+			case "volume-type":
+				match = match && slices.Contains(f.Values, string(v.VolumeType))
+			}
+		}
+		if match {
+			out = append(out, v)
+		}
+	}
+	return &ec2.DescribeVolumesOutput{Volumes: out}, nil
+}
+
+type mockEBSTrail struct {
+	detached map[string]time.Time // volume ID -> DetachVolume event time
+	lookups  int
+}
+
+func (m *mockEBSTrail) LookupEvents(_ context.Context, input *cloudtrail.LookupEventsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	m.lookups++
+	volID := deref(input.LookupAttributes[0].AttributeValue)
+	events := []cttypes.Event{{EventName: awssdk.String("AttachVolume"), EventTime: awssdk.Time(time.Now())}}
+	if t, ok := m.detached[volID]; ok {
+		events = append(events, cttypes.Event{EventName: awssdk.String("DetachVolume"), EventTime: awssdk.Time(t)})
+	}
+	return &cloudtrail.LookupEventsOutput{Events: events}, nil
+}
+
+// newTestEBSScanner returns a scanner whose CloudTrail lookups are not paced.
+func newTestEBSScanner(client EBSAPI, trail EBSActivityAPI, metrics *MetricsFetcher) *EBSScanner {
+	s := NewEBSScanner(client, trail, metrics, "us-east-1")
+	s.trail.interval = 0
+	return s
 }
 
 func TestEBSScanner_DetachedVolume(t *testing.T) {
@@ -25,6 +66,7 @@ func TestEBSScanner_DetachedVolume(t *testing.T) {
 			{
 				VolumeId:         awssdk.String("vol-detached001"),
 				VolumeType:       ec2types.VolumeTypeGp3,
+				State:            ec2types.VolumeStateAvailable,
 				Size:             awssdk.Int32(100),
 				CreateTime:       &created,
 				AvailabilityZone: awssdk.String("us-east-1a"),
@@ -33,7 +75,7 @@ func TestEBSScanner_DetachedVolume(t *testing.T) {
 		},
 	}
 
-	scanner := NewEBSScanner(mock, "us-east-1")
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
 	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -71,13 +113,14 @@ func TestEBSScanner_RecentlyCreatedNotFlagged(t *testing.T) {
 			{
 				VolumeId:   awssdk.String("vol-recent001"),
 				VolumeType: ec2types.VolumeTypeGp3,
+				State:      ec2types.VolumeStateAvailable,
 				Size:       awssdk.Int32(50),
 				CreateTime: &created,
 			},
 		},
 	}
 
-	scanner := NewEBSScanner(mock, "us-east-1")
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
 	result, err := scanner.Scan(context.Background(), ScanConfig{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -89,7 +132,7 @@ func TestEBSScanner_RecentlyCreatedNotFlagged(t *testing.T) {
 
 func TestEBSScanner_NoVolumes(t *testing.T) {
 	mock := &mockEBSClient{volumes: nil}
-	scanner := NewEBSScanner(mock, "us-east-1")
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
 
 	result, err := scanner.Scan(context.Background(), ScanConfig{})
 	if err != nil {
@@ -107,13 +150,14 @@ func TestEBSScanner_ExcludedVolume(t *testing.T) {
 			{
 				VolumeId:   awssdk.String("vol-excluded001"),
 				VolumeType: ec2types.VolumeTypeGp3,
+				State:      ec2types.VolumeStateAvailable,
 				Size:       awssdk.Int32(100),
 				CreateTime: &created,
 			},
 		},
 	}
 
-	scanner := NewEBSScanner(mock, "us-east-1")
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
 	cfg := ScanConfig{
 		Exclude: ExcludeConfig{ResourceIDs: map[string]bool{"vol-excluded001": true}},
 	}
@@ -126,6 +170,213 @@ func TestEBSScanner_ExcludedVolume(t *testing.T) {
 	}
 }
 
+func TestEBSScanner_ExcludedByTag(t *testing.T) {
+	created := time.Now().UTC().Add(-30 * 24 * time.Hour)
+	mock := &mockEBSClient{
+		volumes: []ec2types.Volume{
+			{
+				VolumeId:   awssdk.String("vol-tagged001"),
+				VolumeType: ec2types.VolumeTypeGp3,
+				State:      ec2types.VolumeStateAvailable,
+				Size:       awssdk.Int32(100),
+				CreateTime: &created,
+				Tags:       []ec2types.Tag{{Key: awssdk.String("awsspectre:ignore"), Value: awssdk.String("true")}},
+			},
+		},
+	}
+
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
+	cfg := ScanConfig{
+		Exclude: ExcludeConfig{Tags: map[string]string{"awsspectre:ignore": ""}},
+	}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected tag-excluded volume to produce no findings, got %d", len(result.Findings))
+	}
+}
+
+func TestEBSScanner_DetachSource(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Now().UTC()
+	available := func(id string, age time.Duration) ec2types.Volume {
+		return ec2types.Volume{
+			VolumeId:   awssdk.String(id),
+			VolumeType: ec2types.VolumeTypeGp3,
+			State:      ec2types.VolumeStateAvailable,
+			Size:       awssdk.Int32(100),
+			CreateTime: awssdk.Time(now.Add(-age)),
+		}
+	}
+	recentAttach := available("vol-recentattach", 400*day)
+	recentAttach.Attachments = []ec2types.VolumeAttachment{{AttachTime: awssdk.Time(now.Add(-2 * day))}}
+	// Attached for two years and detached moments ago: the leftover record's
+	// AttachTime must not be read as the detach time.
+	oldAttach := available("vol-oldattach", 800*day)
+	oldAttach.Attachments = []ec2types.VolumeAttachment{{AttachTime: awssdk.Time(now.Add(-730 * day))}}
+
+	mock := &mockEBSClient{volumes: []ec2types.Volume{
+		recentAttach,
+		oldAttach,
+		available("vol-recentdetach", 400*day),
+		available("vol-olddetach", 400*day),
+		available("vol-neverattached", 20*day),
+		available("vol-new", 2*day),
+	}}
+	trail := &mockEBSTrail{detached: map[string]time.Time{
+		"vol-recentdetach": now.Add(-1 * day),
+		"vol-olddetach":    now.Add(-45 * day),
+	}}
+
+	result, err := newTestEBSScanner(mock, trail, newMetricsByName(nil)).Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	want := map[string]struct {
+		source string
+		days   int
+	}{
+		"vol-olddetach":     {"cloudtrail", 45},
+		"vol-neverattached": {"create_time", 20},
+	}
+	if len(byID) != len(want) {
+		t.Fatalf("expected %d findings, got %d: %v", len(want), len(byID), result.Findings)
+	}
+	for id, w := range want {
+		f, ok := byID[id]
+		if !ok {
+			t.Fatalf("expected finding for %s", id)
+		}
+		if f.Metadata["detach_source"] != w.source || f.Metadata["days_detached"] != w.days {
+			t.Fatalf("%s: expected %s source and %d days, got %v", id, w.source, w.days, f.Metadata)
+		}
+	}
+	// Attachment records and volumes younger than the threshold need no CloudTrail lookup.
+	if trail.lookups != 3 {
+		t.Fatalf("expected 3 CloudTrail lookups, got %d", trail.lookups)
+	}
+}
+
+func TestEBSScanner_Gp2ToGp3(t *testing.T) {
+	created := time.Now().UTC().Add(-30 * 24 * time.Hour)
+	mock := &mockEBSClient{
+		volumes: []ec2types.Volume{
+			{
+				VolumeId:    awssdk.String("vol-gp2attached"),
+				VolumeType:  ec2types.VolumeTypeGp2,
+				State:       ec2types.VolumeStateInUse,
+				Size:        awssdk.Int32(500),
+				CreateTime:  &created,
+				Attachments: []ec2types.VolumeAttachment{{InstanceId: awssdk.String("i-app001")}},
+			},
+			{
+				VolumeId:   awssdk.String("vol-gp3attached"),
+				VolumeType: ec2types.VolumeTypeGp3,
+				State:      ec2types.VolumeStateInUse,
+				Size:       awssdk.Int32(500),
+				CreateTime: &created,
+			},
+			{
+				VolumeId:   awssdk.String("vol-gp2detached"),
+				VolumeType: ec2types.VolumeTypeGp2,
+				State:      ec2types.VolumeStateAvailable,
+				Size:       awssdk.Int32(500),
+				CreateTime: &created,
+			},
+		},
+	}
+
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, newMetricsByName(nil))
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+	if byID["vol-gp2detached"].ID != FindingDetachedEBS {
+		t.Fatalf("expected detached gp2 volume reported only as DETACHED_EBS, got %s", byID["vol-gp2detached"].ID)
+	}
+
+	f := byID["vol-gp2attached"]
+	if f.ID != FindingEBSGp2ToGp3 {
+		t.Fatalf("expected EBS_GP2_TO_GP3, got %s", f.ID)
+	}
+	// 500 GiB: gp2 $0.10 - gp3 $0.08 = $10.00/month
+	if f.EstimatedMonthlyWaste < 9.99 || f.EstimatedMonthlyWaste > 10.01 {
+		t.Fatalf("expected ~$10.00, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["target_type"] != "gp3" || f.Metadata["instance_id"] != "i-app001" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestEBSScanner_OverProvisionedIOPS(t *testing.T) {
+	created := time.Now().UTC().Add(-30 * 24 * time.Hour)
+	attachedVolume := func(id string, volumeType ec2types.VolumeType, iops int32) ec2types.Volume {
+		return ec2types.Volume{
+			VolumeId:   awssdk.String(id),
+			VolumeType: volumeType,
+			State:      ec2types.VolumeStateInUse,
+			Size:       awssdk.Int32(200),
+			Iops:       awssdk.Int32(iops),
+			CreateTime: &created,
+		}
+	}
+	mock := &mockEBSClient{
+		volumes: []ec2types.Volume{
+			attachedVolume("vol-io1idle", ec2types.VolumeTypeIo1, 10000),
+			attachedVolume("vol-io2busy", ec2types.VolumeTypeIo2, 10000),
+			attachedVolume("vol-gp3extra", ec2types.VolumeTypeGp3, 6000),
+			attachedVolume("vol-gp3baseline", ec2types.VolumeTypeGp3, 3000),
+		},
+	}
+	// Hourly operation counts: 500 IOPS peak on vol-io1idle, 4,000 on vol-io2busy.
+	metrics := newMetricsByName(map[string]float64{
+		"VolumeReadOps/vol-io1idle":   1_080_000,
+		"VolumeWriteOps/vol-io1idle":  720_000,
+		"VolumeReadOps/vol-io2busy":   14_400_000,
+		"VolumeWriteOps/vol-gp3extra": 360_000,
+	})
+
+	scanner := newTestEBSScanner(mock, &mockEBSTrail{}, metrics)
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+
+	f := byID["vol-io1idle"]
+	if f.ID != FindingEBSOverProvisionedIOPS {
+		t.Fatalf("expected EBS_OVER_PROVISIONED_IOPS, got %s", f.ID)
+	}
+	// (10,000 - 500) IOPS * $0.065 = $617.50
+	if f.EstimatedMonthlyWaste < 617.49 || f.EstimatedMonthlyWaste > 617.51 {
+		t.Fatalf("expected ~$617.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["provisioned_iops"] != 10000 || f.Metadata["observed_peak_iops"] != 500.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+
+	// gp3 bills only the 3,000 IOPS above its baseline: 3,000 * $0.005 = $15.00
+	if cost := byID["vol-gp3extra"].EstimatedMonthlyWaste; cost < 14.99 || cost > 15.01 {
+		t.Fatalf("expected ~$15.00, got $%.2f", cost)
+	}
+}
+
 func TestEBSScanner_Type(t *testing.T) {
 	scanner := &EBSScanner{}
 	if scanner.Type() != ResourceEBS {
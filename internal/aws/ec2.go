@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"regexp"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -12,13 +14,19 @@ import (
 	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
+// rightsizeMaxPercent is the peak CPU and average memory utilization below which a
+// running instance is recommended the next-smaller size.
+const rightsizeMaxPercent = 40.0
+
 // EC2API is the minimal interface for EC2 instance operations.
 type EC2API interface {
 	DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
 	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	DescribeReservedInstances(ctx context.Context, input *ec2.DescribeReservedInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeReservedInstancesOutput, error)
+	DescribeSpotPriceHistory(ctx context.Context, input *ec2.DescribeSpotPriceHistoryInput, opts ...func(*ec2.Options)) (*ec2.DescribeSpotPriceHistoryOutput, error)
 }
 
-// EC2Scanner detects idle and stopped EC2 instances.
+// EC2Scanner detects idle, stopped, oversized, and previous-generation EC2 instances.
 type EC2Scanner struct {
 	client  EC2API
 	metrics *MetricsFetcher
@@ -75,6 +83,13 @@ func (s *EC2Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 				}
 				stoppedVolumeIDs[instID] = volIDs
 
+				meta := map[string]any{
+					"instance_type": instanceType,
+					"days_stopped":  daysStopped,
+					"state":         "stopped",
+				}
+				setTagsMetadata(meta, ec2TagsToMap(inst.Tags))
+
 				result.Findings = append(result.Findings, Finding{
 					ID:                    FindingStoppedEC2,
 					Severity:              SeverityMedium,
@@ -84,11 +99,7 @@ func (s *EC2Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 					Region:                s.region,
 					Message:               fmt.Sprintf("Stopped for %d days", daysStopped),
 					EstimatedMonthlyWaste: 0,
-					Metadata: map[string]any{
-						"instance_type": instanceType,
-						"days_stopped":  daysStopped,
-						"state":         "stopped",
-					},
+					Metadata:              meta,
 				})
 			}
 			continue
@@ -116,48 +127,308 @@ func (s *EC2Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 			}
 
 			instanceMap := buildInstanceMap(instances)
+			var busyIDs []string
 			for _, id := range runningIDs {
 				avgCPU, ok := cpuMap[id]
 				if !ok {
 					continue
 				}
-				if avgCPU < cfg.IdleCPUThreshold {
-					// Check if memory utilization is high enough to override the idle CPU signal
-					avgMem, hasMem := memMap[id]
-					if hasMem && avgMem >= cfg.HighMemoryThreshold {
-						slog.Debug("Instance has low CPU but high memory — not idle",
-							"instance", id, "cpu", avgCPU, "memory", avgMem)
-						continue
-					}
+				if avgCPU >= cfg.IdleCPUFor(ResourceEC2) {
+					busyIDs = append(busyIDs, id)
+					continue
+				}
+				// Check if memory utilization is high enough to override the idle CPU signal
+				avgMem, hasMem := memMap[id]
+				if hasMem && avgMem >= cfg.HighMemoryFor(ResourceEC2) {
+					slog.Debug("Instance has low CPU but high memory — not idle",
+						"instance", id, "cpu", avgCPU, "memory", avgMem)
+					continue
+				}
 
-					inst := instanceMap[id]
-					instanceType := string(inst.InstanceType)
-					cost := pricing.MonthlyEC2Cost(instanceType, s.region)
-					result.Findings = append(result.Findings, Finding{
-						ID:                    FindingIdleEC2,
-						Severity:              SeverityHigh,
-						ResourceType:          ResourceEC2,
-						ResourceID:            id,
-						ResourceName:          instanceName(inst),
-						Region:                s.region,
-						Message:               idleMessage(avgCPU, avgMem, hasMem, cfg.IdleDays),
-						EstimatedMonthlyWaste: cost,
-						Metadata: map[string]any{
-							"instance_type":   instanceType,
-							"avg_cpu_percent": avgCPU,
-							"avg_mem_percent": avgMem,
-							"has_mem_metrics": hasMem,
-							"state":           "running",
-						},
-					})
+				inst := instanceMap[id]
+				instanceType := string(inst.InstanceType)
+				cost := pricing.MonthlyEC2Cost(instanceType, s.region)
+				meta := map[string]any{
+					"instance_type":   instanceType,
+					"avg_cpu_percent": avgCPU,
+					"avg_mem_percent": avgMem,
+					"has_mem_metrics": hasMem,
+					"state":           "running",
 				}
+				setTagsMetadata(meta, ec2TagsToMap(inst.Tags))
+
+				result.Findings = append(result.Findings, Finding{
+					ID:                    FindingIdleEC2,
+					Severity:              SeverityHigh,
+					ResourceType:          ResourceEC2,
+					ResourceID:            id,
+					ResourceName:          instanceName(inst),
+					Region:                s.region,
+					Message:               idleMessage(avgCPU, avgMem, hasMem, cfg.IdleDays),
+					EstimatedMonthlyWaste: cost,
+					Metadata:              meta,
+				})
 			}
+
+			s.appendRightsizeFindings(ctx, result, cfg, busyIDs, cpuMap, memMap, instanceMap)
 		}
 	}
 
+	s.appendOldGenerationFindings(result, runningIDs, buildInstanceMap(instances))
+
+	if len(cfg.SpotEligibleTags) > 0 {
+		s.appendSpotFindings(ctx, result, cfg, runningIDs, buildInstanceMap(instances))
+	}
+
+	if cfg.CommitmentAware {
+		s.applyReservedInstances(ctx, result, instances)
+	}
+
 	return result, nil
 }
 
+// applyReservedInstances zeroes the waste of idle instances that active Reserved
+// Instances pay for. Reservations are matched by instance type in the region;
+// zonal scope and instance size flexibility are not modeled.
+func (s *EC2Scanner) applyReservedInstances(ctx context.Context, result *ScanResult, instances []ec2types.Instance) {
+	out, err := s.client.DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("state"), Values: []string{string(ec2types.ReservedInstanceStateActive)}},
+		},
+	})
+	if err != nil {
+		slog.Warn("Failed to describe Reserved Instances", "region", s.region, "error", err)
+		return
+	}
+
+	reserved := make(map[string]int)
+	for _, ri := range out.ReservedInstances {
+		reserved[string(ri.InstanceType)] += int(derefInt32(ri.InstanceCount))
+	}
+	running := make(map[string]int)
+	for _, inst := range instances {
+		if inst.State != nil && inst.State.Name == ec2types.InstanceStateNameRunning {
+			running[string(inst.InstanceType)]++
+		}
+	}
+
+	applyReservedCoverage(result.Findings, func(f Finding) (string, bool) {
+		if f.ID != FindingIdleEC2 {
+			return "", false
+		}
+		instanceType, ok := f.Metadata["instance_type"].(string)
+		return instanceType, ok
+	}, running, reserved)
+}
+
+// appendSpotFindings prices running on-demand instances that carry a spot-eligible tag
+// at their type's average Spot price. An instance with no other finding is reported as
+// EC2_SPOT_CANDIDATE with the difference as savings; findings already reported for it
+// gain both estimates in metadata instead, so the same spend is not counted twice.
+func (s *EC2Scanner) appendSpotFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, ids []string, instanceMap map[string]ec2types.Instance) {
+	existing := make(map[string][]int)
+	for i, f := range result.Findings {
+		existing[f.ResourceID] = append(existing[f.ResourceID], i)
+	}
+
+	spotByType := make(map[string]float64)
+	for _, id := range ids {
+		inst := instanceMap[id]
+		tags := ec2TagsToMap(inst.Tags)
+		if inst.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot || !matchesAnyTag(tags, cfg.SpotEligibleTags) {
+			continue
+		}
+
+		instanceType := string(inst.InstanceType)
+		spot, ok := spotByType[instanceType]
+		if !ok {
+			// A type with no Spot history in the region prices at 0 and is skipped below.
+			monthly, _, err := pricing.SpotMonthlyEC2Cost(ctx, s.client, instanceType, cfg.IdleDays)
+			if err != nil {
+				slog.Warn("Failed to fetch Spot price history", "region", s.region, "instance_type", instanceType, "error", err)
+				return
+			}
+			spot = monthly
+			spotByType[instanceType] = spot
+		}
+		onDemand := pricing.MonthlyEC2Cost(instanceType, s.region)
+		if spot == 0 || onDemand == 0 || spot >= onDemand {
+			continue
+		}
+
+		if idx, ok := existing[id]; ok {
+			for _, i := range idx {
+				result.Findings[i].Metadata["on_demand_monthly_cost"] = onDemand
+				result.Findings[i].Metadata["spot_monthly_cost"] = spot
+			}
+			continue
+		}
+
+		savings := onDemand - spot
+		savingsPercent := math.Round(savings/onDemand*1000) / 10
+		meta := map[string]any{
+			"instance_type":          instanceType,
+			"on_demand_monthly_cost": onDemand,
+			"spot_monthly_cost":      spot,
+			"savings_percent":        savingsPercent,
+		}
+		setTagsMetadata(meta, tags)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingEC2SpotCandidate,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceEC2,
+			ResourceID:            id,
+			ResourceName:          instanceName(inst),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Tagged spot-eligible, %s averages %.1f%% cheaper on Spot", instanceType, savingsPercent),
+			EstimatedMonthlyWaste: savings,
+			Metadata:              meta,
+		})
+	}
+}
+
+// appendOldGenerationFindings recommends the current-generation equivalent for running
+// previous-generation instances, regardless of utilization. Instances already reported
+// as idle are skipped: their whole cost is counted as waste. Both a generation upgrade
+// and RIGHTSIZE_EC2 save against the same current price, so an instance keeps only the
+// larger of the two as a finding and records the other as alternative_type and
+// alternative_savings metadata.
+func (s *EC2Scanner) appendOldGenerationFindings(result *ScanResult, ids []string, instanceMap map[string]ec2types.Instance) {
+	existing := make(map[string]int)
+	for i, f := range result.Findings {
+		if f.ID == FindingIdleEC2 || f.ID == FindingRightsizeEC2 {
+			existing[f.ResourceID] = i
+		}
+	}
+
+	for _, id := range ids {
+		i, reported := existing[id]
+		if reported && result.Findings[i].ID == FindingIdleEC2 {
+			continue
+		}
+		inst := instanceMap[id]
+		instanceType := string(inst.InstanceType)
+		recommended, ok := pricing.EC2CurrentGenerationType(instanceType)
+		if !ok {
+			continue
+		}
+		current := pricing.MonthlyEC2Cost(instanceType, s.region)
+		upgraded := pricing.MonthlyEC2Cost(recommended, s.region)
+		if current == 0 || upgraded == 0 || upgraded >= current {
+			continue
+		}
+		savings := current - upgraded
+		savingsPercent := math.Round(savings/current*1000) / 10
+
+		meta := map[string]any{
+			"current_type":      instanceType,
+			"recommended_type":  recommended,
+			"projected_savings": savings,
+			"savings_percent":   savingsPercent,
+		}
+		setTagsMetadata(meta, ec2TagsToMap(inst.Tags))
+
+		finding := Finding{
+			ID:                    FindingOldGenerationEC2,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceEC2,
+			ResourceID:            id,
+			ResourceName:          instanceName(inst),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Previous-generation %s, %s is %.1f%% cheaper", instanceType, recommended, savingsPercent),
+			EstimatedMonthlyWaste: savings,
+			Metadata:              meta,
+		}
+		if !reported {
+			result.Findings = append(result.Findings, finding)
+			continue
+		}
+		rightsize := &result.Findings[i]
+		if savings <= rightsize.EstimatedMonthlyWaste {
+			rightsize.Metadata["alternative_type"] = recommended
+			rightsize.Metadata["alternative_savings"] = savings
+			continue
+		}
+		meta["alternative_type"] = rightsize.Metadata["recommended_type"]
+		meta["alternative_savings"] = rightsize.EstimatedMonthlyWaste
+		*rightsize = finding
+	}
+}
+
+// appendRightsizeFindings recommends the next-smaller size for running instances that
+// are above the idle threshold but whose peak CPU, and average memory when the agent
+// reports it, stay under rightsizeMaxPercent. The next size down halves the vCPUs and
+// memory, so a 40% peak lands near 80% on the smaller instance.
+func (s *EC2Scanner) appendRightsizeFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, ids []string, cpuMap, memMap map[string]float64, instanceMap map[string]ec2types.Instance) {
+	var candidates []string
+	for _, id := range ids {
+		if avgMem, hasMem := memMap[id]; hasMem && avgMem >= rightsizeMaxPercent {
+			continue
+		}
+		if _, ok := pricing.EC2NextSmallerType(string(instanceMap[id].InstanceType)); ok {
+			candidates = append(candidates, id)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	series, err := s.metrics.FetchSeries(ctx, "AWS/EC2", "CPUUtilization", "InstanceId", candidates, cfg.IdleDays, "Maximum")
+	if err != nil {
+		slog.Warn("Failed to fetch EC2 peak CPU metrics", "region", s.region, "error", err)
+		return
+	}
+
+	for _, id := range candidates {
+		points, ok := series[id]
+		if !ok {
+			continue
+		}
+		var maxCPU float64
+		for _, p := range points {
+			maxCPU = max(maxCPU, p.Value)
+		}
+		if maxCPU >= rightsizeMaxPercent {
+			continue
+		}
+
+		inst := instanceMap[id]
+		instanceType := string(inst.InstanceType)
+		recommended, _ := pricing.EC2NextSmallerType(instanceType)
+		current := pricing.MonthlyEC2Cost(instanceType, s.region)
+		smaller := pricing.MonthlyEC2Cost(recommended, s.region)
+		if current == 0 || smaller == 0 || smaller >= current {
+			continue
+		}
+		savings := current - smaller
+
+		avgMem, hasMem := memMap[id]
+		meta := map[string]any{
+			"instance_type":     instanceType,
+			"recommended_type":  recommended,
+			"projected_savings": savings,
+			"avg_cpu_percent":   cpuMap[id],
+			"max_cpu_percent":   maxCPU,
+			"avg_mem_percent":   avgMem,
+			"has_mem_metrics":   hasMem,
+		}
+		setTagsMetadata(meta, ec2TagsToMap(inst.Tags))
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRightsizeEC2,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceEC2,
+			ResourceID:            id,
+			ResourceName:          instanceName(inst),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Peak CPU %.1f%% over %d days, downsize %s to %s", maxCPU, cfg.IdleDays, instanceType, recommended),
+			EstimatedMonthlyWaste: savings,
+			Metadata:              meta,
+		})
+	}
+}
+
 func (s *EC2Scanner) listInstances(ctx context.Context) ([]ec2types.Instance, error) {
 	var instances []ec2types.Instance
 	paginator := ec2.NewDescribeInstancesPaginator(s.client, &ec2.DescribeInstancesInput{
@@ -267,9 +538,19 @@ func instanceName(inst ec2types.Instance) string {
 	return ""
 }
 
+// stateTransitionTime matches the timestamp EC2 appends to a state transition
+// reason, e.g. "User initiated (2024-01-15 13:22:00 GMT)".
+var stateTransitionTime = regexp.MustCompile(`\((\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}) GMT\)`)
+
+// stoppedSince returns when the instance was stopped, parsed from its state
+// transition reason. LaunchTime is the fallback when the reason carries no
+// timestamp; it is only a lower bound on when the instance was last active.
 func stoppedSince(inst ec2types.Instance) time.Time {
-	// LaunchTime is used as a proxy for when the instance was last active.
-	// For a more precise stopped-since timestamp, CloudTrail events would be needed.
+	if m := stateTransitionTime.FindStringSubmatch(deref(inst.StateTransitionReason)); m != nil {
+		if t, err := time.Parse(time.DateTime, m[1]); err == nil {
+			return t
+		}
+	}
 	if inst.LaunchTime != nil {
 		return *inst.LaunchTime
 	}
@@ -11,11 +11,14 @@ import (
 	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 type mockEC2Client struct {
 	instances []ec2types.Reservation
 	volumes   []ec2types.Volume
+	reserved  []ec2types.ReservedInstances
+	spot      map[string][]string // instance type → Spot prices
 }
 
 func (m *mockEC2Client) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
@@ -30,6 +33,20 @@ func (m *mockEC2Client) DescribeVolumes(_ context.Context, _ *ec2.DescribeVolume
 	}, nil
 }
 
+func (m *mockEC2Client) DescribeReservedInstances(_ context.Context, _ *ec2.DescribeReservedInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{ReservedInstances: m.reserved}, nil
+}
+
+func (m *mockEC2Client) DescribeSpotPriceHistory(_ context.Context, input *ec2.DescribeSpotPriceHistoryInput, _ ...func(*ec2.Options)) (*ec2.DescribeSpotPriceHistoryOutput, error) {
+	var history []ec2types.SpotPrice
+	for _, it := range input.InstanceTypes {
+		for _, p := range m.spot[string(it)] {
+			history = append(history, ec2types.SpotPrice{InstanceType: it, SpotPrice: awssdk.String(p)})
+		}
+	}
+	return &ec2.DescribeSpotPriceHistoryOutput{SpotPriceHistory: history}, nil
+}
+
 func newMockMetricsFetcher(cpuValues map[string]float64) *MetricsFetcher {
 	return NewMetricsFetcher(&mockCloudWatchClient{
 		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
@@ -50,6 +67,26 @@ func newMockMetricsFetcher(cpuValues map[string]float64) *MetricsFetcher {
 	})
 }
 
+// newMetricsByName serves values keyed by "<metric>/<first dimension value>", for
+// scanners that fetch several metrics for the same resource.
+func newMetricsByName(values map[string]float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				key := *q.MetricStat.Metric.MetricName + "/" + *q.MetricStat.Metric.Dimensions[0].Value
+				if v, ok := values[key]; ok {
+					results = append(results, cwtypes.MetricDataResult{
+						Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+						Values: []float64{v},
+					})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
 func newEC2MockMetricsFetcher(cpuValues, memValues map[string]float64) *MetricsFetcher {
 	return NewMetricsFetcher(&mockCloudWatchClient{
 		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
@@ -133,6 +170,47 @@ func TestEC2Scanner_IdleInstance(t *testing.T) {
 	}
 }
 
+func TestEC2Scanner_PerTypeIdleCPUThreshold(t *testing.T) {
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{
+			{
+				Instances: []ec2types.Instance{
+					{
+						InstanceId:   awssdk.String("i-quiet001"),
+						InstanceType: ec2types.InstanceTypeT3Large,
+						State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+					},
+				},
+			},
+		},
+	}
+	metrics := newEC2MockMetricsFetcher(map[string]float64{"i-quiet001": 4.0}, nil)
+	scanner := NewEC2Scanner(mock, metrics, "us-east-1")
+	base := ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30}
+
+	// An override for another type leaves the global 5% threshold in effect.
+	cfg := base
+	cfg.TypeThresholds = map[ResourceType]Thresholds{ResourceRDS: {IdleCPU: 10}}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ID != FindingIdleEC2 {
+		t.Fatalf("expected IDLE_EC2 at 4%% CPU under the global 5%% threshold, got %+v", result.Findings)
+	}
+
+	cfg.TypeThresholds = map[ResourceType]Thresholds{ResourceEC2: {IdleCPU: 3}}
+	result, err = scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, f := range result.Findings {
+		if f.ID == FindingIdleEC2 {
+			t.Fatalf("expected the ec2 3%% threshold to treat 4%% CPU as busy, got %+v", f)
+		}
+	}
+}
+
 func TestEC2Scanner_HealthyInstance(t *testing.T) {
 	mock := &mockEC2Client{
 		instances: []ec2types.Reservation{
@@ -367,6 +445,118 @@ func TestEC2Scanner_ExcludedByTag(t *testing.T) {
 	}
 }
 
+func TestEC2Scanner_ReservedInstanceCoverage(t *testing.T) {
+	running := func(id string) ec2types.Instance {
+		return ec2types.Instance{
+			InstanceId:   awssdk.String(id),
+			InstanceType: ec2types.InstanceTypeT3Large,
+			State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+		}
+	}
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{
+			{Instances: []ec2types.Instance{running("i-idle1"), running("i-idle2"), running("i-busy")}},
+		},
+		reserved: []ec2types.ReservedInstances{
+			{InstanceType: ec2types.InstanceTypeT3Large, InstanceCount: awssdk.Int32(2)},
+		},
+	}
+
+	metrics := newEC2MockMetricsFetcher(map[string]float64{"i-idle1": 1.0, "i-idle2": 1.0, "i-busy": 60.0}, nil)
+	scanner := NewEC2Scanner(mock, metrics, "us-east-1")
+
+	cfg := ScanConfig{
+		IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30,
+		CommitmentAware: true,
+	}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Three running, two reserved: deleting one idle instance saves its on-demand
+	// cost, deleting the second only leaves a reservation unused.
+	onDemand := pricing.MonthlyEC2Cost("t3.large", "us-east-1")
+	byID := findingsByResourceID(result.Findings)
+	first, second := byID["i-idle1"], byID["i-idle2"]
+	if first.EstimatedMonthlyWaste != onDemand || first.Metadata["covered_by_commitment"] != false {
+		t.Fatalf("expected i-idle1 uncovered at $%.2f, got $%.2f %v", onDemand, first.EstimatedMonthlyWaste, first.Metadata)
+	}
+	if second.EstimatedMonthlyWaste != 0 || second.Metadata["covered_by_commitment"] != true || !second.Hygiene {
+		t.Fatalf("expected i-idle2 covered at $0, got $%.2f %v", second.EstimatedMonthlyWaste, second.Metadata)
+	}
+	if second.Metadata["on_demand_monthly_cost"] != onDemand {
+		t.Fatalf("expected on_demand_monthly_cost $%.2f, got %v", onDemand, second.Metadata["on_demand_monthly_cost"])
+	}
+}
+
+func TestEC2Scanner_SpotCandidate(t *testing.T) {
+	spotTag := []ec2types.Tag{{Key: awssdk.String("spot-eligible"), Value: awssdk.String("true")}}
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{
+			{
+				Instances: []ec2types.Instance{
+					{
+						InstanceId:   awssdk.String("i-busy"),
+						InstanceType: ec2types.InstanceTypeT3Large,
+						State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+						Tags:         spotTag,
+					},
+					{
+						InstanceId:   awssdk.String("i-idle"),
+						InstanceType: ec2types.InstanceTypeT3Large,
+						State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+						Tags:         spotTag,
+					},
+					{
+						InstanceId:   awssdk.String("i-untagged"),
+						InstanceType: ec2types.InstanceTypeT3Large,
+						State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+					},
+				},
+			},
+		},
+		spot: map[string][]string{"t3.large": {"0.0250", "0.0350"}},
+	}
+
+	metrics := newEC2MockMetricsFetcher(map[string]float64{"i-busy": 60.0, "i-idle": 1.0, "i-untagged": 60.0}, nil)
+	scanner := NewEC2Scanner(mock, metrics, "us-east-1")
+
+	cfg := ScanConfig{
+		IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30,
+		SpotEligibleTags: map[string]string{"spot-eligible": "true"},
+	}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Average Spot price $0.03/hour * 730 = $21.90
+	onDemand := pricing.MonthlyEC2Cost("t3.large", "us-east-1")
+	var candidates []Finding
+	for _, f := range result.Findings {
+		if f.ID == FindingEC2SpotCandidate {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) != 1 || candidates[0].ResourceID != "i-busy" {
+		t.Fatalf("expected one EC2_SPOT_CANDIDATE for i-busy, got %+v", candidates)
+	}
+	f := candidates[0]
+	spot, _ := f.Metadata["spot_monthly_cost"].(float64)
+	if spot < 21.89 || spot > 21.91 {
+		t.Fatalf("expected spot_monthly_cost ~$21.90, got %v", f.Metadata["spot_monthly_cost"])
+	}
+	if f.Metadata["on_demand_monthly_cost"] != onDemand || f.EstimatedMonthlyWaste != onDemand-spot {
+		t.Fatalf("expected savings $%.2f, got $%.2f", onDemand-spot, f.EstimatedMonthlyWaste)
+	}
+
+	idle := findingsByResourceID(result.Findings)["i-idle"]
+	if idle.ID != FindingIdleEC2 || idle.Metadata["spot_monthly_cost"] != spot {
+		t.Fatalf("expected idle finding annotated with spot cost, got %s %v", idle.ID, idle.Metadata)
+	}
+}
+
 func TestEC2Scanner_LowCPUHighMemory_NotIdle(t *testing.T) {
 	mock := &mockEC2Client{
 		instances: []ec2types.Reservation{
@@ -474,6 +664,197 @@ func TestEC2Scanner_LowCPU_NoCWAgent_FallbackIdle(t *testing.T) {
 	}
 }
 
+// newRightsizeMetricsFetcher serves average and peak CPU separately, plus average memory.
+func newRightsizeMetricsFetcher(avgCPU, maxCPU, avgMem map[string]float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				values := avgCPU
+				if *q.MetricStat.Metric.Namespace == "CWAgent" {
+					values = avgMem
+				} else if *q.MetricStat.Stat == "Maximum" {
+					values = maxCPU
+				}
+				if v, ok := values[*q.MetricStat.Metric.Dimensions[0].Value]; ok {
+					results = append(results, cwtypes.MetricDataResult{
+						Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+						Values: []float64{v},
+					})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func runningInstance(id string, instanceType ec2types.InstanceType) ec2types.Instance {
+	return ec2types.Instance{
+		InstanceId:   awssdk.String(id),
+		InstanceType: instanceType,
+		State:        &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+	}
+}
+
+func TestEC2Scanner_Rightsize(t *testing.T) {
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{{
+			Instances: []ec2types.Instance{
+				runningInstance("i-oversized", ec2types.InstanceTypeM52xlarge),
+				runningInstance("i-spiky", ec2types.InstanceTypeM52xlarge),
+				runningInstance("i-memory", ec2types.InstanceTypeM52xlarge),
+				runningInstance("i-smallest", ec2types.InstanceTypeM5Large),
+			},
+		}},
+	}
+	metrics := newRightsizeMetricsFetcher(
+		map[string]float64{"i-oversized": 8, "i-spiky": 8, "i-memory": 8, "i-smallest": 8},
+		map[string]float64{"i-oversized": 30, "i-spiky": 95, "i-memory": 30, "i-smallest": 30},
+		map[string]float64{"i-oversized": 20, "i-memory": 70},
+	)
+	scanner := NewEC2Scanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingRightsizeEC2 || f.ResourceID != "i-oversized" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	if f.Metadata["recommended_type"] != "m5.xlarge" {
+		t.Fatalf("expected recommended_type m5.xlarge, got %v", f.Metadata["recommended_type"])
+	}
+	want := pricing.MonthlyEC2Cost("m5.2xlarge", "us-east-1") - pricing.MonthlyEC2Cost("m5.xlarge", "us-east-1")
+	if f.EstimatedMonthlyWaste != want || f.Metadata["projected_savings"] != want {
+		t.Fatalf("expected savings $%.2f, got $%.2f (metadata %v)", want, f.EstimatedMonthlyWaste, f.Metadata["projected_savings"])
+	}
+}
+
+func TestEC2Scanner_OldGeneration(t *testing.T) {
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{{
+			Instances: []ec2types.Instance{
+				runningInstance("i-m4", ec2types.InstanceTypeM4Xlarge),
+				runningInstance("i-t2-idle", ec2types.InstanceTypeT2Large),
+				runningInstance("i-m5", ec2types.InstanceTypeM5Xlarge),
+			},
+		}},
+	}
+	metrics := newEC2MockMetricsFetcher(map[string]float64{"i-m4": 60, "i-t2-idle": 1, "i-m5": 60}, nil)
+	scanner := NewEC2Scanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+	if byID["i-t2-idle"].ID != FindingIdleEC2 {
+		t.Fatalf("expected idle t2 to be reported only as IDLE_EC2, got %s", byID["i-t2-idle"].ID)
+	}
+
+	f := byID["i-m4"]
+	if f.ID != FindingOldGenerationEC2 {
+		t.Fatalf("expected OLD_GENERATION_EC2, got %s", f.ID)
+	}
+	// m4.xlarge $0.20/hour vs m5.xlarge $0.192/hour: $5.84/month, 4% cheaper
+	if f.EstimatedMonthlyWaste < 5.83 || f.EstimatedMonthlyWaste > 5.85 {
+		t.Fatalf("expected ~$5.84, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["current_type"] != "m4.xlarge" || f.Metadata["recommended_type"] != "m5.xlarge" || f.Metadata["savings_percent"] != 4.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestEC2Scanner_OldGenerationKeepsOneRecommendation(t *testing.T) {
+	instances := map[string]ec2types.Instance{
+		"i-small-rightsize": runningInstance("i-small-rightsize", ec2types.InstanceTypeM4Xlarge),
+		"i-big-rightsize":   runningInstance("i-big-rightsize", ec2types.InstanceTypeM4Xlarge),
+	}
+	result := &ScanResult{Findings: []Finding{
+		{ID: FindingRightsizeEC2, ResourceID: "i-small-rightsize", EstimatedMonthlyWaste: 1, Metadata: map[string]any{"recommended_type": "m4.large"}},
+		{ID: FindingRightsizeEC2, ResourceID: "i-big-rightsize", EstimatedMonthlyWaste: 70, Metadata: map[string]any{"recommended_type": "m4.large"}},
+	}}
+	scanner := NewEC2Scanner(&mockEC2Client{}, nil, "us-east-1")
+
+	scanner.appendOldGenerationFindings(result, []string{"i-small-rightsize", "i-big-rightsize"}, instances)
+
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected one finding per instance, got %d: %v", len(result.Findings), result.Findings)
+	}
+	byID := findingsByResourceID(result.Findings)
+	// m4.xlarge → m5.xlarge saves ~$5.84, more than the $1 rightsize and less than the $70 one.
+	upgraded := byID["i-small-rightsize"]
+	if upgraded.ID != FindingOldGenerationEC2 || upgraded.Metadata["alternative_type"] != "m4.large" || upgraded.Metadata["alternative_savings"] != 1.0 {
+		t.Fatalf("expected OLD_GENERATION_EC2 with the rightsize as alternative, got %s %v", upgraded.ID, upgraded.Metadata)
+	}
+	kept := byID["i-big-rightsize"]
+	if kept.ID != FindingRightsizeEC2 || kept.EstimatedMonthlyWaste != 70 || kept.Metadata["alternative_type"] != "m5.xlarge" {
+		t.Fatalf("expected RIGHTSIZE_EC2 with the upgrade as alternative, got %s %v", kept.ID, kept.Metadata)
+	}
+}
+
+func TestStoppedSince(t *testing.T) {
+	launch := time.Date(2023, 6, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		reason string
+		want   time.Time
+	}{
+		{"user initiated", "User initiated (2024-01-15 13:22:00 GMT)", time.Date(2024, 1, 15, 13, 22, 0, 0, time.UTC)},
+		{"service initiated", "Service initiated (2024-02-29 00:00:59 GMT)", time.Date(2024, 2, 29, 0, 0, 59, 0, time.UTC)},
+		{"no timestamp", "Server.SpotInstanceTermination: Spot instance termination", launch},
+		{"invalid date", "User initiated (2024-13-45 99:00:00 GMT)", launch},
+		{"empty reason", "", launch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inst := ec2types.Instance{LaunchTime: &launch, StateTransitionReason: awssdk.String(tt.reason)}
+			if got := stoppedSince(inst); !got.Equal(tt.want) {
+				t.Fatalf("stoppedSince(%q) = %v, want %v", tt.reason, got, tt.want)
+			}
+		})
+	}
+
+	if got := stoppedSince(ec2types.Instance{}); !got.IsZero() {
+		t.Fatalf("expected zero time without reason or launch time, got %v", got)
+	}
+}
+
+func TestEC2Scanner_StoppedUsesTransitionReason(t *testing.T) {
+	// Launched long ago but stopped yesterday: not stopped long enough to report.
+	launchTime := time.Now().Add(-400 * 24 * time.Hour)
+	stoppedAt := time.Now().Add(-24 * time.Hour).UTC().Format(time.DateTime)
+	mock := &mockEC2Client{
+		instances: []ec2types.Reservation{{
+			Instances: []ec2types.Instance{{
+				InstanceId:            awssdk.String("i-recent"),
+				InstanceType:          ec2types.InstanceTypeT3Large,
+				State:                 &ec2types.InstanceState{Name: ec2types.InstanceStateNameStopped},
+				LaunchTime:            &launchTime,
+				StateTransitionReason: awssdk.String("User initiated (" + stoppedAt + " GMT)"),
+			}},
+		}},
+	}
+	scanner := NewEC2Scanner(mock, newEC2MockMetricsFetcher(nil, nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, StoppedThresholdDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d: %v", len(result.Findings), result.Findings)
+	}
+}
+
 func TestEC2Scanner_Type(t *testing.T) {
 	scanner := &EC2Scanner{}
 	if scanner.Type() != ResourceEC2 {
@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// ECRAPI is the minimal interface for ECR operations.
+type ECRAPI interface {
+	DescribeRepositories(ctx context.Context, input *ecr.DescribeRepositoriesInput, opts ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error)
+	DescribeImages(ctx context.Context, input *ecr.DescribeImagesInput, opts ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error)
+	GetLifecyclePolicy(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+	ListTagsForResource(ctx context.Context, input *ecr.ListTagsForResourceInput, opts ...func(*ecr.Options)) (*ecr.ListTagsForResourceOutput, error)
+}
+
+// ECRScanner detects repositories accumulating stale images without a lifecycle policy.
+type ECRScanner struct {
+	client ECRAPI
+	region string
+}
+
+// NewECRScanner creates a scanner for ECR repositories.
+func NewECRScanner(client ECRAPI, region string) *ECRScanner {
+	return &ECRScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *ECRScanner) Type() ResourceType {
+	return ResourceECR
+}
+
+// Scan examines repositories without a lifecycle policy for images that were pushed
+// and last pulled before the stale window. Untagged images are counted directly; tagged
+// images that nothing has pulled within the window are treated as no longer deployed.
+func (s *ECRScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	repos, err := s.listRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list ECR repositories: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(repos)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, repo := range repos {
+		name := deref(repo.RepositoryName)
+		if cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+		tags, err := s.repositoryTags(ctx, deref(repo.RepositoryArn))
+		if err != nil {
+			slog.Debug("Failed to list ECR repository tags", "repository", name, "error", err)
+		}
+		if cfg.Exclude.ShouldExclude(name, tags) {
+			continue
+		}
+
+		hasPolicy, err := s.hasLifecyclePolicy(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to get ECR lifecycle policy", "repository", name, "error", err)
+			continue
+		}
+		if hasPolicy {
+			continue
+		}
+
+		images, err := s.listImages(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to describe ECR images", "repository", name, "error", err)
+			continue
+		}
+
+		var staleBytes int64
+		var untagged, tagged int
+		for _, img := range images {
+			if !ecrImageStale(img, cutoff) {
+				continue
+			}
+			staleBytes += derefInt64(img.ImageSizeInBytes)
+			if len(img.ImageTags) == 0 {
+				untagged++
+			} else {
+				tagged++
+			}
+		}
+		staleCount := untagged + tagged
+		if staleCount == 0 {
+			continue
+		}
+
+		staleGiB := float64(staleBytes) / bytesPerGiB
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingECRStaleImages,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceECR,
+			ResourceID:            name,
+			ResourceName:          deref(repo.RepositoryArn),
+			Region:                s.region,
+			Message:               fmt.Sprintf("%d images (%.1f GiB) not pushed or pulled in %d days and no lifecycle policy", staleCount, staleGiB, cfg.StaleDays),
+			EstimatedMonthlyWaste: staleGiB * pricing.ECRStorageCostPerGB(s.region),
+			Metadata: withTags(map[string]any{
+				"image_count":          len(images),
+				"stale_image_count":    staleCount,
+				"stale_untagged_count": untagged,
+				"stale_tagged_count":   tagged,
+				"stale_size_bytes":     staleBytes,
+			}, tags),
+		})
+	}
+
+	return result, nil
+}
+
+// ecrImageStale reports whether an active image was pushed before cutoff and has not
+// been pulled since. Images pulled as part of a multi-arch index share its pull time.
+func ecrImageStale(img ecrtypes.ImageDetail, cutoff time.Time) bool {
+	if img.ImageStatus != "" && img.ImageStatus != ecrtypes.ImageStatusActive {
+		return false
+	}
+	if img.ImagePushedAt == nil || img.ImagePushedAt.After(cutoff) {
+		return false
+	}
+	return img.LastRecordedPullTime == nil || !img.LastRecordedPullTime.After(cutoff)
+}
+
+func (s *ECRScanner) hasLifecyclePolicy(ctx context.Context, name string) (bool, error) {
+	_, err := s.client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{RepositoryName: &name})
+	if err != nil {
+		var notFound *ecrtypes.LifecyclePolicyNotFoundException
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *ECRScanner) listRepositories(ctx context.Context) ([]ecrtypes.Repository, error) {
+	var repos []ecrtypes.Repository
+	paginator := ecr.NewDescribeRepositoriesPaginator(s.client, &ecr.DescribeRepositoriesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, page.Repositories...)
+	}
+	return repos, nil
+}
+
+func (s *ECRScanner) listImages(ctx context.Context, name string) ([]ecrtypes.ImageDetail, error) {
+	var images []ecrtypes.ImageDetail
+	paginator := ecr.NewDescribeImagesPaginator(s.client, &ecr.DescribeImagesInput{RepositoryName: &name})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, page.ImageDetails...)
+	}
+	return images, nil
+}
+
+func (s *ECRScanner) repositoryTags(ctx context.Context, arn string) (map[string]string, error) {
+	if arn == "" {
+		return nil, nil
+	}
+	out, err := s.client.ListTagsForResource(ctx, &ecr.ListTagsForResourceInput{ResourceArn: &arn})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Tags) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string, len(out.Tags))
+	for _, t := range out.Tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+type mockECRClient struct {
+	repos    []ecrtypes.Repository
+	images   map[string][]ecrtypes.ImageDetail
+	policies map[string]bool
+	tags     map[string][]ecrtypes.Tag
+}
+
+func (m *mockECRClient) DescribeRepositories(_ context.Context, _ *ecr.DescribeRepositoriesInput, _ ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	return &ecr.DescribeRepositoriesOutput{Repositories: m.repos}, nil
+}
+
+func (m *mockECRClient) DescribeImages(_ context.Context, input *ecr.DescribeImagesInput, _ ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	return &ecr.DescribeImagesOutput{ImageDetails: m.images[*input.RepositoryName]}, nil
+}
+
+func (m *mockECRClient) GetLifecyclePolicy(_ context.Context, input *ecr.GetLifecyclePolicyInput, _ ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	if m.policies[*input.RepositoryName] {
+		return &ecr.GetLifecyclePolicyOutput{LifecyclePolicyText: awssdk.String("{}")}, nil
+	}
+	return nil, &ecrtypes.LifecyclePolicyNotFoundException{Message: awssdk.String("not found")}
+}
+
+func (m *mockECRClient) ListTagsForResource(_ context.Context, input *ecr.ListTagsForResourceInput, _ ...func(*ecr.Options)) (*ecr.ListTagsForResourceOutput, error) {
+	return &ecr.ListTagsForResourceOutput{Tags: m.tags[*input.ResourceArn]}, nil
+}
+
+func ecrRepo(name string) ecrtypes.Repository {
+	return ecrtypes.Repository{
+		RepositoryName: awssdk.String(name),
+		RepositoryArn:  awssdk.String("arn:aws:ecr:us-east-1:123456789012:repository/" + name),
+	}
+}
+
+func ecrImage(sizeGiB int64, pushedAgo, pulledAgo time.Duration, tags ...string) ecrtypes.ImageDetail {
+	img := ecrtypes.ImageDetail{
+		ImageSizeInBytes: awssdk.Int64(sizeGiB * bytesPerGiB),
+		ImagePushedAt:    awssdk.Time(time.Now().Add(-pushedAgo)),
+		ImageTags:        tags,
+		ImageStatus:      ecrtypes.ImageStatusActive,
+	}
+	if pulledAgo > 0 {
+		img.LastRecordedPullTime = awssdk.Time(time.Now().Add(-pulledAgo))
+	}
+	return img
+}
+
+func TestECRScanner_StaleImages(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockECRClient{
+		repos: []ecrtypes.Repository{ecrRepo("api"), ecrRepo("managed")},
+		images: map[string][]ecrtypes.ImageDetail{
+			"api": {
+				ecrImage(2, 200*day, 0),                 // untagged, never pulled
+				ecrImage(3, 200*day, 150*day, "v1.0.0"), // tagged, not pulled recently
+				ecrImage(5, 200*day, 2*day, "v1.1.0"),   // still pulled
+				ecrImage(7, 10*day, 0),                  // pushed recently
+			},
+			"managed": {ecrImage(10, 200*day, 0)},
+		},
+		policies: map[string]bool{"managed": true},
+	}
+	scanner := NewECRScanner(mock, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 2 {
+		t.Fatalf("expected 2 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingECRStaleImages || f.ResourceID != "api" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 5 GiB * $0.10 = $0.50
+	if f.EstimatedMonthlyWaste < 0.49 || f.EstimatedMonthlyWaste > 0.51 {
+		t.Fatalf("expected ~$0.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["stale_image_count"] != 2 || f.Metadata["image_count"] != 4 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if f.Metadata["stale_size_bytes"] != int64(5*bytesPerGiB) {
+		t.Fatalf("expected stale_size_bytes %d, got %v", int64(5*bytesPerGiB), f.Metadata["stale_size_bytes"])
+	}
+}
+
+func TestECRScanner_ExcludedByTag(t *testing.T) {
+	repo := ecrRepo("api")
+	mock := &mockECRClient{
+		repos:  []ecrtypes.Repository{repo},
+		images: map[string][]ecrtypes.ImageDetail{"api": {ecrImage(2, 200*24*time.Hour, 0)}},
+		tags:   map[string][]ecrtypes.Tag{*repo.RepositoryArn: {{Key: awssdk.String("keep"), Value: awssdk.String("true")}}},
+	}
+	scanner := NewECRScanner(mock, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{Tags: map[string]string{"keep": "true"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded repository to be skipped, got %d findings", len(result.Findings))
+	}
+}
@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	// ecsIdleUtilizationPct is the CPU and memory utilization below which a service is idle.
+	ecsIdleUtilizationPct = 1.0
+	// ecsDescribeClustersBatch and ecsDescribeServicesBatch are the API per-call limits.
+	ecsDescribeClustersBatch = 100
+	ecsDescribeServicesBatch = 10
+)
+
+// ECSAPI is the minimal interface for ECS operations.
+type ECSAPI interface {
+	ListClusters(ctx context.Context, input *ecs.ListClustersInput, opts ...func(*ecs.Options)) (*ecs.ListClustersOutput, error)
+	DescribeClusters(ctx context.Context, input *ecs.DescribeClustersInput, opts ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error)
+	ListServices(ctx context.Context, input *ecs.ListServicesInput, opts ...func(*ecs.Options)) (*ecs.ListServicesOutput, error)
+	DescribeServices(ctx context.Context, input *ecs.DescribeServicesInput, opts ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error)
+	DescribeTaskDefinition(ctx context.Context, input *ecs.DescribeTaskDefinitionInput, opts ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error)
+}
+
+// ECSScanner detects idle ECS services and clusters with no running tasks.
+type ECSScanner struct {
+	client  ECSAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewECSScanner creates a scanner for ECS clusters and services.
+func NewECSScanner(client ECSAPI, metrics *MetricsFetcher, region string) *ECSScanner {
+	return &ECSScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *ECSScanner) Type() ResourceType {
+	return ResourceECS
+}
+
+// Scan examines active ECS clusters and their services.
+func (s *ECSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusterARNs, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list ECS clusters: %w", err)
+	}
+
+	result := &ScanResult{}
+	if len(clusterARNs) == 0 {
+		return result, nil
+	}
+
+	clusters, err := s.describeClusters(ctx, clusterARNs)
+	if err != nil {
+		return nil, fmt.Errorf("describe ECS clusters: %w", err)
+	}
+	result.ResourcesScanned = len(clusters)
+
+	// Task definitions are shared across services; fetch each once.
+	taskDefs := make(map[string]*ecstypes.TaskDefinition)
+
+	for _, cluster := range clusters {
+		clusterARN := deref(cluster.ClusterArn)
+		clusterName := deref(cluster.ClusterName)
+		if cfg.Exclude.ShouldExclude(clusterARN, ecsTagsToMap(cluster.Tags)) {
+			continue
+		}
+		if deref(cluster.Status) != "ACTIVE" {
+			continue
+		}
+
+		// ECS_ZERO_TASK_CLUSTER: nothing running or pending
+		if cluster.RunningTasksCount == 0 && cluster.PendingTasksCount == 0 {
+			result.Findings = append(result.Findings, Finding{
+				ID:           FindingECSZeroTaskCluster,
+				Severity:     SeverityLow,
+				ResourceType: ResourceECS,
+				ResourceID:   clusterARN,
+				ResourceName: clusterName,
+				Region:       s.region,
+				Message:      fmt.Sprintf("Cluster has no running tasks (%d services, %d container instances)", cluster.ActiveServicesCount, cluster.RegisteredContainerInstancesCount),
+				// Container instances are billed (and reported) as EC2; the cluster itself is free.
+				Hygiene: true,
+				Metadata: withTags(map[string]any{
+					"active_services":                cluster.ActiveServicesCount,
+					"registered_container_instances": cluster.RegisteredContainerInstancesCount,
+				}, ecsTagsToMap(cluster.Tags)),
+			})
+			continue
+		}
+
+		services, err := s.listServices(ctx, clusterARN)
+		if err != nil {
+			slog.Warn("Failed to list ECS services", "cluster", clusterName, "error", err)
+			continue
+		}
+		result.ResourcesScanned += len(services)
+
+		var names []string
+		svcMap := make(map[string]ecstypes.Service, len(services))
+		for _, svc := range services {
+			arn := deref(svc.ServiceArn)
+			if cfg.Exclude.ShouldExclude(arn, ecsTagsToMap(svc.Tags)) {
+				continue
+			}
+			if deref(svc.Status) != "ACTIVE" || svc.DesiredCount == 0 {
+				continue
+			}
+			name := deref(svc.ServiceName)
+			names = append(names, name)
+			svcMap[name] = svc
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		staticDims := []cwtypes.Dimension{{Name: awssdk.String("ClusterName"), Value: awssdk.String(clusterName)}}
+		cpuMap, err := s.metrics.FetchAverageWithStaticDim(ctx, "AWS/ECS", "CPUUtilization", "ServiceName", names, cfg.IdleDays, staticDims)
+		if err != nil {
+			slog.Warn("Failed to fetch ECS CPU metrics", "region", s.region, "cluster", clusterName, "error", err)
+			continue
+		}
+		memMap, err := s.metrics.FetchAverageWithStaticDim(ctx, "AWS/ECS", "MemoryUtilization", "ServiceName", names, cfg.IdleDays, staticDims)
+		if err != nil {
+			slog.Warn("Failed to fetch ECS memory metrics", "region", s.region, "cluster", clusterName, "error", err)
+			continue
+		}
+
+		for _, name := range names {
+			avgCPU, hasCPU := cpuMap[name]
+			avgMem, hasMem := memMap[name]
+			if !hasCPU || !hasMem || avgCPU >= ecsIdleUtilizationPct || avgMem >= ecsIdleUtilizationPct {
+				continue
+			}
+
+			svc := svcMap[name]
+			fargate := isFargateService(svc)
+			meta := map[string]any{
+				"cluster":            clusterName,
+				"desired_count":      svc.DesiredCount,
+				"running_count":      svc.RunningCount,
+				"launch_type":        ecsLaunchType(svc),
+				"task_definition":    deref(svc.TaskDefinition),
+				"avg_cpu_percent":    avgCPU,
+				"avg_memory_percent": avgMem,
+			}
+			setTagsMetadata(meta, ecsTagsToMap(svc.Tags))
+
+			cost := 0.0
+			if fargate {
+				td := s.taskDefinition(ctx, deref(svc.TaskDefinition), taskDefs)
+				if vcpu, memGiB, ok := fargateTaskSize(td); ok {
+					cost = pricing.MonthlyFargateCost(vcpu, memGiB, s.region) * float64(svc.DesiredCount)
+					meta["task_vcpu"] = vcpu
+					meta["task_memory_gib"] = memGiB
+				}
+			}
+
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingECSIdleService,
+				Severity:              SeverityMedium,
+				ResourceType:          ResourceECS,
+				ResourceID:            deref(svc.ServiceArn),
+				ResourceName:          name,
+				Region:                s.region,
+				Message:               fmt.Sprintf("%d desired tasks with CPU %.1f%%, memory %.1f%% over %d days", svc.DesiredCount, avgCPU, avgMem, cfg.IdleDays),
+				EstimatedMonthlyWaste: cost,
+				// EC2-backed services are billed through their container instances.
+				Hygiene:  !fargate,
+				Metadata: meta,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// taskDefinition returns the task definition for arn, caching lookups. Failures are logged and return nil.
+func (s *ECSScanner) taskDefinition(ctx context.Context, arn string, cache map[string]*ecstypes.TaskDefinition) *ecstypes.TaskDefinition {
+	if td, ok := cache[arn]; ok {
+		return td
+	}
+	out, err := s.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: awssdk.String(arn),
+	})
+	if err != nil {
+		slog.Warn("Failed to describe ECS task definition", "task_definition", arn, "error", err)
+		cache[arn] = nil
+		return nil
+	}
+	cache[arn] = out.TaskDefinition
+	return out.TaskDefinition
+}
+
+// fargateTaskSize converts task-level CPU units and memory MiB to vCPU and GiB.
+func fargateTaskSize(td *ecstypes.TaskDefinition) (float64, float64, bool) {
+	if td == nil {
+		return 0, 0, false
+	}
+	cpuUnits, err := strconv.ParseFloat(deref(td.Cpu), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	memMiB, err := strconv.ParseFloat(deref(td.Memory), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return cpuUnits / 1024, memMiB / 1024, true
+}
+
+func isFargateService(svc ecstypes.Service) bool {
+	if svc.LaunchType == ecstypes.LaunchTypeFargate {
+		return true
+	}
+	for _, item := range svc.CapacityProviderStrategy {
+		switch deref(item.CapacityProvider) {
+		case "FARGATE", "FARGATE_SPOT":
+			return true
+		}
+	}
+	return false
+}
+
+func ecsLaunchType(svc ecstypes.Service) string {
+	if svc.LaunchType != "" {
+		return string(svc.LaunchType)
+	}
+	if len(svc.CapacityProviderStrategy) > 0 {
+		return "CAPACITY_PROVIDER"
+	}
+	return ""
+}
+
+func (s *ECSScanner) listClusters(ctx context.Context) ([]string, error) {
+	var arns []string
+	paginator := ecs.NewListClustersPaginator(s.client, &ecs.ListClustersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page.ClusterArns...)
+	}
+	return arns, nil
+}
+
+func (s *ECSScanner) describeClusters(ctx context.Context, arns []string) ([]ecstypes.Cluster, error) {
+	var clusters []ecstypes.Cluster
+	for _, batch := range batchIDs(arns, ecsDescribeClustersBatch) {
+		out, err := s.client.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: batch,
+			Include:  []ecstypes.ClusterField{ecstypes.ClusterFieldTags},
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, out.Clusters...)
+	}
+	return clusters, nil
+}
+
+func (s *ECSScanner) listServices(ctx context.Context, clusterARN string) ([]ecstypes.Service, error) {
+	var arns []string
+	paginator := ecs.NewListServicesPaginator(s.client, &ecs.ListServicesInput{
+		Cluster: awssdk.String(clusterARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page.ServiceArns...)
+	}
+
+	var services []ecstypes.Service
+	for _, batch := range batchIDs(arns, ecsDescribeServicesBatch) {
+		out, err := s.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  awssdk.String(clusterARN),
+			Services: batch,
+			Include:  []ecstypes.ServiceField{ecstypes.ServiceFieldTags},
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, out.Services...)
+	}
+	return services, nil
+}
+
+func ecsTagsToMap(tags []ecstypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m
+}
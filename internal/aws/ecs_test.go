@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+type mockECSClient struct {
+	clusters []ecstypes.Cluster
+	services map[string][]ecstypes.Service
+	taskDefs map[string]ecstypes.TaskDefinition
+}
+
+func (m *mockECSClient) ListClusters(_ context.Context, _ *ecs.ListClustersInput, _ ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	var arns []string
+	for _, c := range m.clusters {
+		arns = append(arns, *c.ClusterArn)
+	}
+	return &ecs.ListClustersOutput{ClusterArns: arns}, nil
+}
+
+func (m *mockECSClient) DescribeClusters(_ context.Context, _ *ecs.DescribeClustersInput, _ ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error) {
+	return &ecs.DescribeClustersOutput{Clusters: m.clusters}, nil
+}
+
+func (m *mockECSClient) ListServices(_ context.Context, input *ecs.ListServicesInput, _ ...func(*ecs.Options)) (*ecs.ListServicesOutput, error) {
+	var arns []string
+	for _, svc := range m.services[*input.Cluster] {
+		arns = append(arns, *svc.ServiceArn)
+	}
+	return &ecs.ListServicesOutput{ServiceArns: arns}, nil
+}
+
+func (m *mockECSClient) DescribeServices(_ context.Context, input *ecs.DescribeServicesInput, _ ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error) {
+	return &ecs.DescribeServicesOutput{Services: m.services[*input.Cluster]}, nil
+}
+
+func (m *mockECSClient) DescribeTaskDefinition(_ context.Context, input *ecs.DescribeTaskDefinitionInput, _ ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error) {
+	td := m.taskDefs[*input.TaskDefinition]
+	return &ecs.DescribeTaskDefinitionOutput{TaskDefinition: &td}, nil
+}
+
+func ecsCluster(name string, runningTasks int32) ecstypes.Cluster {
+	return ecstypes.Cluster{
+		ClusterArn:        awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/" + name),
+		ClusterName:       awssdk.String(name),
+		Status:            awssdk.String("ACTIVE"),
+		RunningTasksCount: runningTasks,
+	}
+}
+
+func ecsService(name string, launchType ecstypes.LaunchType, desired int32) ecstypes.Service {
+	return ecstypes.Service{
+		ServiceArn:     awssdk.String("arn:aws:ecs:us-east-1:123456789012:service/app/" + name),
+		ServiceName:    awssdk.String(name),
+		Status:         awssdk.String("ACTIVE"),
+		LaunchType:     launchType,
+		DesiredCount:   desired,
+		RunningCount:   desired,
+		TaskDefinition: awssdk.String("td-" + name),
+	}
+}
+
+// newECSMockMetrics returns the same CPU and memory utilization for every service.
+func newECSMockMetrics(cpu, mem float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				v := cpu
+				if *q.MetricStat.Metric.MetricName == "MemoryUtilization" {
+					v = mem
+				}
+				results = append(results, cwtypes.MetricDataResult{Id: awssdk.String(fmt.Sprintf("m%d", i)), Values: []float64{v}})
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestECSScanner_IdleFargateService(t *testing.T) {
+	cluster := ecsCluster("app", 2)
+	mock := &mockECSClient{
+		clusters: []ecstypes.Cluster{cluster},
+		services: map[string][]ecstypes.Service{
+			*cluster.ClusterArn: {ecsService("worker", ecstypes.LaunchTypeFargate, 2)},
+		},
+		taskDefs: map[string]ecstypes.TaskDefinition{
+			"td-worker": {Cpu: awssdk.String("1024"), Memory: awssdk.String("2048")},
+		},
+	}
+	scanner := NewECSScanner(mock, newECSMockMetrics(0.2, 0.5), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingECSIdleService {
+		t.Fatalf("expected ECS_IDLE_SERVICE, got %s", f.ID)
+	}
+	// 2 tasks * (1 vCPU * $0.04048 + 2 GiB * $0.004445) * 730 = ~$72.08
+	if f.EstimatedMonthlyWaste < 71.5 || f.EstimatedMonthlyWaste > 72.5 {
+		t.Fatalf("expected ~$72.08, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Hygiene {
+		t.Fatal("expected priced Fargate finding not to be hygiene")
+	}
+}
+
+func TestECSScanner_IdleEC2ServiceIsHygiene(t *testing.T) {
+	cluster := ecsCluster("app", 1)
+	mock := &mockECSClient{
+		clusters: []ecstypes.Cluster{cluster},
+		services: map[string][]ecstypes.Service{
+			*cluster.ClusterArn: {ecsService("legacy", ecstypes.LaunchTypeEc2, 1)},
+		},
+	}
+	scanner := NewECSScanner(mock, newECSMockMetrics(0.1, 0.1), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || !result.Findings[0].Hygiene || result.Findings[0].EstimatedMonthlyWaste != 0 {
+		t.Fatalf("expected one $0 hygiene finding, got %+v", result.Findings)
+	}
+}
+
+func TestECSScanner_BusyService(t *testing.T) {
+	cluster := ecsCluster("app", 2)
+	mock := &mockECSClient{
+		clusters: []ecstypes.Cluster{cluster},
+		services: map[string][]ecstypes.Service{
+			*cluster.ClusterArn: {ecsService("api", ecstypes.LaunchTypeFargate, 2)},
+		},
+	}
+	scanner := NewECSScanner(mock, newECSMockMetrics(35, 60), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(result.Findings))
+	}
+}
+
+func TestECSScanner_ZeroTaskCluster(t *testing.T) {
+	mock := &mockECSClient{clusters: []ecstypes.Cluster{ecsCluster("abandoned", 0)}}
+	scanner := NewECSScanner(mock, newECSMockMetrics(0, 0), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ID != FindingECSZeroTaskCluster {
+		t.Fatalf("expected ECS_ZERO_TASK_CLUSTER, got %+v", result.Findings)
+	}
+}
+
+func TestECSScanner_ExcludedServiceARN(t *testing.T) {
+	cluster := ecsCluster("app", 2)
+	svc := ecsService("worker", ecstypes.LaunchTypeFargate, 2)
+	mock := &mockECSClient{
+		clusters: []ecstypes.Cluster{cluster},
+		services: map[string][]ecstypes.Service{*cluster.ClusterArn: {svc}},
+	}
+	scanner := NewECSScanner(mock, newECSMockMetrics(0, 0), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{*svc.ServiceArn: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded service to produce no findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// efsNoLifecycleMinGiB is the Standard-class size below which a missing IA policy is not worth reporting.
+const efsNoLifecycleMinGiB = 10
+
+// EFSAPI is the minimal interface for EFS operations.
+type EFSAPI interface {
+	DescribeFileSystems(ctx context.Context, input *efs.DescribeFileSystemsInput, opts ...func(*efs.Options)) (*efs.DescribeFileSystemsOutput, error)
+	DescribeLifecycleConfiguration(ctx context.Context, input *efs.DescribeLifecycleConfigurationInput, opts ...func(*efs.Options)) (*efs.DescribeLifecycleConfigurationOutput, error)
+}
+
+// EFSScanner detects idle EFS file systems and file systems without an IA lifecycle policy.
+type EFSScanner struct {
+	client  EFSAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewEFSScanner creates a scanner for EFS file systems.
+func NewEFSScanner(client EFSAPI, metrics *MetricsFetcher, region string) *EFSScanner {
+	return &EFSScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *EFSScanner) Type() ResourceType {
+	return ResourceEFS
+}
+
+// Scan examines available EFS file systems for zero IO and missing IA lifecycle policies.
+func (s *EFSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	fileSystems, err := s.listFileSystems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list EFS file systems: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(fileSystems)}
+	if len(fileSystems) == 0 {
+		return result, nil
+	}
+
+	var ids []string
+	fsMap := make(map[string]efstypes.FileSystemDescription, len(fileSystems))
+	for _, fs := range fileSystems {
+		id := deref(fs.FileSystemId)
+		if cfg.Exclude.ShouldExclude(id, efsTagsToMap(fs.Tags)) {
+			continue
+		}
+		if fs.LifeCycleState != efstypes.LifeCycleStateAvailable {
+			continue
+		}
+		ids = append(ids, id)
+		fsMap[id] = fs
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	ioMap, err := s.metrics.FetchSum(ctx, "AWS/EFS", "TotalIOBytes", "FileSystemId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EFS IO metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	connMap, err := s.metrics.FetchSum(ctx, "AWS/EFS", "ClientConnections", "FileSystemId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EFS connection metrics", "region", s.region, "error", err)
+		connMap = make(map[string]float64)
+	}
+
+	for _, id := range ids {
+		fs := fsMap[id]
+		var standardBytes, iaBytes, archiveBytes int64
+		if fs.SizeInBytes != nil {
+			standardBytes = derefInt64(fs.SizeInBytes.ValueInStandard)
+			iaBytes = derefInt64(fs.SizeInBytes.ValueInIA)
+			archiveBytes = derefInt64(fs.SizeInBytes.ValueInArchive)
+		}
+		standardGiB := int(standardBytes / bytesPerGiB)
+
+		meta := map[string]any{
+			"performance_mode":         string(fs.PerformanceMode),
+			"throughput_mode":          string(fs.ThroughputMode),
+			"size_standard_bytes":      standardBytes,
+			"size_ia_bytes":            iaBytes,
+			"size_archive_bytes":       archiveBytes,
+			"mount_targets":            fs.NumberOfMountTargets,
+			"total_io_bytes":           ioMap[id],
+			"total_client_connections": connMap[id],
+		}
+		setTagsMetadata(meta, efsTagsToMap(fs.Tags))
+		if fs.ProvisionedThroughputInMibps != nil {
+			meta["provisioned_throughput_mibps"] = *fs.ProvisionedThroughputInMibps
+		}
+
+		// EFS_IDLE: zero IO over the idle window
+		if ioMap[id] == 0 {
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingEFSIdle,
+				Severity:              SeverityHigh,
+				ResourceType:          ResourceEFS,
+				ResourceID:            id,
+				ResourceName:          deref(fs.Name),
+				Region:                s.region,
+				Message:               fmt.Sprintf("Zero IO over %d days (%d GiB in Standard)", cfg.IdleDays, standardGiB),
+				EstimatedMonthlyWaste: pricing.MonthlyEFSStandardCost(standardGiB, s.region),
+				Metadata:              meta,
+			})
+			continue
+		}
+
+		// EFS_NO_LIFECYCLE: significant Standard storage with no transition to IA
+		if standardGiB < efsNoLifecycleMinGiB {
+			continue
+		}
+		hasIA, err := s.hasIAPolicy(ctx, id)
+		if err != nil {
+			slog.Warn("Failed to describe EFS lifecycle configuration", "file_system", id, "error", err)
+			continue
+		}
+		if hasIA {
+			continue
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingEFSNoLifecycle,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceEFS,
+			ResourceID:            id,
+			ResourceName:          deref(fs.Name),
+			Region:                s.region,
+			Message:               fmt.Sprintf("%d GiB in Standard with no lifecycle policy to Infrequent Access", standardGiB),
+			EstimatedMonthlyWaste: pricing.MonthlyEFSStandardCost(standardGiB, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// hasIAPolicy reports whether the file system transitions files to Infrequent Access.
+func (s *EFSScanner) hasIAPolicy(ctx context.Context, id string) (bool, error) {
+	out, err := s.client.DescribeLifecycleConfiguration(ctx, &efs.DescribeLifecycleConfigurationInput{
+		FileSystemId: &id,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range out.LifecyclePolicies {
+		if p.TransitionToIA != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *EFSScanner) listFileSystems(ctx context.Context) ([]efstypes.FileSystemDescription, error) {
+	var fileSystems []efstypes.FileSystemDescription
+	paginator := efs.NewDescribeFileSystemsPaginator(s.client, &efs.DescribeFileSystemsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		fileSystems = append(fileSystems, page.FileSystems...)
+	}
+	return fileSystems, nil
+}
+
+func efsTagsToMap(tags []efstypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m
+}
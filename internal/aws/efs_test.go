@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+type mockEFSClient struct {
+	fileSystems []efstypes.FileSystemDescription
+	policies    map[string][]efstypes.LifecyclePolicy
+}
+
+func (m *mockEFSClient) DescribeFileSystems(_ context.Context, _ *efs.DescribeFileSystemsInput, _ ...func(*efs.Options)) (*efs.DescribeFileSystemsOutput, error) {
+	return &efs.DescribeFileSystemsOutput{FileSystems: m.fileSystems}, nil
+}
+
+func (m *mockEFSClient) DescribeLifecycleConfiguration(_ context.Context, input *efs.DescribeLifecycleConfigurationInput, _ ...func(*efs.Options)) (*efs.DescribeLifecycleConfigurationOutput, error) {
+	return &efs.DescribeLifecycleConfigurationOutput{LifecyclePolicies: m.policies[*input.FileSystemId]}, nil
+}
+
+func efsFileSystem(id string, standardGiB int64) efstypes.FileSystemDescription {
+	return efstypes.FileSystemDescription{
+		FileSystemId:    awssdk.String(id),
+		LifeCycleState:  efstypes.LifeCycleStateAvailable,
+		PerformanceMode: efstypes.PerformanceModeGeneralPurpose,
+		ThroughputMode:  efstypes.ThroughputModeBursting,
+		SizeInBytes: &efstypes.FileSystemSize{
+			Value:           standardGiB * bytesPerGiB,
+			ValueInStandard: awssdk.Int64(standardGiB * bytesPerGiB),
+		},
+	}
+}
+
+func TestEFSScanner_IdleFileSystem(t *testing.T) {
+	mock := &mockEFSClient{fileSystems: []efstypes.FileSystemDescription{efsFileSystem("fs-idle001", 100)}}
+	scanner := NewEFSScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingEFSIdle {
+		t.Fatalf("expected EFS_IDLE, got %s", f.ID)
+	}
+	// 100 GiB * $0.30 = $30.00
+	if f.EstimatedMonthlyWaste < 29.9 || f.EstimatedMonthlyWaste > 30.1 {
+		t.Fatalf("expected ~$30.00, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["performance_mode"] != "generalPurpose" || f.Metadata["throughput_mode"] != "bursting" {
+		t.Fatalf("unexpected mode metadata: %v / %v", f.Metadata["performance_mode"], f.Metadata["throughput_mode"])
+	}
+}
+
+func TestEFSScanner_NoLifecycle(t *testing.T) {
+	mock := &mockEFSClient{
+		fileSystems: []efstypes.FileSystemDescription{
+			efsFileSystem("fs-nopolicy", 200),
+			efsFileSystem("fs-withia", 200),
+			efsFileSystem("fs-small", 2),
+		},
+		policies: map[string][]efstypes.LifecyclePolicy{
+			"fs-withia": {{TransitionToIA: efstypes.TransitionToIARulesAfter30Days}},
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"fs-nopolicy": 4096, "fs-withia": 4096, "fs-small": 4096})
+	scanner := NewEFSScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingEFSNoLifecycle || f.ResourceID != "fs-nopolicy" {
+		t.Fatalf("expected EFS_NO_LIFECYCLE for fs-nopolicy, got %s for %s", f.ID, f.ResourceID)
+	}
+}
+
+func TestEFSScanner_SkipsDeletingFileSystem(t *testing.T) {
+	fs := efsFileSystem("fs-deleting", 100)
+	fs.LifeCycleState = efstypes.LifeCycleStateDeleting
+	mock := &mockEFSClient{fileSystems: []efstypes.FileSystemDescription{fs}}
+	scanner := NewEFSScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(result.Findings))
+	}
+}
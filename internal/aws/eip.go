@@ -51,6 +51,11 @@ func (s *EIPScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 
 		cost := pricing.MonthlyEIPCost(s.region)
 		publicIP := deref(addr.PublicIp)
+		meta := map[string]any{
+			"public_ip": publicIP,
+			"domain":    string(addr.Domain),
+		}
+		setTagsMetadata(meta, ec2TagsToMap(addr.Tags))
 
 		result.Findings = append(result.Findings, Finding{
 			ID:                    FindingUnusedEIP,
@@ -60,10 +65,7 @@ func (s *EIPScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 			Region:                s.region,
 			Message:               fmt.Sprintf("Elastic IP %s not associated with any instance", publicIP),
 			EstimatedMonthlyWaste: cost,
-			Metadata: map[string]any{
-				"public_ip": publicIP,
-				"domain":    string(addr.Domain),
-			},
+			Metadata:              meta,
 		})
 	}
 
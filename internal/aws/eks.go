@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// eksClusterTagPrefix is the tag key prefix that self-managed and Karpenter nodes carry.
+const eksClusterTagPrefix = "kubernetes.io/cluster/"
+
+// eksClusterNameTags are tags whose value names the cluster on managed node group and Auto Mode nodes.
+var eksClusterNameTags = []string{"eks:cluster-name", "eks:eks-cluster-name"}
+
+// EKSAPI is the minimal interface for EKS operations.
+type EKSAPI interface {
+	ListClusters(ctx context.Context, input *eks.ListClustersInput, opts ...func(*eks.Options)) (*eks.ListClustersOutput, error)
+	DescribeCluster(ctx context.Context, input *eks.DescribeClusterInput, opts ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+	ListFargateProfiles(ctx context.Context, input *eks.ListFargateProfilesInput, opts ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
+}
+
+// EKSNodeAPI is the minimal EC2 interface for counting cluster worker nodes.
+type EKSNodeAPI interface {
+	DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, opts ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+}
+
+// EKSScanner detects EKS clusters paying for a control plane with no worker nodes.
+type EKSScanner struct {
+	client    EKSAPI
+	ec2Client EKSNodeAPI
+	region    string
+}
+
+// NewEKSScanner creates a scanner for EKS clusters.
+func NewEKSScanner(client EKSAPI, ec2Client EKSNodeAPI, region string) *EKSScanner {
+	return &EKSScanner{client: client, ec2Client: ec2Client, region: region}
+}
+
+// Type returns the resource type.
+func (s *EKSScanner) Type() ResourceType {
+	return ResourceEKS
+}
+
+// Scan examines active EKS clusters for zero running worker nodes. Node history is not
+// available, so the cluster must be at least StaleDays old to be reported.
+func (s *EKSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	names, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list EKS clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(names)}
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	nodeCounts, err := s.countNodes(ctx)
+	if err != nil {
+		slog.Warn("Failed to list EKS worker nodes", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	now := time.Now().UTC()
+	for _, name := range names {
+		if cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+
+		out, err := s.client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: awssdk.String(name)})
+		if err != nil {
+			slog.Warn("Failed to describe EKS cluster", "cluster", name, "error", err)
+			continue
+		}
+		cluster := out.Cluster
+		if cluster == nil || cluster.Status != ekstypes.ClusterStatusActive {
+			continue
+		}
+		if cfg.Exclude.ShouldExclude(name, cluster.Tags) {
+			continue
+		}
+		if nodeCounts[name] > 0 {
+			continue
+		}
+		if cluster.CreatedAt == nil {
+			continue
+		}
+		ageDays := int(now.Sub(*cluster.CreatedAt).Hours() / 24)
+		if ageDays < cfg.StaleDays {
+			continue
+		}
+
+		// Fargate pods run without EC2 nodes, so a cluster with profiles is not empty.
+		hasFargate, err := s.hasFargateProfiles(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to list EKS Fargate profiles", "cluster", name, "error", err)
+			continue
+		}
+		if hasFargate {
+			continue
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingEKSEmptyCluster,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceEKS,
+			ResourceID:            name,
+			ResourceName:          deref(cluster.Name),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Active cluster with zero worker nodes, created %d days ago", ageDays),
+			EstimatedMonthlyWaste: pricing.MonthlyEKSControlPlaneCost(s.region),
+			Metadata: withTags(map[string]any{
+				"cluster_arn":        deref(cluster.Arn),
+				"kubernetes_version": deref(cluster.Version),
+				"node_count":         0,
+				"age_days":           ageDays,
+			}, cluster.Tags),
+		})
+	}
+
+	return result, nil
+}
+
+// countNodes returns running EC2 instances per EKS cluster name, read from node tags.
+func (s *EKSScanner) countNodes(ctx context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2Client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("instance-state-name"), Values: []string{"pending", "running"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page.Reservations {
+			for _, inst := range r.Instances {
+				if name := eksNodeCluster(inst.Tags); name != "" {
+					counts[name]++
+				}
+			}
+		}
+	}
+	return counts, nil
+}
+
+// eksNodeCluster returns the cluster an instance belongs to, or "" if it is not an EKS node.
+func eksNodeCluster(tags []ec2types.Tag) string {
+	for _, tag := range tags {
+		key := deref(tag.Key)
+		for _, k := range eksClusterNameTags {
+			if key == k {
+				return deref(tag.Value)
+			}
+		}
+		if strings.HasPrefix(key, eksClusterTagPrefix) {
+			return strings.TrimPrefix(key, eksClusterTagPrefix)
+		}
+	}
+	return ""
+}
+
+func (s *EKSScanner) hasFargateProfiles(ctx context.Context, cluster string) (bool, error) {
+	out, err := s.client.ListFargateProfiles(ctx, &eks.ListFargateProfilesInput{
+		ClusterName: awssdk.String(cluster),
+		MaxResults:  awssdk.Int32(1),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(out.FargateProfileNames) > 0, nil
+}
+
+func (s *EKSScanner) listClusters(ctx context.Context) ([]string, error) {
+	var names []string
+	paginator := eks.NewListClustersPaginator(s.client, &eks.ListClustersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, page.Clusters...)
+	}
+	return names, nil
+}
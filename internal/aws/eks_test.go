@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+type mockEKSClient struct {
+	clusters map[string]ekstypes.Cluster
+	fargate  map[string][]string
+}
+
+func (m *mockEKSClient) ListClusters(_ context.Context, _ *eks.ListClustersInput, _ ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
+	var names []string
+	for name := range m.clusters {
+		names = append(names, name)
+	}
+	return &eks.ListClustersOutput{Clusters: names}, nil
+}
+
+func (m *mockEKSClient) DescribeCluster(_ context.Context, input *eks.DescribeClusterInput, _ ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	c := m.clusters[*input.Name]
+	return &eks.DescribeClusterOutput{Cluster: &c}, nil
+}
+
+func (m *mockEKSClient) ListFargateProfiles(_ context.Context, input *eks.ListFargateProfilesInput, _ ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error) {
+	return &eks.ListFargateProfilesOutput{FargateProfileNames: m.fargate[*input.ClusterName]}, nil
+}
+
+type mockEKSNodeClient struct {
+	instances []ec2types.Instance
+}
+
+func (m *mockEKSNodeClient) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{{Instances: m.instances}}}, nil
+}
+
+func eksCluster(name string, ageDays int) ekstypes.Cluster {
+	return ekstypes.Cluster{
+		Name:      awssdk.String(name),
+		Arn:       awssdk.String("arn:aws:eks:us-east-1:123456789012:cluster/" + name),
+		Status:    ekstypes.ClusterStatusActive,
+		Version:   awssdk.String("1.31"),
+		CreatedAt: awssdk.Time(time.Now().Add(-time.Duration(ageDays) * 24 * time.Hour)),
+	}
+}
+
+func eksNode(tagKey, tagValue string) ec2types.Instance {
+	return ec2types.Instance{
+		InstanceId: awssdk.String("i-node"),
+		Tags:       []ec2types.Tag{{Key: awssdk.String(tagKey), Value: awssdk.String(tagValue)}},
+	}
+}
+
+func TestEKSScanner_EmptyCluster(t *testing.T) {
+	mock := &mockEKSClient{clusters: map[string]ekstypes.Cluster{
+		"empty":   eksCluster("empty", 120),
+		"managed": eksCluster("managed", 120),
+		"karp":    eksCluster("karp", 120),
+	}}
+	nodes := &mockEKSNodeClient{instances: []ec2types.Instance{
+		eksNode("eks:cluster-name", "managed"),
+		eksNode("kubernetes.io/cluster/karp", "owned"),
+	}}
+	scanner := NewEKSScanner(mock, nodes, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingEKSEmptyCluster || f.ResourceID != "empty" {
+		t.Fatalf("expected EKS_EMPTY_CLUSTER for empty, got %s for %s", f.ID, f.ResourceID)
+	}
+	if f.EstimatedMonthlyWaste < 72 || f.EstimatedMonthlyWaste > 74 {
+		t.Fatalf("expected ~$73 control-plane cost, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.ResourceName != "empty" {
+		t.Fatalf("expected cluster name as resource name, got %s", f.ResourceName)
+	}
+	if f.Metadata["cluster_arn"] != "arn:aws:eks:us-east-1:123456789012:cluster/empty" {
+		t.Fatalf("expected cluster ARN in metadata, got %v", f.Metadata["cluster_arn"])
+	}
+	if f.Metadata["kubernetes_version"] != "1.31" || f.Metadata["node_count"] != 0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestEKSScanner_SkipsYoungAndFargateClusters(t *testing.T) {
+	mock := &mockEKSClient{
+		clusters: map[string]ekstypes.Cluster{
+			"new":     eksCluster("new", 5),
+			"fargate": eksCluster("fargate", 120),
+		},
+		fargate: map[string][]string{"fargate": {"default"}},
+	}
+	scanner := NewEKSScanner(mock, &mockEKSNodeClient{}, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(result.Findings))
+	}
+}
@@ -15,9 +15,13 @@ type ELBAPI interface {
 	DescribeLoadBalancers(ctx context.Context, input *elasticloadbalancingv2.DescribeLoadBalancersInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error)
 	DescribeTargetGroups(ctx context.Context, input *elasticloadbalancingv2.DescribeTargetGroupsInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetGroupsOutput, error)
 	DescribeTargetHealth(ctx context.Context, input *elasticloadbalancingv2.DescribeTargetHealthInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetHealthOutput, error)
+	DescribeTags(ctx context.Context, input *elasticloadbalancingv2.DescribeTagsInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error)
 }
 
-// ELBScanner detects idle ALBs and NLBs.
+// elbTagsBatchSize is the maximum number of ARNs DescribeTags accepts per call.
+const elbTagsBatchSize = 20
+
+// ELBScanner detects idle ALBs, NLBs, and Gateway Load Balancers.
 type ELBScanner struct {
 	client  ELBAPI
 	metrics *MetricsFetcher
@@ -34,7 +38,7 @@ func (s *ELBScanner) Type() ResourceType {
 	return ResourceALB
 }
 
-// Scan examines all ALBs and NLBs in the region for idle load balancers.
+// Scan examines all ALBs, NLBs, and GWLBs in the region for idle load balancers.
 func (s *ELBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
 	lbs, err := s.listLoadBalancers(ctx)
 	if err != nil {
@@ -46,11 +50,17 @@ func (s *ELBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 		return result, nil
 	}
 
+	tagsByARN, err := s.loadBalancerTags(ctx, lbs)
+	if err != nil {
+		slog.Debug("Failed to describe load balancer tags", "error", err)
+	}
+
 	for _, lb := range lbs {
 		lbARN := deref(lb.LoadBalancerArn)
 		lbName := deref(lb.LoadBalancerName)
+		tags := tagsByARN[lbARN]
 
-		if cfg.Exclude.ShouldExclude(lbARN, nil) {
+		if cfg.Exclude.ShouldExclude(lbARN, tags) {
 			continue
 		}
 
@@ -76,6 +86,35 @@ func (s *ELBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 		// LB is idle: zero healthy targets or zero requests
 		findingID, resourceType, cost := s.classifyLB(lb)
 		msg := fmt.Sprintf("Load balancer %q has no healthy targets or zero requests over %d days", lbName, cfg.IdleDays)
+		meta := map[string]any{
+			"lb_type": string(lb.Type),
+			"scheme":  string(lb.Scheme),
+			"vpc_id":  deref(lb.VpcId),
+		}
+		setTagsMetadata(meta, tags)
+
+		// EIPs mapped to NLB subnets are associated with ELB-managed ENIs, so the
+		// EIP scanner (unassociated addresses only) never reports them; count them here.
+		if allocs := nlbAllocatedEIPs(lb); len(allocs) > 0 {
+			eipCost := float64(len(allocs)) * pricing.MonthlyEIPCost(s.region)
+			cost += eipCost
+			meta["allocated_eips"] = allocs
+			meta["eip_monthly_cost"] = eipCost
+			msg = fmt.Sprintf("%s (%d allocated EIPs)", msg, len(allocs))
+		}
+
+		// An idle LB can still accrue LCU charges from health checks, TLS handshakes,
+		// or stray connections; price the observed average on top of the base rate.
+		avgLCU, err := s.avgConsumedLCUs(ctx, lb, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch consumed LCUs", "lb", lbName, "error", err)
+		}
+		meta["avg_consumed_lcu"] = avgLCU
+		if avgLCU > 0 {
+			lcuCost := pricing.MonthlyLCUCost(string(lb.Type), avgLCU, s.region)
+			cost += lcuCost
+			meta["lcu_monthly_cost"] = lcuCost
+		}
 
 		result.Findings = append(result.Findings, Finding{
 			ID:                    findingID,
@@ -86,11 +125,7 @@ func (s *ELBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 			Region:                s.region,
 			Message:               msg,
 			EstimatedMonthlyWaste: cost,
-			Metadata: map[string]any{
-				"lb_type": string(lb.Type),
-				"scheme":  string(lb.Scheme),
-				"vpc_id":  deref(lb.VpcId),
-			},
+			Metadata:              meta,
 		})
 	}
 
@@ -117,6 +152,38 @@ func (s *ELBScanner) listLoadBalancers(ctx context.Context) ([]elbtypes.LoadBala
 	return lbs, nil
 }
 
+// loadBalancerTags returns each load balancer's tags keyed by ARN. A load balancer
+// whose tags could not be fetched has no entry, which skips tag-based exclusion.
+func (s *ELBScanner) loadBalancerTags(ctx context.Context, lbs []elbtypes.LoadBalancer) (map[string]map[string]string, error) {
+	arns := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		if lb.LoadBalancerArn != nil {
+			arns = append(arns, *lb.LoadBalancerArn)
+		}
+	}
+
+	tags := make(map[string]map[string]string, len(arns))
+	for start := 0; start < len(arns); start += elbTagsBatchSize {
+		end := min(start+elbTagsBatchSize, len(arns))
+		out, err := s.client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: arns[start:end],
+		})
+		if err != nil {
+			return tags, err
+		}
+		for _, desc := range out.TagDescriptions {
+			m := make(map[string]string, len(desc.Tags))
+			for _, t := range desc.Tags {
+				if t.Key != nil {
+					m[*t.Key] = deref(t.Value)
+				}
+			}
+			tags[deref(desc.ResourceArn)] = m
+		}
+	}
+	return tags, nil
+}
+
 func (s *ELBScanner) hasHealthyTargets(ctx context.Context, lbARN string) (bool, error) {
 	tgOut, err := s.client.DescribeTargetGroups(ctx, &elasticloadbalancingv2.DescribeTargetGroupsInput{
 		LoadBalancerArn: &lbARN,
@@ -144,6 +211,37 @@ func (s *ELBScanner) hasHealthyTargets(ctx context.Context, lbARN string) (bool,
 	return false, nil
 }
 
+// avgConsumedLCUs returns the average hourly ConsumedLCUs of a load balancer over
+// the lookback window. Missing data is reported as zero.
+func (s *ELBScanner) avgConsumedLCUs(ctx context.Context, lb elbtypes.LoadBalancer, days int) (float64, error) {
+	switch lb.Type {
+	case elbtypes.LoadBalancerTypeEnumApplication, elbtypes.LoadBalancerTypeEnumNetwork, elbtypes.LoadBalancerTypeEnumGateway:
+	default:
+		return 0, nil
+	}
+	lbDimValue := extractLBDimension(deref(lb.LoadBalancerArn))
+	if lbDimValue == "" {
+		return 0, nil
+	}
+	avgs, err := s.metrics.FetchAverage(ctx, lbNamespace(lb.Type), "ConsumedLCUs", "LoadBalancer", []string{lbDimValue}, days)
+	if err != nil {
+		return 0, err
+	}
+	return avgs[lbDimValue], nil
+}
+
+// lbNamespace returns the CloudWatch namespace for a load balancer type.
+func lbNamespace(lbType elbtypes.LoadBalancerTypeEnum) string {
+	switch lbType {
+	case elbtypes.LoadBalancerTypeEnumNetwork:
+		return "AWS/NetworkELB"
+	case elbtypes.LoadBalancerTypeEnumGateway:
+		return "AWS/GatewayELB"
+	default:
+		return "AWS/ApplicationELB"
+	}
+}
+
 func (s *ELBScanner) isIdleByRequests(ctx context.Context, lb elbtypes.LoadBalancer, idleDays int) (bool, error) {
 	// Extract the LB suffix from the ARN for CloudWatch dimension
 	// ARN format: arn:aws:elasticloadbalancing:region:account:loadbalancer/app/name/id
@@ -156,25 +254,19 @@ func (s *ELBScanner) isIdleByRequests(ctx context.Context, lb elbtypes.LoadBalan
 	switch lb.Type {
 	case elbtypes.LoadBalancerTypeEnumApplication:
 		metricName = "RequestCount"
-	case elbtypes.LoadBalancerTypeEnumNetwork:
+	case elbtypes.LoadBalancerTypeEnumNetwork, elbtypes.LoadBalancerTypeEnumGateway:
 		metricName = "ActiveFlowCount"
 	default:
 		return false, nil
 	}
 
-	namespace := "AWS/ApplicationELB"
-	dimensionName := "LoadBalancer"
-	if lb.Type == elbtypes.LoadBalancerTypeEnumNetwork {
-		namespace = "AWS/NetworkELB"
-	}
-
 	// Extract the LB dimension value from the ARN
 	lbDimValue := extractLBDimension(lbARN)
 	if lbDimValue == "" {
 		return false, nil
 	}
 
-	sums, err := s.metrics.FetchSum(ctx, namespace, metricName, dimensionName, []string{lbDimValue}, idleDays)
+	sums, err := s.metrics.FetchSum(ctx, lbNamespace(lb.Type), metricName, "LoadBalancer", []string{lbDimValue}, idleDays)
 	if err != nil {
 		return false, err
 	}
@@ -191,11 +283,29 @@ func (s *ELBScanner) classifyLB(lb elbtypes.LoadBalancer) (FindingID, ResourceTy
 	switch lb.Type {
 	case elbtypes.LoadBalancerTypeEnumNetwork:
 		return FindingIdleNLB, ResourceNLB, pricing.MonthlyNLBCost(s.region)
+	case elbtypes.LoadBalancerTypeEnumGateway:
+		return FindingIdleGWLB, ResourceGWLB, pricing.MonthlyGWLBCost(s.region)
 	default:
 		return FindingIdleALB, ResourceALB, pricing.MonthlyALBCost(s.region)
 	}
 }
 
+// nlbAllocatedEIPs returns the Elastic IP allocation IDs mapped to an NLB's subnets.
+func nlbAllocatedEIPs(lb elbtypes.LoadBalancer) []string {
+	if lb.Type != elbtypes.LoadBalancerTypeEnumNetwork {
+		return nil
+	}
+	var allocs []string
+	for _, az := range lb.AvailabilityZones {
+		for _, addr := range az.LoadBalancerAddresses {
+			if id := deref(addr.AllocationId); id != "" {
+				allocs = append(allocs, id)
+			}
+		}
+	}
+	return allocs
+}
+
 // extractLBDimension extracts the CloudWatch dimension value from an ELBv2 ARN.
 // Input:  arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/app/my-lb/abc123
 // Output: app/my-lb/abc123
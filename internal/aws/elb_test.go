@@ -9,12 +9,14 @@ import (
 	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 type mockELBClient struct {
 	lbs           []elbtypes.LoadBalancer
 	targetGroups  []elbtypes.TargetGroup
 	targetHealths []elbtypes.TargetHealthDescription
+	tags          map[string][]elbtypes.Tag
 }
 
 func (m *mockELBClient) DescribeLoadBalancers(_ context.Context, _ *elasticloadbalancingv2.DescribeLoadBalancersInput, _ ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error) {
@@ -35,6 +37,14 @@ func (m *mockELBClient) DescribeTargetHealth(_ context.Context, _ *elasticloadba
 	}, nil
 }
 
+func (m *mockELBClient) DescribeTags(_ context.Context, input *elasticloadbalancingv2.DescribeTagsInput, _ ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error) {
+	var descs []elbtypes.TagDescription
+	for _, arn := range input.ResourceArns {
+		descs = append(descs, elbtypes.TagDescription{ResourceArn: awssdk.String(arn), Tags: m.tags[arn]})
+	}
+	return &elasticloadbalancingv2.DescribeTagsOutput{TagDescriptions: descs}, nil
+}
+
 func TestELBScanner_IdleALB_NoHealthyTargets(t *testing.T) {
 	mock := &mockELBClient{
 		lbs: []elbtypes.LoadBalancer{
@@ -124,6 +134,64 @@ func TestELBScanner_IdleNLB_NoHealthyTargets(t *testing.T) {
 	}
 }
 
+func TestELBScanner_IdleGWLB(t *testing.T) {
+	arn := "arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/gwy/inspection-gwlb/abc123"
+	mock := &mockELBClient{
+		lbs: []elbtypes.LoadBalancer{
+			{
+				LoadBalancerArn:  awssdk.String(arn),
+				LoadBalancerName: awssdk.String("inspection-gwlb"),
+				Type:             elbtypes.LoadBalancerTypeEnumGateway,
+				VpcId:            awssdk.String("vpc-123"),
+			},
+		},
+		targetGroups: []elbtypes.TargetGroup{
+			{TargetGroupArn: awssdk.String("arn:tg/appliances/123")},
+		},
+		targetHealths: []elbtypes.TargetHealthDescription{
+			{TargetHealth: &elbtypes.TargetHealth{State: elbtypes.TargetHealthStateEnumHealthy}},
+		},
+	}
+
+	var namespaces []string
+	metrics := NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			for _, q := range input.MetricDataQueries {
+				namespaces = append(namespaces, *q.MetricStat.Metric.Namespace)
+			}
+			return &cloudwatch.GetMetricDataOutput{}, nil
+		},
+	})
+	scanner := NewELBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingIdleGWLB {
+		t.Fatalf("expected IDLE_GWLB, got %s", f.ID)
+	}
+	if f.ResourceType != ResourceGWLB {
+		t.Fatalf("expected ResourceGWLB, got %s", f.ResourceType)
+	}
+	if f.EstimatedMonthlyWaste != pricing.MonthlyGWLBCost("us-east-1") {
+		t.Fatalf("expected GWLB base cost, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	for _, ns := range namespaces {
+		if ns != "AWS/GatewayELB" {
+			t.Fatalf("expected AWS/GatewayELB metrics, got %s", ns)
+		}
+	}
+	if len(namespaces) == 0 {
+		t.Fatal("expected the idle check to query CloudWatch")
+	}
+}
+
 func TestELBScanner_HealthyALB_NotFlagged(t *testing.T) {
 	mock := &mockELBClient{
 		lbs: []elbtypes.LoadBalancer{
@@ -212,6 +280,36 @@ func TestELBScanner_ExcludedLB(t *testing.T) {
 	}
 }
 
+func TestELBScanner_ExcludedByTag(t *testing.T) {
+	arn := "arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/app/tagged/abc123"
+	mock := &mockELBClient{
+		lbs: []elbtypes.LoadBalancer{
+			{
+				LoadBalancerArn:  awssdk.String(arn),
+				LoadBalancerName: awssdk.String("tagged"),
+				Type:             elbtypes.LoadBalancerTypeEnumApplication,
+			},
+		},
+		tags: map[string][]elbtypes.Tag{
+			arn: {{Key: awssdk.String("awsspectre:ignore"), Value: awssdk.String("true")}},
+		},
+	}
+
+	scanner := NewELBScanner(mock, newMockMetricsFetcher(nil), "us-east-1")
+
+	cfg := ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"awsspectre:ignore": ""}},
+	}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected tag-excluded LB to produce no findings, got %d", len(result.Findings))
+	}
+}
+
 func TestELBScanner_Type(t *testing.T) {
 	scanner := &ELBScanner{}
 	if scanner.Type() != ResourceALB {
@@ -235,6 +333,11 @@ func TestExtractLBDimension(t *testing.T) {
 			"arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/net/my-nlb/def456",
 			"net/my-nlb/def456",
 		},
+		{
+			"GWLB",
+			"arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/gwy/my-gwlb/ghi789",
+			"gwy/my-gwlb/ghi789",
+		},
 		{
 			"empty ARN",
 			"",
@@ -256,3 +359,81 @@ func TestExtractLBDimension(t *testing.T) {
 		})
 	}
 }
+
+func TestELBScanner_IdleNLB_IncludesAllocatedEIPs(t *testing.T) {
+	mock := &mockELBClient{
+		lbs: []elbtypes.LoadBalancer{
+			{
+				LoadBalancerArn:  awssdk.String("arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/net/static-nlb/abc123"),
+				LoadBalancerName: awssdk.String("static-nlb"),
+				Type:             elbtypes.LoadBalancerTypeEnumNetwork,
+				Scheme:           elbtypes.LoadBalancerSchemeEnumInternetFacing,
+				VpcId:            awssdk.String("vpc-123"),
+				AvailabilityZones: []elbtypes.AvailabilityZone{
+					{
+						ZoneName:              awssdk.String("us-east-1a"),
+						LoadBalancerAddresses: []elbtypes.LoadBalancerAddress{{AllocationId: awssdk.String("eipalloc-a"), IpAddress: awssdk.String("203.0.113.10")}},
+					},
+					{
+						ZoneName:              awssdk.String("us-east-1b"),
+						LoadBalancerAddresses: []elbtypes.LoadBalancerAddress{{AllocationId: awssdk.String("eipalloc-b"), IpAddress: awssdk.String("203.0.113.11")}},
+					},
+				},
+			},
+		},
+	}
+
+	metrics := newMockMetricsFetcher(nil)
+	scanner := NewELBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	want := pricing.MonthlyNLBCost("us-east-1") + 2*pricing.MonthlyEIPCost("us-east-1")
+	if f.EstimatedMonthlyWaste != want {
+		t.Fatalf("expected NLB plus two EIPs ($%.2f), got $%.2f", want, f.EstimatedMonthlyWaste)
+	}
+	allocs, ok := f.Metadata["allocated_eips"].([]string)
+	if !ok || len(allocs) != 2 || allocs[0] != "eipalloc-a" || allocs[1] != "eipalloc-b" {
+		t.Fatalf("expected allocated_eips [eipalloc-a eipalloc-b], got %v", f.Metadata["allocated_eips"])
+	}
+}
+
+func TestELBScanner_IdleALB_IncludesConsumedLCUs(t *testing.T) {
+	mock := &mockELBClient{
+		lbs: []elbtypes.LoadBalancer{
+			{
+				LoadBalancerArn:  awssdk.String("arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/app/lcu-alb/abc123"),
+				LoadBalancerName: awssdk.String("lcu-alb"),
+				Type:             elbtypes.LoadBalancerTypeEnumApplication,
+				VpcId:            awssdk.String("vpc-123"),
+			},
+		},
+	}
+
+	metrics := newMetricsByName(map[string]float64{"ConsumedLCUs/app/lcu-alb/abc123": 1.5})
+	scanner := NewELBScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.Metadata["avg_consumed_lcu"] != 1.5 {
+		t.Fatalf("expected avg_consumed_lcu 1.5, got %v", f.Metadata["avg_consumed_lcu"])
+	}
+	want := pricing.MonthlyALBCost("us-east-1") + pricing.MonthlyLCUCost("application", 1.5, "us-east-1")
+	if f.EstimatedMonthlyWaste != want {
+		t.Fatalf("expected base plus LCU cost ($%.2f), got $%.2f", want, f.EstimatedMonthlyWaste)
+	}
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	emrtypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// emrIdleThreshold is the minimum average of IsIdle treated as idle for the whole window.
+// IsIdle is 0 or 1 per five-minute sample, so anything below 1 means some work ran.
+const emrIdleThreshold = 0.999
+
+// EMRAPI is the minimal interface for EMR operations.
+type EMRAPI interface {
+	ListClusters(ctx context.Context, input *emr.ListClustersInput, opts ...func(*emr.Options)) (*emr.ListClustersOutput, error)
+	ListInstanceGroups(ctx context.Context, input *emr.ListInstanceGroupsInput, opts ...func(*emr.Options)) (*emr.ListInstanceGroupsOutput, error)
+	ListInstanceFleets(ctx context.Context, input *emr.ListInstanceFleetsInput, opts ...func(*emr.Options)) (*emr.ListInstanceFleetsOutput, error)
+}
+
+// EMRScanner detects EMR clusters that stay up with nothing to run.
+type EMRScanner struct {
+	client  EMRAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewEMRScanner creates a scanner for EMR clusters.
+func NewEMRScanner(client EMRAPI, metrics *MetricsFetcher, region string) *EMRScanner {
+	return &EMRScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *EMRScanner) Type() ResourceType {
+	return ResourceEMR
+}
+
+// emrNodes is one role's running capacity within a cluster.
+type emrNodes struct {
+	role         string
+	instanceType string
+	count        int
+}
+
+// Scan examines waiting and running clusters older than the idle window and flags those
+// reporting IsIdle for the entire window with no applications running.
+func (s *EMRScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list EMR clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(clusters)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	var ids []string
+	clusterMap := make(map[string]emrtypes.ClusterSummary, len(clusters))
+	for _, c := range clusters {
+		id := deref(c.Id)
+		if cfg.Exclude.ShouldExclude(id, nil) {
+			continue
+		}
+		// Clusters created within the idle window cannot have been idle for all of it.
+		if created := emrCreationTime(c); created != nil && created.After(cutoff) {
+			continue
+		}
+		ids = append(ids, id)
+		clusterMap[id] = c
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	idleMap, err := s.metrics.FetchAverage(ctx, "AWS/ElasticMapReduce", "IsIdle", "JobFlowId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EMR IsIdle metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	appsMap, err := s.metrics.FetchSum(ctx, "AWS/ElasticMapReduce", "AppsRunning", "JobFlowId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EMR AppsRunning metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, id := range ids {
+		idle, ok := idleMap[id]
+		if !ok || idle < emrIdleThreshold || appsMap[id] > 0 {
+			continue
+		}
+		c := clusterMap[id]
+
+		nodes, err := s.clusterNodes(ctx, id)
+		if err != nil {
+			slog.Warn("Failed to list EMR cluster instances", "cluster", id, "error", err)
+			continue
+		}
+
+		var cost float64
+		counts := map[string]int{"master": 0, "core": 0, "task": 0}
+		for _, n := range nodes {
+			cost += pricing.MonthlyEMRCost(n.instanceType, n.count, s.region)
+			counts[n.role] += n.count
+		}
+
+		meta := map[string]any{
+			"master_instance_count": counts["master"],
+			"core_instance_count":   counts["core"],
+			"task_instance_count":   counts["task"],
+		}
+		if c.Status != nil {
+			meta["state"] = string(c.Status.State)
+		}
+		if created := emrCreationTime(c); created != nil {
+			meta["creation_time"] = created.UTC().Format(time.RFC3339)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingEMRIdleCluster,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceEMR,
+			ResourceID:            id,
+			ResourceName:          deref(c.Name),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Idle for all of the last %d days with no applications running (%d instances)", cfg.IdleDays, counts["master"]+counts["core"]+counts["task"]),
+			EstimatedMonthlyWaste: cost,
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// clusterNodes returns running capacity per role from instance groups, or from instance
+// fleets for clusters that use them. Fleet capacity is converted to instances using the
+// weighted capacity of the fleet's first instance type.
+func (s *EMRScanner) clusterNodes(ctx context.Context, clusterID string) ([]emrNodes, error) {
+	var nodes []emrNodes
+	groups := emr.NewListInstanceGroupsPaginator(s.client, &emr.ListInstanceGroupsInput{ClusterId: &clusterID})
+	for groups.HasMorePages() {
+		page, err := groups.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range page.InstanceGroups {
+			nodes = append(nodes, emrNodes{
+				role:         strings.ToLower(string(g.InstanceGroupType)),
+				instanceType: deref(g.InstanceType),
+				count:        int(derefInt32(g.RunningInstanceCount)),
+			})
+		}
+	}
+	if len(nodes) > 0 {
+		return nodes, nil
+	}
+
+	fleets := emr.NewListInstanceFleetsPaginator(s.client, &emr.ListInstanceFleetsInput{ClusterId: &clusterID})
+	for fleets.HasMorePages() {
+		page, err := fleets.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range page.InstanceFleets {
+			if len(f.InstanceTypeSpecifications) == 0 {
+				continue
+			}
+			spec := f.InstanceTypeSpecifications[0]
+			capacity := int(derefInt32(f.ProvisionedOnDemandCapacity) + derefInt32(f.ProvisionedSpotCapacity))
+			if weight := int(derefInt32(spec.WeightedCapacity)); weight > 1 {
+				capacity /= weight
+			}
+			nodes = append(nodes, emrNodes{
+				role:         strings.ToLower(string(f.InstanceFleetType)),
+				instanceType: deref(spec.InstanceType),
+				count:        capacity,
+			})
+		}
+	}
+	return nodes, nil
+}
+
+func (s *EMRScanner) listClusters(ctx context.Context) ([]emrtypes.ClusterSummary, error) {
+	var clusters []emrtypes.ClusterSummary
+	paginator := emr.NewListClustersPaginator(s.client, &emr.ListClustersInput{
+		ClusterStates: []emrtypes.ClusterState{emrtypes.ClusterStateWaiting, emrtypes.ClusterStateRunning},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.Clusters...)
+	}
+	return clusters, nil
+}
+
+func emrCreationTime(c emrtypes.ClusterSummary) *time.Time {
+	if c.Status == nil || c.Status.Timeline == nil {
+		return nil
+	}
+	return c.Status.Timeline.CreationDateTime
+}
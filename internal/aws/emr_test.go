@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	emrtypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
+)
+
+type mockEMRClient struct {
+	clusters []emrtypes.ClusterSummary
+	groups   map[string][]emrtypes.InstanceGroup
+	fleets   map[string][]emrtypes.InstanceFleet
+	err      error
+}
+
+func (m *mockEMRClient) ListClusters(_ context.Context, _ *emr.ListClustersInput, _ ...func(*emr.Options)) (*emr.ListClustersOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &emr.ListClustersOutput{Clusters: m.clusters}, nil
+}
+
+func (m *mockEMRClient) ListInstanceGroups(_ context.Context, input *emr.ListInstanceGroupsInput, _ ...func(*emr.Options)) (*emr.ListInstanceGroupsOutput, error) {
+	return &emr.ListInstanceGroupsOutput{InstanceGroups: m.groups[*input.ClusterId]}, nil
+}
+
+func (m *mockEMRClient) ListInstanceFleets(_ context.Context, input *emr.ListInstanceFleetsInput, _ ...func(*emr.Options)) (*emr.ListInstanceFleetsOutput, error) {
+	return &emr.ListInstanceFleetsOutput{InstanceFleets: m.fleets[*input.ClusterId]}, nil
+}
+
+func emrCluster(id string, age time.Duration) emrtypes.ClusterSummary {
+	return emrtypes.ClusterSummary{
+		Id:   awssdk.String(id),
+		Name: awssdk.String("etl-" + id),
+		Status: &emrtypes.ClusterStatus{
+			State:    emrtypes.ClusterStateWaiting,
+			Timeline: &emrtypes.ClusterTimeline{CreationDateTime: awssdk.Time(time.Now().Add(-age))},
+		},
+	}
+}
+
+func emrGroup(role emrtypes.InstanceGroupType, instanceType string, count int32) emrtypes.InstanceGroup {
+	return emrtypes.InstanceGroup{
+		InstanceGroupType:    role,
+		InstanceType:         awssdk.String(instanceType),
+		RunningInstanceCount: awssdk.Int32(count),
+	}
+}
+
+// emrMetrics returns IsIdle averages and AppsRunning sums per cluster ID.
+func emrMetrics(isIdle, apps map[string]float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for _, q := range input.MetricDataQueries {
+				values := isIdle
+				if *q.MetricStat.Metric.MetricName == "AppsRunning" {
+					values = apps
+				}
+				if v, ok := values[*q.MetricStat.Metric.Dimensions[0].Value]; ok {
+					results = append(results, cwtypes.MetricDataResult{Id: q.Id, Values: []float64{v}})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestEMRScanner_IdleCluster(t *testing.T) {
+	day := 24 * time.Hour
+	client := &mockEMRClient{
+		clusters: []emrtypes.ClusterSummary{
+			emrCluster("j-idle", 30*day),
+			emrCluster("j-partly", 30*day),
+			emrCluster("j-apps", 30*day),
+			emrCluster("j-new", 2*day),
+		},
+		groups: map[string][]emrtypes.InstanceGroup{
+			"j-idle": {
+				emrGroup(emrtypes.InstanceGroupTypeMaster, "m5.xlarge", 1),
+				emrGroup(emrtypes.InstanceGroupTypeCore, "m5.xlarge", 2),
+				emrGroup(emrtypes.InstanceGroupTypeTask, "m5.xlarge", 0),
+			},
+		},
+	}
+	metrics := emrMetrics(
+		map[string]float64{"j-idle": 1, "j-partly": 0.6, "j-apps": 1, "j-new": 1},
+		map[string]float64{"j-idle": 0, "j-partly": 0, "j-apps": 3},
+	)
+	scanner := NewEMRScanner(client, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingEMRIdleCluster || f.ResourceID != "j-idle" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// m5.xlarge: ($0.192 EC2 + $0.048 EMR) * 3 * 730h = $525.60
+	if f.EstimatedMonthlyWaste < 525.59 || f.EstimatedMonthlyWaste > 525.61 {
+		t.Fatalf("expected ~$525.60, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["master_instance_count"] != 1 || f.Metadata["core_instance_count"] != 2 || f.Metadata["task_instance_count"] != 0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if _, ok := f.Metadata["creation_time"]; !ok {
+		t.Fatal("expected creation_time in metadata")
+	}
+}
+
+func TestEMRScanner_InstanceFleets(t *testing.T) {
+	client := &mockEMRClient{
+		clusters: []emrtypes.ClusterSummary{emrCluster("j-fleet", 30*24*time.Hour)},
+		fleets: map[string][]emrtypes.InstanceFleet{
+			"j-fleet": {
+				{
+					InstanceFleetType:           emrtypes.InstanceFleetTypeMaster,
+					ProvisionedOnDemandCapacity: awssdk.Int32(1),
+					InstanceTypeSpecifications:  []emrtypes.InstanceTypeSpecification{{InstanceType: awssdk.String("m5.xlarge"), WeightedCapacity: awssdk.Int32(1)}},
+				},
+				{
+					InstanceFleetType:          emrtypes.InstanceFleetTypeCore,
+					ProvisionedSpotCapacity:    awssdk.Int32(8),
+					InstanceTypeSpecifications: []emrtypes.InstanceTypeSpecification{{InstanceType: awssdk.String("m5.xlarge"), WeightedCapacity: awssdk.Int32(4)}},
+				},
+			},
+		},
+	}
+	scanner := NewEMRScanner(client, emrMetrics(map[string]float64{"j-fleet": 1}, nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	if got := result.Findings[0].Metadata["core_instance_count"]; got != 2 {
+		t.Fatalf("expected 2 core instances from weighted capacity, got %v", got)
+	}
+}
+
+func TestEMRScanner_Excluded(t *testing.T) {
+	client := &mockEMRClient{clusters: []emrtypes.ClusterSummary{emrCluster("j-1", 30*24*time.Hour)}}
+	scanner := NewEMRScanner(client, emrMetrics(map[string]float64{"j-1": 1}, nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"j-1": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestEMRScanner_APIError(t *testing.T) {
+	scanner := NewEMRScanner(&mockEMRClient{err: errors.New("denied")}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7}); err == nil {
+		t.Fatal("expected error")
+	}
+}
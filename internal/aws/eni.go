@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// ENIAPI is the minimal interface for Elastic Network Interface operations.
+type ENIAPI interface {
+	DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+}
+
+// ENIScanner detects network interfaces left behind by deleted resources.
+type ENIScanner struct {
+	client ENIAPI
+	region string
+}
+
+// NewENIScanner creates a scanner for Elastic Network Interfaces.
+func NewENIScanner(client ENIAPI, region string) *ENIScanner {
+	return &ENIScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *ENIScanner) Type() ResourceType {
+	return ResourceENI
+}
+
+// Scan examines network interfaces in the "available" state, which are not
+// attached to any instance or service.
+func (s *ENIScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	enis, err := s.listAvailableInterfaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("describe network interfaces: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(enis)}
+
+	for _, eni := range enis {
+		eniID := deref(eni.NetworkInterfaceId)
+		if cfg.Exclude.ShouldExclude(eniID, ec2TagsToMap(eni.TagSet)) {
+			continue
+		}
+
+		// The status filter is server-side; re-check in case an attachment raced the listing.
+		if eni.Attachment != nil && eni.Attachment.Status != ec2types.AttachmentStatusDetached {
+			continue
+		}
+
+		description := deref(eni.Description)
+		msg := "Network interface is not attached to anything"
+		if description != "" {
+			msg = fmt.Sprintf("Network interface is not attached to anything (%s)", description)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:           FindingUnusedENI,
+			Severity:     SeverityLow,
+			ResourceType: ResourceENI,
+			ResourceID:   eniID,
+			ResourceName: eniName(eni.TagSet),
+			Region:       s.region,
+			Message:      msg,
+			Hygiene:      true,
+			Metadata: withTags(map[string]any{
+				"interface_type":    string(eni.InterfaceType),
+				"private_ip":        deref(eni.PrivateIpAddress),
+				"vpc_id":            deref(eni.VpcId),
+				"subnet_id":         deref(eni.SubnetId),
+				"description":       description,
+				"requester_managed": awssdk.ToBool(eni.RequesterManaged),
+			}, ec2TagsToMap(eni.TagSet)),
+		})
+	}
+
+	return result, nil
+}
+
+func eniName(tags []ec2types.Tag) string {
+	for _, tag := range tags {
+		if deref(tag.Key) == "Name" {
+			return deref(tag.Value)
+		}
+	}
+	return ""
+}
+
+func (s *ENIScanner) listAvailableInterfaces(ctx context.Context) ([]ec2types.NetworkInterface, error) {
+	var enis []ec2types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(s.client, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("status"), Values: []string{"available"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		enis = append(enis, page.NetworkInterfaces...)
+	}
+	return enis, nil
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockENIClient struct {
+	interfaces []ec2types.NetworkInterface
+	err        error
+}
+
+func (m *mockENIClient) DescribeNetworkInterfaces(_ context.Context, _ *ec2.DescribeNetworkInterfacesInput, _ ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: m.interfaces}, nil
+}
+
+func TestENIScanner_UnattachedInterface(t *testing.T) {
+	mock := &mockENIClient{
+		interfaces: []ec2types.NetworkInterface{
+			{
+				NetworkInterfaceId: awssdk.String("eni-orphan001"),
+				Status:             ec2types.NetworkInterfaceStatusAvailable,
+				InterfaceType:      ec2types.NetworkInterfaceTypeLambda,
+				PrivateIpAddress:   awssdk.String("10.0.1.15"),
+				VpcId:              awssdk.String("vpc-123"),
+				SubnetId:           awssdk.String("subnet-abc"),
+				Description:        awssdk.String("AWS Lambda VPC ENI-deleted-fn"),
+				TagSet:             []ec2types.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("leftover")}},
+			},
+		},
+	}
+
+	scanner := NewENIScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 1 {
+		t.Fatalf("expected 1 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingUnusedENI {
+		t.Fatalf("expected UNUSED_ENI, got %s", f.ID)
+	}
+	if f.ResourceName != "leftover" {
+		t.Fatalf("expected name leftover, got %q", f.ResourceName)
+	}
+	if f.EstimatedMonthlyWaste != 0 || !f.Hygiene {
+		t.Fatalf("expected $0 hygiene finding, got $%.2f hygiene=%v", f.EstimatedMonthlyWaste, f.Hygiene)
+	}
+	if f.Metadata["interface_type"] != "lambda" {
+		t.Fatalf("expected interface_type lambda, got %v", f.Metadata["interface_type"])
+	}
+	if f.Metadata["private_ip"] != "10.0.1.15" || f.Metadata["vpc_id"] != "vpc-123" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if f.Metadata["description"] != "AWS Lambda VPC ENI-deleted-fn" {
+		t.Fatalf("unexpected description: %v", f.Metadata["description"])
+	}
+}
+
+func TestENIScanner_AttachedSkipped(t *testing.T) {
+	mock := &mockENIClient{
+		interfaces: []ec2types.NetworkInterface{
+			{
+				NetworkInterfaceId: awssdk.String("eni-attached"),
+				Attachment:         &ec2types.NetworkInterfaceAttachment{Status: ec2types.AttachmentStatusAttached},
+			},
+		},
+	}
+
+	scanner := NewENIScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestENIScanner_Excluded(t *testing.T) {
+	mock := &mockENIClient{
+		interfaces: []ec2types.NetworkInterface{
+			{NetworkInterfaceId: awssdk.String("eni-keep")},
+			{
+				NetworkInterfaceId: awssdk.String("eni-tagged"),
+				TagSet:             []ec2types.Tag{{Key: awssdk.String("team"), Value: awssdk.String("net")}},
+			},
+		},
+	}
+
+	scanner := NewENIScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		Exclude: ExcludeConfig{
+			ResourceIDs: map[string]bool{"eni-keep": true},
+			Tags:        map[string]string{"team": "net"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestENIScanner_APIError(t *testing.T) {
+	scanner := NewENIScanner(&mockENIClient{err: errors.New("denied")}, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
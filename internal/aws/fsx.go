@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// FSxAPI is the minimal interface for FSx operations.
+type FSxAPI interface {
+	DescribeFileSystems(ctx context.Context, input *fsx.DescribeFileSystemsInput, opts ...func(*fsx.Options)) (*fsx.DescribeFileSystemsOutput, error)
+}
+
+// FSxScanner detects FSx file systems with no data reads or writes.
+type FSxScanner struct {
+	client  FSxAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewFSxScanner creates a scanner for FSx file systems.
+func NewFSxScanner(client FSxAPI, metrics *MetricsFetcher, region string) *FSxScanner {
+	return &FSxScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *FSxScanner) Type() ResourceType {
+	return ResourceFSx
+}
+
+// Scan examines available FSx file systems older than the idle window for zero data IO.
+func (s *FSxScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	fileSystems, err := s.listFileSystems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list FSx file systems: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(fileSystems)}
+
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	var ids []string
+	fsMap := make(map[string]fsxtypes.FileSystem, len(fileSystems))
+	for _, fs := range fileSystems {
+		id := deref(fs.FileSystemId)
+		if cfg.Exclude.ShouldExclude(id, fsxTagsToMap(fs.Tags)) {
+			continue
+		}
+		if fs.Lifecycle != fsxtypes.FileSystemLifecycleAvailable {
+			continue
+		}
+		// File systems created within the idle window have not had time to see traffic.
+		if fs.CreationTime != nil && fs.CreationTime.After(cutoff) {
+			continue
+		}
+		ids = append(ids, id)
+		fsMap[id] = fs
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	readMap, err := s.metrics.FetchSum(ctx, "AWS/FSx", "DataReadBytes", "FileSystemId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch FSx read metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	writeMap, err := s.metrics.FetchSum(ctx, "AWS/FSx", "DataWriteBytes", "FileSystemId", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch FSx write metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, id := range ids {
+		if readMap[id] > 0 || writeMap[id] > 0 {
+			continue
+		}
+		fs := fsMap[id]
+		storageGiB := int(derefInt32(fs.StorageCapacity))
+		deploymentType, throughput := fsxDeployment(fs)
+
+		meta := map[string]any{
+			"file_system_type":  string(fs.FileSystemType),
+			"deployment_type":   deploymentType,
+			"storage_type":      string(fs.StorageType),
+			"storage_gib":       storageGiB,
+			"throughput_mbps":   throughput,
+			"total_read_bytes":  readMap[id],
+			"total_write_bytes": writeMap[id],
+		}
+		setTagsMetadata(meta, fsxTagsToMap(fs.Tags))
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingFSxIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceFSx,
+			ResourceID:            id,
+			ResourceName:          fsxName(fs.Tags),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero data read or written over %d days (%s, %d GiB)", cfg.IdleDays, fs.FileSystemType, storageGiB),
+			EstimatedMonthlyWaste: pricing.MonthlyFSxCost(string(fs.FileSystemType), storageGiB, throughput, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *FSxScanner) listFileSystems(ctx context.Context) ([]fsxtypes.FileSystem, error) {
+	var fileSystems []fsxtypes.FileSystem
+	paginator := fsx.NewDescribeFileSystemsPaginator(s.client, &fsx.DescribeFileSystemsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		fileSystems = append(fileSystems, page.FileSystems...)
+	}
+	return fileSystems, nil
+}
+
+// fsxDeployment returns the deployment type and provisioned throughput (MBps) from the
+// type-specific configuration. Lustre throughput is bundled into its storage price, so
+// it is reported as the aggregate per-unit throughput for metadata only.
+func fsxDeployment(fs fsxtypes.FileSystem) (string, int) {
+	switch {
+	case fs.WindowsConfiguration != nil:
+		return string(fs.WindowsConfiguration.DeploymentType), int(derefInt32(fs.WindowsConfiguration.ThroughputCapacity))
+	case fs.OntapConfiguration != nil:
+		return string(fs.OntapConfiguration.DeploymentType), int(derefInt32(fs.OntapConfiguration.ThroughputCapacity))
+	case fs.OpenZFSConfiguration != nil:
+		return string(fs.OpenZFSConfiguration.DeploymentType), int(derefInt32(fs.OpenZFSConfiguration.ThroughputCapacity))
+	case fs.LustreConfiguration != nil:
+		perTiB := int(derefInt32(fs.LustreConfiguration.PerUnitStorageThroughput))
+		return string(fs.LustreConfiguration.DeploymentType), perTiB * int(derefInt32(fs.StorageCapacity)) / 1024
+	}
+	return "", 0
+}
+
+func fsxName(tags []fsxtypes.Tag) string {
+	for _, t := range tags {
+		if deref(t.Key) == "Name" {
+			return deref(t.Value)
+		}
+	}
+	return ""
+}
+
+func fsxTagsToMap(tags []fsxtypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+)
+
+type mockFSxClient struct {
+	fileSystems []fsxtypes.FileSystem
+	err         error
+}
+
+func (m *mockFSxClient) DescribeFileSystems(_ context.Context, _ *fsx.DescribeFileSystemsInput, _ ...func(*fsx.Options)) (*fsx.DescribeFileSystemsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &fsx.DescribeFileSystemsOutput{FileSystems: m.fileSystems}, nil
+}
+
+func TestFSxScanner_IdleFileSystems(t *testing.T) {
+	old := awssdk.Time(time.Now().Add(-60 * 24 * time.Hour))
+	mock := &mockFSxClient{fileSystems: []fsxtypes.FileSystem{
+		{
+			FileSystemId:    awssdk.String("fs-windows"),
+			FileSystemType:  fsxtypes.FileSystemTypeWindows,
+			Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+			StorageCapacity: awssdk.Int32(1024),
+			StorageType:     fsxtypes.StorageTypeSsd,
+			CreationTime:    old,
+			Tags:            []fsxtypes.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("shares")}},
+			WindowsConfiguration: &fsxtypes.WindowsFileSystemConfiguration{
+				DeploymentType:     fsxtypes.WindowsDeploymentTypeSingleAz2,
+				ThroughputCapacity: awssdk.Int32(32),
+			},
+		},
+		{
+			FileSystemId:    awssdk.String("fs-lustre"),
+			FileSystemType:  fsxtypes.FileSystemTypeLustre,
+			Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+			StorageCapacity: awssdk.Int32(1200),
+			CreationTime:    old,
+			LustreConfiguration: &fsxtypes.LustreFileSystemConfiguration{
+				DeploymentType:           fsxtypes.LustreDeploymentTypePersistent2,
+				PerUnitStorageThroughput: awssdk.Int32(125),
+			},
+		},
+		{
+			FileSystemId:    awssdk.String("fs-ontap-busy"),
+			FileSystemType:  fsxtypes.FileSystemTypeOntap,
+			Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+			StorageCapacity: awssdk.Int32(1024),
+			CreationTime:    old,
+			OntapConfiguration: &fsxtypes.OntapFileSystemConfiguration{
+				DeploymentType:     fsxtypes.OntapDeploymentTypeMultiAz1,
+				ThroughputCapacity: awssdk.Int32(128),
+			},
+		},
+		{
+			FileSystemId:    awssdk.String("fs-new"),
+			FileSystemType:  fsxtypes.FileSystemTypeWindows,
+			Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+			StorageCapacity: awssdk.Int32(32),
+			CreationTime:    awssdk.Time(time.Now().Add(-2 * 24 * time.Hour)),
+		},
+		{
+			FileSystemId:   awssdk.String("fs-creating"),
+			FileSystemType: fsxtypes.FileSystemTypeWindows,
+			Lifecycle:      fsxtypes.FileSystemLifecycleCreating,
+		},
+	}}
+	metrics := newMockMetricsFetcher(map[string]float64{"fs-windows": 0, "fs-lustre": 0, "fs-ontap-busy": 4096})
+	scanner := NewFSxScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	win := byID["fs-windows"]
+	if win.ID != FindingFSxIdle || win.ResourceName != "shares" {
+		t.Fatalf("unexpected finding: %+v", win)
+	}
+	// 1024 GiB * $0.13 + 32 MBps * $2.20 = $203.52
+	if win.EstimatedMonthlyWaste < 203.51 || win.EstimatedMonthlyWaste > 203.53 {
+		t.Fatalf("expected ~$203.52, got $%.2f", win.EstimatedMonthlyWaste)
+	}
+	if win.Metadata["file_system_type"] != "WINDOWS" || win.Metadata["deployment_type"] != "SINGLE_AZ_2" {
+		t.Fatalf("unexpected metadata: %v", win.Metadata)
+	}
+
+	lustre := byID["fs-lustre"]
+	if lustre.Metadata["deployment_type"] != "PERSISTENT_2" {
+		t.Fatalf("unexpected metadata: %v", lustre.Metadata)
+	}
+	// Lustre throughput is bundled: 1200 GiB * $0.145 = $174.00
+	if lustre.EstimatedMonthlyWaste < 173.99 || lustre.EstimatedMonthlyWaste > 174.01 {
+		t.Fatalf("expected ~$174.00, got $%.2f", lustre.EstimatedMonthlyWaste)
+	}
+}
+
+func TestFSxScanner_ExcludedByTag(t *testing.T) {
+	mock := &mockFSxClient{fileSystems: []fsxtypes.FileSystem{{
+		FileSystemId:    awssdk.String("fs-1"),
+		FileSystemType:  fsxtypes.FileSystemTypeWindows,
+		Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+		StorageCapacity: awssdk.Int32(32),
+		Tags:            []fsxtypes.Tag{{Key: awssdk.String("awsspectre"), Value: awssdk.String("ignore")}},
+	}}}
+	scanner := NewFSxScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 14,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"awsspectre": "ignore"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestFSxScanner_APIError(t *testing.T) {
+	scanner := NewFSxScanner(&mockFSxClient{err: errors.New("denied")}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	gatypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	// globalAcceleratorControlPlaneRegion hosts the Global Accelerator API and its metrics.
+	globalAcceleratorControlPlaneRegion = "us-west-2"
+	globalAcceleratorFindingRegion      = "global"
+)
+
+// GlobalAcceleratorAPI is the minimal interface for Global Accelerator operations.
+type GlobalAcceleratorAPI interface {
+	ListAccelerators(ctx context.Context, input *globalaccelerator.ListAcceleratorsInput, opts ...func(*globalaccelerator.Options)) (*globalaccelerator.ListAcceleratorsOutput, error)
+	ListListeners(ctx context.Context, input *globalaccelerator.ListListenersInput, opts ...func(*globalaccelerator.Options)) (*globalaccelerator.ListListenersOutput, error)
+	ListEndpointGroups(ctx context.Context, input *globalaccelerator.ListEndpointGroupsInput, opts ...func(*globalaccelerator.Options)) (*globalaccelerator.ListEndpointGroupsOutput, error)
+}
+
+// GlobalAcceleratorScanner detects accelerators with no healthy endpoints or no traffic.
+type GlobalAcceleratorScanner struct {
+	client  GlobalAcceleratorAPI
+	metrics *MetricsFetcher
+}
+
+// NewGlobalAcceleratorScanner creates a scanner for standard accelerators.
+// Both client and metrics must target us-west-2.
+func NewGlobalAcceleratorScanner(client GlobalAcceleratorAPI, metrics *MetricsFetcher) *GlobalAcceleratorScanner {
+	return &GlobalAcceleratorScanner{client: client, metrics: metrics}
+}
+
+// Type returns the resource type.
+func (s *GlobalAcceleratorScanner) Type() ResourceType {
+	return ResourceGlobalAccelerator
+}
+
+// acceleratorInfo holds the listener and endpoint topology of one accelerator.
+type acceleratorInfo struct {
+	acc              gatypes.Accelerator
+	id               string
+	listeners        int
+	endpointGroups   int
+	healthyEndpoints int
+}
+
+// Scan examines standard accelerators older than the idle window. The fixed hourly fee
+// applies whether or not the accelerator is enabled, until it is deleted.
+func (s *GlobalAcceleratorScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	accelerators, err := s.listAccelerators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list accelerators: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(accelerators)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	var infos []acceleratorInfo
+	var ids []string
+	for _, acc := range accelerators {
+		arn := deref(acc.AcceleratorArn)
+		id := acceleratorID(arn)
+		if cfg.Exclude.ShouldExclude(id, nil) || cfg.Exclude.ShouldExclude(arn, nil) || cfg.Exclude.ShouldExclude(deref(acc.Name), nil) {
+			continue
+		}
+		if acc.CreatedTime != nil && acc.CreatedTime.After(cutoff) {
+			continue
+		}
+
+		info, err := s.describeTopology(ctx, acc)
+		if err != nil {
+			slog.Warn("Failed to list accelerator listeners", "accelerator", id, "error", err)
+			continue
+		}
+		info.id = id
+		infos = append(infos, info)
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	flows, metricsErr := s.metrics.FetchSum(ctx, "AWS/GlobalAccelerator", "NewFlowCount", "Accelerator", ids, cfg.IdleDays)
+	if metricsErr != nil {
+		// Without flow data, only the structural no-healthy-endpoint check applies.
+		slog.Warn("Failed to fetch Global Accelerator flow metrics", "error", metricsErr)
+		result.Errors = append(result.Errors, fmt.Sprintf("globalaccelerator flow metric: %v", metricsErr))
+	}
+
+	for _, info := range infos {
+		newFlows, hasFlows := flows[info.id]
+		var msg string
+		switch {
+		case info.healthyEndpoints == 0:
+			msg = fmt.Sprintf("No healthy endpoints across %d endpoint groups", info.endpointGroups)
+		case metricsErr == nil && newFlows == 0:
+			msg = fmt.Sprintf("Zero new flows over %d days", cfg.IdleDays)
+		default:
+			continue
+		}
+
+		meta := map[string]any{
+			"enabled":                awssdk.ToBool(info.acc.Enabled),
+			"dns_name":               deref(info.acc.DnsName),
+			"listener_count":         info.listeners,
+			"endpoint_group_count":   info.endpointGroups,
+			"healthy_endpoint_count": info.healthyEndpoints,
+		}
+		if hasFlows {
+			meta["new_flow_count"] = newFlows
+		}
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingGAIdle,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceGlobalAccelerator,
+			ResourceID:            info.id,
+			ResourceName:          deref(info.acc.Name),
+			Region:                globalAcceleratorFindingRegion,
+			Message:               msg,
+			EstimatedMonthlyWaste: pricing.MonthlyGlobalAcceleratorCost(),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *GlobalAcceleratorScanner) describeTopology(ctx context.Context, acc gatypes.Accelerator) (acceleratorInfo, error) {
+	info := acceleratorInfo{acc: acc}
+	listeners := globalaccelerator.NewListListenersPaginator(s.client, &globalaccelerator.ListListenersInput{
+		AcceleratorArn: acc.AcceleratorArn,
+	})
+	for listeners.HasMorePages() {
+		page, err := listeners.NextPage(ctx)
+		if err != nil {
+			return info, err
+		}
+		for _, l := range page.Listeners {
+			info.listeners++
+			groups := globalaccelerator.NewListEndpointGroupsPaginator(s.client, &globalaccelerator.ListEndpointGroupsInput{
+				ListenerArn: l.ListenerArn,
+			})
+			for groups.HasMorePages() {
+				gp, err := groups.NextPage(ctx)
+				if err != nil {
+					return info, err
+				}
+				for _, g := range gp.EndpointGroups {
+					info.endpointGroups++
+					for _, ep := range g.EndpointDescriptions {
+						if ep.HealthState == gatypes.HealthStateHealthy {
+							info.healthyEndpoints++
+						}
+					}
+				}
+			}
+		}
+	}
+	return info, nil
+}
+
+func (s *GlobalAcceleratorScanner) listAccelerators(ctx context.Context) ([]gatypes.Accelerator, error) {
+	var accelerators []gatypes.Accelerator
+	paginator := globalaccelerator.NewListAcceleratorsPaginator(s.client, &globalaccelerator.ListAcceleratorsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accelerators = append(accelerators, page.Accelerators...)
+	}
+	return accelerators, nil
+}
+
+// acceleratorID extracts the accelerator ID, used as the CloudWatch dimension value,
+// from arn:aws:globalaccelerator::123456789012:accelerator/<id>.
+func acceleratorID(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
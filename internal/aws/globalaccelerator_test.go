@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	gatypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+)
+
+type mockGlobalAcceleratorClient struct {
+	accelerators []gatypes.Accelerator
+	listeners    map[string][]gatypes.Listener
+	groups       map[string][]gatypes.EndpointGroup
+}
+
+func (m *mockGlobalAcceleratorClient) ListAccelerators(_ context.Context, _ *globalaccelerator.ListAcceleratorsInput, _ ...func(*globalaccelerator.Options)) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	return &globalaccelerator.ListAcceleratorsOutput{Accelerators: m.accelerators}, nil
+}
+
+func (m *mockGlobalAcceleratorClient) ListListeners(_ context.Context, input *globalaccelerator.ListListenersInput, _ ...func(*globalaccelerator.Options)) (*globalaccelerator.ListListenersOutput, error) {
+	return &globalaccelerator.ListListenersOutput{Listeners: m.listeners[*input.AcceleratorArn]}, nil
+}
+
+func (m *mockGlobalAcceleratorClient) ListEndpointGroups(_ context.Context, input *globalaccelerator.ListEndpointGroupsInput, _ ...func(*globalaccelerator.Options)) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	return &globalaccelerator.ListEndpointGroupsOutput{EndpointGroups: m.groups[*input.ListenerArn]}, nil
+}
+
+// gaTopology builds a mock with one accelerator per id, each with one listener and one
+// endpoint group holding a single endpoint in the given health state.
+func gaTopology(health map[string]gatypes.HealthState) *mockGlobalAcceleratorClient {
+	m := &mockGlobalAcceleratorClient{
+		listeners: make(map[string][]gatypes.Listener),
+		groups:    make(map[string][]gatypes.EndpointGroup),
+	}
+	for id, state := range health {
+		arn := "arn:aws:globalaccelerator::123456789012:accelerator/" + id
+		listenerArn := arn + "/listener/l1"
+		m.accelerators = append(m.accelerators, gatypes.Accelerator{
+			AcceleratorArn: awssdk.String(arn),
+			Name:           awssdk.String(id),
+			Enabled:        awssdk.Bool(true),
+			CreatedTime:    awssdk.Time(time.Now().Add(-60 * 24 * time.Hour)),
+		})
+		m.listeners[arn] = []gatypes.Listener{{ListenerArn: awssdk.String(listenerArn)}}
+		m.groups[listenerArn] = []gatypes.EndpointGroup{{
+			EndpointDescriptions: []gatypes.EndpointDescription{{EndpointId: awssdk.String("alb-1"), HealthState: state}},
+		}}
+	}
+	return m
+}
+
+func TestGlobalAcceleratorScanner_IdleAccelerators(t *testing.T) {
+	mock := gaTopology(map[string]gatypes.HealthState{
+		"unhealthy": gatypes.HealthStateUnhealthy,
+		"no-flows":  gatypes.HealthStateHealthy,
+		"active":    gatypes.HealthStateHealthy,
+	})
+	metrics := newMockMetricsFetcher(map[string]float64{"active": 5000})
+	scanner := NewGlobalAcceleratorScanner(mock, metrics)
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+	byID := findingsByResourceID(result.Findings)
+	f := byID["unhealthy"]
+	if f.ID != FindingGAIdle || f.Region != "global" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// $0.025/hr * 730 = $18.25
+	if f.EstimatedMonthlyWaste < 18.24 || f.EstimatedMonthlyWaste > 18.26 {
+		t.Fatalf("expected ~$18.25, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["listener_count"] != 1 || f.Metadata["endpoint_group_count"] != 1 || f.Metadata["healthy_endpoint_count"] != 0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if _, ok := byID["no-flows"]; !ok {
+		t.Fatal("expected finding for healthy accelerator with zero flows")
+	}
+}
+
+func TestGlobalAcceleratorScanner_MetricErrorKeepsStructuralFindings(t *testing.T) {
+	mock := gaTopology(map[string]gatypes.HealthState{
+		"unhealthy": gatypes.HealthStateUnhealthy,
+		"healthy":   gatypes.HealthStateHealthy,
+	})
+	metrics := NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, _ *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			return nil, errors.New("throttled")
+		},
+	})
+	scanner := NewGlobalAcceleratorScanner(mock, metrics)
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ResourceID != "unhealthy" {
+		t.Fatalf("expected only the unhealthy accelerator, got %+v", result.Findings)
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected metric error recorded, got %v", result.Errors)
+	}
+}
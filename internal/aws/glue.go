@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	gluetypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// glueWorkerDPU is the number of DPUs each Glue worker type provides.
+var glueWorkerDPU = map[gluetypes.WorkerType]float64{
+	gluetypes.WorkerTypeStandard: 1,
+	gluetypes.WorkerTypeG025x:    0.25,
+	gluetypes.WorkerTypeG1x:      1,
+	gluetypes.WorkerTypeG2x:      2,
+	gluetypes.WorkerTypeG4x:      4,
+	gluetypes.WorkerTypeG8x:      8,
+	gluetypes.WorkerTypeZ2x:      2,
+}
+
+// GlueAPI is the minimal interface for Glue operations.
+type GlueAPI interface {
+	GetDevEndpoints(ctx context.Context, input *glue.GetDevEndpointsInput, opts ...func(*glue.Options)) (*glue.GetDevEndpointsOutput, error)
+	GetJobs(ctx context.Context, input *glue.GetJobsInput, opts ...func(*glue.Options)) (*glue.GetJobsOutput, error)
+	GetJobRuns(ctx context.Context, input *glue.GetJobRunsInput, opts ...func(*glue.Options)) (*glue.GetJobRunsOutput, error)
+}
+
+// GlueScanner detects idle development endpoints and jobs that no longer run.
+type GlueScanner struct {
+	client GlueAPI
+	region string
+}
+
+// NewGlueScanner creates a scanner for Glue dev endpoints and jobs.
+func NewGlueScanner(client GlueAPI, region string) *GlueScanner {
+	return &GlueScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *GlueScanner) Type() ResourceType {
+	return ResourceGlue
+}
+
+// Scan examines dev endpoints and jobs. Glue publishes no session metrics for dev
+// endpoints, so a READY endpoint left unmodified for the idle window is treated as idle.
+func (s *GlueScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	endpoints, err := s.listDevEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Glue dev endpoints: %w", err)
+	}
+	jobs, err := s.listJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Glue jobs: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(endpoints) + len(jobs)}
+	now := time.Now().UTC()
+	idleCutoff := now.Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	staleCutoff := now.Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, ep := range endpoints {
+		name := deref(ep.EndpointName)
+		if cfg.Exclude.ShouldExclude(name, nil) || deref(ep.Status) != "READY" {
+			continue
+		}
+		lastChange := ep.LastModifiedTimestamp
+		if lastChange == nil {
+			lastChange = ep.CreatedTimestamp
+		}
+		if lastChange != nil && lastChange.After(idleCutoff) {
+			continue
+		}
+
+		dpus := devEndpointDPUs(ep)
+		meta := map[string]any{
+			"dpu_count":    dpus,
+			"glue_version": deref(ep.GlueVersion),
+		}
+		if lastChange != nil {
+			meta["last_modified"] = lastChange.UTC().Format(time.RFC3339)
+		}
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingGlueIdleDevEndpoint,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceGlue,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Dev endpoint billing %.2g DPUs continuously, unchanged for over %d days", dpus, cfg.IdleDays),
+			EstimatedMonthlyWaste: pricing.MonthlyGlueDPUCost(dpus, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	for _, job := range jobs {
+		name := deref(job.Name)
+		if cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+		if job.CreatedOn != nil && job.CreatedOn.After(staleCutoff) {
+			continue
+		}
+
+		lastRun, err := s.lastJobRun(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to get Glue job runs", "job", name, "error", err)
+			continue
+		}
+		if lastRun != nil && lastRun.After(staleCutoff) {
+			continue
+		}
+
+		meta := map[string]any{
+			"dpu_count": jobDPUs(job),
+		}
+		msg := fmt.Sprintf("No runs in %d days", cfg.StaleDays)
+		if lastRun != nil {
+			meta["last_run"] = lastRun.UTC().Format(time.RFC3339)
+		} else {
+			// Glue keeps job run history for 90 days.
+			msg = "No runs in retained job run history"
+		}
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingGlueUnusedJob,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceGlue,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               msg,
+			EstimatedMonthlyWaste: 0,
+			Hygiene:               true, // jobs bill per run, not while defined
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// devEndpointDPUs returns the DPUs allocated to a dev endpoint, from its workers when
+// a worker type is set and from NumberOfNodes otherwise.
+func devEndpointDPUs(ep gluetypes.DevEndpoint) float64 {
+	if ep.WorkerType != "" && ep.NumberOfWorkers != nil {
+		return float64(*ep.NumberOfWorkers) * glueWorkerDPU[ep.WorkerType]
+	}
+	return float64(ep.NumberOfNodes)
+}
+
+func jobDPUs(job gluetypes.Job) float64 {
+	if job.WorkerType != "" && job.NumberOfWorkers != nil {
+		return float64(*job.NumberOfWorkers) * glueWorkerDPU[job.WorkerType]
+	}
+	if job.MaxCapacity != nil {
+		return *job.MaxCapacity
+	}
+	return 0
+}
+
+// lastJobRun returns the start time of the most recent run; GetJobRuns lists newest first.
+func (s *GlueScanner) lastJobRun(ctx context.Context, name string) (*time.Time, error) {
+	out, err := s.client.GetJobRuns(ctx, &glue.GetJobRunsInput{
+		JobName:    &name,
+		MaxResults: awssdk.Int32(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.JobRuns) == 0 {
+		return nil, nil
+	}
+	return out.JobRuns[0].StartedOn, nil
+}
+
+func (s *GlueScanner) listDevEndpoints(ctx context.Context) ([]gluetypes.DevEndpoint, error) {
+	var endpoints []gluetypes.DevEndpoint
+	paginator := glue.NewGetDevEndpointsPaginator(s.client, &glue.GetDevEndpointsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, page.DevEndpoints...)
+	}
+	return endpoints, nil
+}
+
+func (s *GlueScanner) listJobs(ctx context.Context) ([]gluetypes.Job, error) {
+	var jobs []gluetypes.Job
+	paginator := glue.NewGetJobsPaginator(s.client, &glue.GetJobsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, page.Jobs...)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	gluetypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+type mockGlueClient struct {
+	endpoints []gluetypes.DevEndpoint
+	jobs      []gluetypes.Job
+	runs      map[string][]gluetypes.JobRun
+}
+
+func (m *mockGlueClient) GetDevEndpoints(_ context.Context, _ *glue.GetDevEndpointsInput, _ ...func(*glue.Options)) (*glue.GetDevEndpointsOutput, error) {
+	return &glue.GetDevEndpointsOutput{DevEndpoints: m.endpoints}, nil
+}
+
+func (m *mockGlueClient) GetJobs(_ context.Context, _ *glue.GetJobsInput, _ ...func(*glue.Options)) (*glue.GetJobsOutput, error) {
+	return &glue.GetJobsOutput{Jobs: m.jobs}, nil
+}
+
+func (m *mockGlueClient) GetJobRuns(_ context.Context, input *glue.GetJobRunsInput, _ ...func(*glue.Options)) (*glue.GetJobRunsOutput, error) {
+	return &glue.GetJobRunsOutput{JobRuns: m.runs[*input.JobName]}, nil
+}
+
+func TestGlueScanner_IdleDevEndpoint(t *testing.T) {
+	old := awssdk.Time(time.Now().Add(-60 * 24 * time.Hour))
+	mock := &mockGlueClient{endpoints: []gluetypes.DevEndpoint{
+		{EndpointName: awssdk.String("notebook"), Status: awssdk.String("READY"), NumberOfNodes: 5, LastModifiedTimestamp: old},
+		{EndpointName: awssdk.String("fresh"), Status: awssdk.String("READY"), NumberOfNodes: 5, LastModifiedTimestamp: awssdk.Time(time.Now())},
+		{EndpointName: awssdk.String("failed"), Status: awssdk.String("FAILED"), NumberOfNodes: 5, LastModifiedTimestamp: old},
+	}}
+	scanner := NewGlueScanner(mock, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingGlueIdleDevEndpoint || f.ResourceID != "notebook" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 5 DPUs * $0.44 * 730 = $1606.00
+	if f.EstimatedMonthlyWaste < 1605.9 || f.EstimatedMonthlyWaste > 1606.1 {
+		t.Fatalf("expected ~$1606.00, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["dpu_count"] != 5.0 {
+		t.Fatalf("expected dpu_count 5, got %v", f.Metadata["dpu_count"])
+	}
+}
+
+func TestGlueScanner_UnusedJobs(t *testing.T) {
+	created := awssdk.Time(time.Now().Add(-365 * 24 * time.Hour))
+	mock := &mockGlueClient{
+		jobs: []gluetypes.Job{
+			{Name: awssdk.String("stale"), CreatedOn: created, WorkerType: gluetypes.WorkerTypeG2x, NumberOfWorkers: awssdk.Int32(10)},
+			{Name: awssdk.String("never"), CreatedOn: created, MaxCapacity: awssdk.Float64(2)},
+			{Name: awssdk.String("nightly"), CreatedOn: created},
+		},
+		runs: map[string][]gluetypes.JobRun{
+			"stale":   {{StartedOn: awssdk.Time(time.Now().Add(-120 * 24 * time.Hour))}},
+			"nightly": {{StartedOn: awssdk.Time(time.Now().Add(-12 * time.Hour))}},
+		},
+	}
+	scanner := NewGlueScanner(mock, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+	byID := findingsByResourceID(result.Findings)
+	stale := byID["stale"]
+	if stale.ID != FindingGlueUnusedJob || !stale.Hygiene || stale.EstimatedMonthlyWaste != 0 {
+		t.Fatalf("unexpected finding: %+v", stale)
+	}
+	if stale.Metadata["dpu_count"] != 20.0 || stale.Metadata["last_run"] == nil {
+		t.Fatalf("unexpected metadata: %v", stale.Metadata)
+	}
+	if _, ok := byID["never"].Metadata["last_run"]; ok {
+		t.Fatal("job without runs should have no last_run")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	firehosetypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
 	kinesistypes "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 	"github.com/ppiankov/awsspectre/internal/pricing"
@@ -103,6 +104,11 @@ func (s *KinesisScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult,
 		if isProvisioned {
 			shardCost = pricing.MonthlyKinesisShardCost(int(info.shardCount), s.region)
 		}
+		// On-demand streams bill a per-stream hourly charge even with no traffic.
+		idleCost := shardCost
+		if !isProvisioned {
+			idleCost = pricing.MonthlyKinesisOnDemandBaseCost(s.region)
+		}
 
 		// KINESIS_STREAM_IDLE: zero records in and out
 		if incoming == 0 && reading == 0 {
@@ -114,8 +120,7 @@ func (s *KinesisScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult,
 				ResourceName:          info.arn,
 				Region:                s.region,
 				Message:               fmt.Sprintf("Zero records in/out over %d days (%d shards, %s mode)", cfg.IdleDays, info.shardCount, info.mode),
-				EstimatedMonthlyWaste: shardCost,
-				Hygiene:               !isProvisioned, // WO-197: on-demand idle streams have no shard cost but must stay visible.
+				EstimatedMonthlyWaste: idleCost,
 				Metadata: map[string]any{
 					"shard_count": info.shardCount,
 					"stream_mode": info.mode,
@@ -201,6 +206,7 @@ func (s *KinesisScanner) describeStream(ctx context.Context, name string) (strea
 // FirehoseAPI is the minimal interface for Firehose operations.
 type FirehoseAPI interface {
 	ListDeliveryStreams(ctx context.Context, input *firehose.ListDeliveryStreamsInput, opts ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error)
+	DescribeDeliveryStream(ctx context.Context, input *firehose.DescribeDeliveryStreamInput, opts ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error)
 }
 
 // FirehoseScanner detects idle Firehose delivery streams.
@@ -220,7 +226,9 @@ func (s *FirehoseScanner) Type() ResourceType {
 	return ResourceFirehose
 }
 
-// Scan examines all Firehose delivery streams for zero incoming records.
+// Scan examines all Firehose delivery streams for zero incoming records. Firehose bills
+// per GB ingested, so an idle stream costs nothing unless it delivers into a VPC,
+// which adds an hourly charge for each subnet's endpoint.
 func (s *FirehoseScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
 	streamNames, err := s.listDeliveryStreams(ctx)
 	if err != nil {
@@ -255,6 +263,21 @@ func (s *FirehoseScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult
 			continue
 		}
 
+		meta := map[string]any{
+			"delivery_stream_name": name,
+		}
+		var cost float64
+		out, err := s.client.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{DeliveryStreamName: &name})
+		if err != nil {
+			slog.Warn("Failed to describe Firehose delivery stream", "stream", name, "error", err)
+		} else if desc := out.DeliveryStreamDescription; desc != nil {
+			dest := describeFirehoseDestination(desc.Destinations)
+			meta["destination_type"] = dest.kind
+			meta["format_conversion_enabled"] = dest.conversionEnabled
+			meta["vpc_subnet_count"] = dest.vpcSubnets
+			cost = pricing.MonthlyFirehoseVPCDeliveryCost(dest.vpcSubnets, s.region)
+		}
+
 		result.Findings = append(result.Findings, Finding{
 			ID:                    FindingKinesisFirehoseIdle,
 			Severity:              SeverityMedium,
@@ -262,17 +285,65 @@ func (s *FirehoseScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult
 			ResourceID:            name,
 			Region:                s.region,
 			Message:               fmt.Sprintf("Zero incoming records over %d days", cfg.IdleDays),
-			EstimatedMonthlyWaste: 0,
-			Hygiene:               true, // WO-194: zero-waste Firehose hygiene findings stay visible.
-			Metadata: map[string]any{
-				"delivery_stream_name": name,
-			},
+			EstimatedMonthlyWaste: cost,
+			Hygiene:               cost == 0, // WO-194: zero-waste Firehose hygiene findings stay visible.
+			Metadata:              meta,
 		})
 	}
 
 	return result, nil
 }
 
+// firehoseDestination summarizes the parts of a delivery stream's destination that
+// matter for cost.
+type firehoseDestination struct {
+	kind              string
+	conversionEnabled bool
+	vpcSubnets        int
+}
+
+// describeFirehoseDestination reads the first destination; delivery streams have one.
+func describeFirehoseDestination(dests []firehosetypes.DestinationDescription) firehoseDestination {
+	if len(dests) == 0 {
+		return firehoseDestination{}
+	}
+	d := dests[0]
+	var vpc *firehosetypes.VpcConfigurationDescription
+	var dest firehoseDestination
+	switch {
+	case d.ExtendedS3DestinationDescription != nil:
+		dest.kind = "s3"
+		if conv := d.ExtendedS3DestinationDescription.DataFormatConversionConfiguration; conv != nil {
+			dest.conversionEnabled = conv.Enabled == nil || *conv.Enabled
+		}
+	case d.S3DestinationDescription != nil:
+		dest.kind = "s3"
+	case d.RedshiftDestinationDescription != nil:
+		dest.kind = "redshift"
+	case d.AmazonopensearchserviceDestinationDescription != nil:
+		dest.kind = "opensearch"
+		vpc = d.AmazonopensearchserviceDestinationDescription.VpcConfigurationDescription
+	case d.AmazonOpenSearchServerlessDestinationDescription != nil:
+		dest.kind = "opensearch_serverless"
+		vpc = d.AmazonOpenSearchServerlessDestinationDescription.VpcConfigurationDescription
+	case d.ElasticsearchDestinationDescription != nil:
+		dest.kind = "elasticsearch"
+		vpc = d.ElasticsearchDestinationDescription.VpcConfigurationDescription
+	case d.SplunkDestinationDescription != nil:
+		dest.kind = "splunk"
+	case d.HttpEndpointDestinationDescription != nil:
+		dest.kind = "http_endpoint"
+	case d.SnowflakeDestinationDescription != nil:
+		dest.kind = "snowflake"
+	case d.IcebergDestinationDescription != nil:
+		dest.kind = "iceberg"
+	}
+	if vpc != nil {
+		dest.vpcSubnets = len(vpc.SubnetIds)
+	}
+	return dest
+}
+
 func (s *FirehoseScanner) listDeliveryStreams(ctx context.Context) ([]string, error) {
 	var names []string
 	var startName *string
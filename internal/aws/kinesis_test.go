@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	firehosetypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
 	kinesistypes "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 )
@@ -33,7 +34,16 @@ func (m *mockKinesisClient) DescribeStreamSummary(_ context.Context, input *kine
 }
 
 type mockFirehoseClient struct {
-	streams []string
+	streams      []string
+	destinations map[string]firehosetypes.DestinationDescription
+}
+
+func (m *mockFirehoseClient) DescribeDeliveryStream(_ context.Context, input *firehose.DescribeDeliveryStreamInput, _ ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error) {
+	desc := &firehosetypes.DeliveryStreamDescription{DeliveryStreamName: input.DeliveryStreamName}
+	if d, ok := m.destinations[deref(input.DeliveryStreamName)]; ok {
+		desc.Destinations = []firehosetypes.DestinationDescription{d}
+	}
+	return &firehose.DescribeDeliveryStreamOutput{DeliveryStreamDescription: desc}, nil
 }
 
 func (m *mockFirehoseClient) ListDeliveryStreams(_ context.Context, _ *firehose.ListDeliveryStreamsInput, _ ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error) {
@@ -208,11 +218,12 @@ func TestKinesisScanner_OnDemandIdle(t *testing.T) {
 	if f.ID != FindingKinesisStreamIdle {
 		t.Fatalf("expected KINESIS_STREAM_IDLE, got %s", f.ID)
 	}
-	if f.EstimatedMonthlyWaste != 0 {
-		t.Fatalf("expected $0 waste for on-demand idle stream, got %f", f.EstimatedMonthlyWaste)
+	// On-demand base charge: $0.04/hour * 730 = $29.20
+	if f.EstimatedMonthlyWaste < 29.19 || f.EstimatedMonthlyWaste > 29.21 {
+		t.Fatalf("expected ~$29.20 for on-demand idle stream, got $%.2f", f.EstimatedMonthlyWaste)
 	}
-	if !f.Hygiene {
-		t.Fatalf("expected on-demand idle stream to be marked hygiene")
+	if f.Hygiene {
+		t.Fatalf("expected priced on-demand idle stream not to be marked hygiene")
 	}
 }
 
@@ -262,6 +273,47 @@ func TestFirehoseScanner_IdleStream(t *testing.T) {
 	}
 }
 
+func TestFirehoseScanner_IdleStreamDestinations(t *testing.T) {
+	mock := &mockFirehoseClient{
+		streams: []string{"to-parquet", "to-vpc-search"},
+		destinations: map[string]firehosetypes.DestinationDescription{
+			"to-parquet": {ExtendedS3DestinationDescription: &firehosetypes.ExtendedS3DestinationDescription{
+				DataFormatConversionConfiguration: &firehosetypes.DataFormatConversionConfiguration{Enabled: awssdk.Bool(true)},
+			}},
+			"to-vpc-search": {AmazonopensearchserviceDestinationDescription: &firehosetypes.AmazonopensearchserviceDestinationDescription{
+				VpcConfigurationDescription: &firehosetypes.VpcConfigurationDescription{SubnetIds: []string{"subnet-a", "subnet-b"}},
+			}},
+		},
+	}
+
+	scanner := NewFirehoseScanner(mock, zeroMetricsFetcher(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(byID))
+	}
+
+	parquet := byID["to-parquet"]
+	if parquet.EstimatedMonthlyWaste != 0 || !parquet.Hygiene {
+		t.Fatalf("expected $0 hygiene finding without VPC delivery, got $%.2f hygiene=%t", parquet.EstimatedMonthlyWaste, parquet.Hygiene)
+	}
+	if parquet.Metadata["destination_type"] != "s3" || parquet.Metadata["format_conversion_enabled"] != true {
+		t.Fatalf("unexpected metadata: %v", parquet.Metadata)
+	}
+
+	vpc := byID["to-vpc-search"]
+	// 2 subnets at $0.01/hour * 730 = $14.60
+	if vpc.EstimatedMonthlyWaste < 14.59 || vpc.EstimatedMonthlyWaste > 14.61 || vpc.Hygiene {
+		t.Fatalf("expected ~$14.60 priced finding, got $%.2f hygiene=%t", vpc.EstimatedMonthlyWaste, vpc.Hygiene)
+	}
+	if vpc.Metadata["destination_type"] != "opensearch" || vpc.Metadata["vpc_subnet_count"] != 2 {
+		t.Fatalf("unexpected metadata: %v", vpc.Metadata)
+	}
+}
+
 func TestFirehoseScanner_ActiveStream(t *testing.T) {
 	mock := &mockFirehoseClient{streams: []string{"active-firehose"}}
 
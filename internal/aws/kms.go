@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	// kmsMaxEventPages caps the CloudTrail pages read for all KMS events in the window.
+	// When the cap is hit, keys not seen yet fall back to a per-key lookup.
+	kmsMaxEventPages = 20
+	// kmsMaxKeyEventPages caps the per-key fallback lookup. A key with this many
+	// non-cryptographic events in the window is treated as in use rather than scanned further.
+	kmsMaxKeyEventPages = 2
+	// kmsLookupInterval spaces LookupEvents calls to stay under CloudTrail's limit of
+	// 2 requests per second per account and region.
+	kmsLookupInterval = 500 * time.Millisecond
+	kmsEventSource    = "kms.amazonaws.com"
+)
+
+// kmsCryptoEvents are the CloudTrail event names that represent a key being used.
+var kmsCryptoEvents = map[string]bool{
+	"Encrypt":                             true,
+	"Decrypt":                             true,
+	"ReEncrypt":                           true,
+	"GenerateDataKey":                     true,
+	"GenerateDataKeyWithoutPlaintext":     true,
+	"GenerateDataKeyPair":                 true,
+	"GenerateDataKeyPairWithoutPlaintext": true,
+	"Sign":                                true,
+	"Verify":                              true,
+	"GenerateMac":                         true,
+	"VerifyMac":                           true,
+	"DeriveSharedSecret":                  true,
+}
+
+// KMSAPI is the minimal interface for KMS operations.
+type KMSAPI interface {
+	ListKeys(ctx context.Context, input *kms.ListKeysInput, opts ...func(*kms.Options)) (*kms.ListKeysOutput, error)
+	DescribeKey(ctx context.Context, input *kms.DescribeKeyInput, opts ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+	GetKeyRotationStatus(ctx context.Context, input *kms.GetKeyRotationStatusInput, opts ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error)
+}
+
+// KMSActivityAPI is the minimal CloudTrail interface for finding key usage events.
+type KMSActivityAPI interface {
+	LookupEvents(ctx context.Context, input *cloudtrail.LookupEventsInput, opts ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// KMSScanner detects customer-managed KMS keys with no cryptographic activity.
+type KMSScanner struct {
+	client KMSAPI
+	trail  *pacedTrail
+	region string
+}
+
+// NewKMSScanner creates a scanner for customer-managed KMS keys.
+func NewKMSScanner(client KMSAPI, trail KMSActivityAPI, region string) *KMSScanner {
+	return &KMSScanner{
+		client: client,
+		trail:  &pacedTrail{client: trail, interval: kmsLookupInterval},
+		region: region,
+	}
+}
+
+// Type returns the resource type.
+func (s *KMSScanner) Type() ResourceType {
+	return ResourceKMS
+}
+
+// Scan flags customer-managed keys older than StaleDays with no encrypt, decrypt, or
+// other cryptographic call in that window. AWS/KMS publishes no per-key request
+// metrics, so usage comes from CloudTrail management events, which LookupEvents
+// retains for 90 days; longer stale windows are effectively capped at 90.
+func (s *KMSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	keys, err := s.listKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list KMS keys: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(keys)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	var candidates []*kmstypes.KeyMetadata
+	for _, k := range keys {
+		keyID := deref(k.KeyId)
+		keyArn := deref(k.KeyArn)
+		if cfg.Exclude.ShouldExclude(keyID, nil) || cfg.Exclude.ShouldExclude(keyArn, nil) {
+			continue
+		}
+
+		desc, err := s.client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: k.KeyId})
+		if err != nil {
+			slog.Warn("Failed to describe KMS key", "key", keyID, "error", err)
+			continue
+		}
+		md := desc.KeyMetadata
+		if md == nil || md.KeyManager != kmstypes.KeyManagerTypeCustomer {
+			continue
+		}
+		if md.KeyState == kmstypes.KeyStatePendingDeletion || md.KeyState == kmstypes.KeyStatePendingReplicaDeletion {
+			continue
+		}
+		// Keys created within the stale window have not had time to be used.
+		if md.CreationDate != nil && md.CreationDate.After(cutoff) {
+			continue
+		}
+		candidates = append(candidates, md)
+	}
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	// One pass over all KMS events in the window covers every key; per-key lookups
+	// are needed only when that pass is truncated.
+	usedKeys, complete, err := s.usedKeysSince(ctx, cutoff)
+	if err != nil {
+		slog.Warn("Failed to look up KMS key activity", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, md := range candidates {
+		keyID := deref(md.KeyId)
+		keyArn := deref(md.Arn)
+		if usedKeys[keyArn] {
+			continue
+		}
+		if !complete {
+			used, err := s.usedSince(ctx, keyArn, cutoff)
+			if err != nil {
+				slog.Warn("Failed to look up KMS key activity", "key", keyID, "error", err)
+				continue
+			}
+			if used {
+				continue
+			}
+		}
+
+		meta := map[string]any{
+			"key_state":        string(md.KeyState),
+			"key_spec":         string(md.KeySpec),
+			"key_usage":        string(md.KeyUsage),
+			"rotation_enabled": s.rotationEnabled(ctx, md),
+		}
+		if md.CreationDate != nil {
+			meta["creation_date"] = md.CreationDate.UTC().Format(time.RFC3339)
+		}
+		if awssdk.ToBool(md.MultiRegion) {
+			meta["multi_region"] = true
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingKMSUnusedKey,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceKMS,
+			ResourceID:            keyID,
+			ResourceName:          deref(md.Description),
+			Region:                s.region,
+			Message:               fmt.Sprintf("No cryptographic operations over %d days (%s)", cfg.StaleDays, md.KeyState),
+			EstimatedMonthlyWaste: pricing.MonthlyKMSKeyCost(),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// usedKeysSince returns the ARNs of keys with a cryptographic call recorded by CloudTrail
+// after the cutoff, read from all KMS events in one paginated lookup. complete is false
+// when the page cap was reached before the window was fully read.
+func (s *KMSScanner) usedKeysSince(ctx context.Context, cutoff time.Time) (map[string]bool, bool, error) {
+	used := make(map[string]bool)
+	paginator := cloudtrail.NewLookupEventsPaginator(s.trail, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{
+			{AttributeKey: cttypes.LookupAttributeKeyEventSource, AttributeValue: awssdk.String(kmsEventSource)},
+		},
+		StartTime: awssdk.Time(cutoff),
+	})
+
+	for page := 0; paginator.HasMorePages(); page++ {
+		if page == kmsMaxEventPages {
+			return used, false, nil
+		}
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, e := range out.Events {
+			if !kmsCryptoEvents[deref(e.EventName)] {
+				continue
+			}
+			for _, r := range e.Resources {
+				used[deref(r.ResourceName)] = true
+			}
+		}
+	}
+	return used, true, nil
+}
+
+// usedSince reports whether CloudTrail recorded a cryptographic call against the key
+// after the cutoff. The scanner's own DescribeKey and GetKeyRotationStatus calls are ignored.
+func (s *KMSScanner) usedSince(ctx context.Context, keyArn string, cutoff time.Time) (bool, error) {
+	paginator := cloudtrail.NewLookupEventsPaginator(s.trail, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{
+			{AttributeKey: cttypes.LookupAttributeKeyResourceName, AttributeValue: awssdk.String(keyArn)},
+		},
+		StartTime: awssdk.Time(cutoff),
+	})
+
+	for page := 0; paginator.HasMorePages(); page++ {
+		if page == kmsMaxKeyEventPages {
+			return true, nil
+		}
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, e := range out.Events {
+			if kmsCryptoEvents[deref(e.EventName)] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// rotationEnabled reports automatic rotation status. Only symmetric encryption keys
+// support rotation; other key specs report false without an API call.
+func (s *KMSScanner) rotationEnabled(ctx context.Context, md *kmstypes.KeyMetadata) bool {
+	if md.KeySpec != kmstypes.KeySpecSymmetricDefault || md.Origin != kmstypes.OriginTypeAwsKms {
+		return false
+	}
+	out, err := s.client.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: md.KeyId})
+	if err != nil {
+		slog.Warn("Failed to get KMS key rotation status", "key", deref(md.KeyId), "error", err)
+		return false
+	}
+	return out.KeyRotationEnabled
+}
+
+func (s *KMSScanner) listKeys(ctx context.Context) ([]kmstypes.KeyListEntry, error) {
+	var keys []kmstypes.KeyListEntry
+	paginator := kms.NewListKeysPaginator(s.client, &kms.ListKeysInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, page.Keys...)
+	}
+	return keys, nil
+}
+
+// pacedTrail spaces out LookupEvents calls made by one scanner so that a region's
+// lookups stay under CloudTrail's request rate limit.
+type pacedTrail struct {
+	client   KMSActivityAPI
+	interval time.Duration
+	last     time.Time
+}
+
+// LookupEvents waits until interval has passed since the previous call, then forwards the request.
+func (p *pacedTrail) LookupEvents(ctx context.Context, input *cloudtrail.LookupEventsInput, opts ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	if wait := time.Until(p.last.Add(p.interval)); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	p.last = time.Now()
+	return p.client.LookupEvents(ctx, input, opts...)
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+type mockKMSClient struct {
+	keys     map[string]kmstypes.KeyMetadata
+	rotation map[string]bool
+	listErr  error
+}
+
+func (m *mockKMSClient) ListKeys(_ context.Context, _ *kms.ListKeysInput, _ ...func(*kms.Options)) (*kms.ListKeysOutput, error) {
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	var entries []kmstypes.KeyListEntry
+	for id, md := range m.keys {
+		entries = append(entries, kmstypes.KeyListEntry{KeyId: awssdk.String(id), KeyArn: md.Arn})
+	}
+	return &kms.ListKeysOutput{Keys: entries}, nil
+}
+
+func (m *mockKMSClient) DescribeKey(_ context.Context, input *kms.DescribeKeyInput, _ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	md := m.keys[*input.KeyId]
+	return &kms.DescribeKeyOutput{KeyMetadata: &md}, nil
+}
+
+func (m *mockKMSClient) GetKeyRotationStatus(_ context.Context, input *kms.GetKeyRotationStatusInput, _ ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: m.rotation[*input.KeyId]}, nil
+}
+
+type mockKMSActivityClient struct {
+	events     map[string][]string // key ARN -> event names
+	err        error
+	endlessAll bool // event-source lookups always report another page
+	calls      map[cttypes.LookupAttributeKey]int
+}
+
+func (m *mockKMSActivityClient) LookupEvents(_ context.Context, input *cloudtrail.LookupEventsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	attr := input.LookupAttributes[0]
+	if m.calls == nil {
+		m.calls = make(map[cttypes.LookupAttributeKey]int)
+	}
+	m.calls[attr.AttributeKey]++
+
+	var events []cttypes.Event
+	if attr.AttributeKey == cttypes.LookupAttributeKeyEventSource {
+		if m.endlessAll {
+			return &cloudtrail.LookupEventsOutput{NextToken: awssdk.String("more")}, nil
+		}
+		for arn, names := range m.events {
+			for _, name := range names {
+				events = append(events, cttypes.Event{
+					EventName: awssdk.String(name),
+					Resources: []cttypes.Resource{{ResourceName: awssdk.String(arn)}},
+				})
+			}
+		}
+		return &cloudtrail.LookupEventsOutput{Events: events}, nil
+	}
+	for _, name := range m.events[*attr.AttributeValue] {
+		events = append(events, cttypes.Event{EventName: awssdk.String(name)})
+	}
+	return &cloudtrail.LookupEventsOutput{Events: events}, nil
+}
+
+func kmsKey(id string, manager kmstypes.KeyManagerType, state kmstypes.KeyState, age time.Duration) kmstypes.KeyMetadata {
+	return kmstypes.KeyMetadata{
+		KeyId:        awssdk.String(id),
+		Arn:          awssdk.String("arn:aws:kms:us-east-1:123456789012:key/" + id),
+		KeyManager:   manager,
+		KeyState:     state,
+		KeySpec:      kmstypes.KeySpecSymmetricDefault,
+		Origin:       kmstypes.OriginTypeAwsKms,
+		CreationDate: awssdk.Time(time.Now().Add(-age)),
+	}
+}
+
+func TestKMSScanner_UnusedKeys(t *testing.T) {
+	old := 200 * 24 * time.Hour
+	client := &mockKMSClient{
+		keys: map[string]kmstypes.KeyMetadata{
+			"unused":   kmsKey("unused", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, old),
+			"disabled": kmsKey("disabled", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateDisabled, old),
+			"active":   kmsKey("active", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, old),
+			"aws":      kmsKey("aws", kmstypes.KeyManagerTypeAws, kmstypes.KeyStateEnabled, old),
+			"deleting": kmsKey("deleting", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStatePendingDeletion, old),
+			"new":      kmsKey("new", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, 5*24*time.Hour),
+		},
+		rotation: map[string]bool{"unused": true},
+	}
+	trail := &mockKMSActivityClient{events: map[string][]string{
+		"arn:aws:kms:us-east-1:123456789012:key/unused": {"DescribeKey", "GetKeyRotationStatus"},
+		"arn:aws:kms:us-east-1:123456789012:key/active": {"DescribeKey", "Decrypt"},
+	}}
+	scanner := NewKMSScanner(client, trail, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 6 {
+		t.Fatalf("expected 6 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %+v", len(result.Findings), result.Findings)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	f := byID["unused"]
+	if f.ID != FindingKMSUnusedKey || f.EstimatedMonthlyWaste != 1.00 {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	if f.Metadata["key_state"] != "Enabled" || f.Metadata["rotation_enabled"] != true {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if d := byID["disabled"]; d.Metadata["key_state"] != "Disabled" || d.Metadata["rotation_enabled"] != false {
+		t.Fatalf("unexpected metadata: %v", d.Metadata)
+	}
+	if trail.calls[cttypes.LookupAttributeKeyEventSource] != 1 || trail.calls[cttypes.LookupAttributeKeyResourceName] != 0 {
+		t.Fatalf("expected a single account-wide lookup, got %v", trail.calls)
+	}
+}
+
+func TestKMSScanner_TruncatedLookupFallsBackPerKey(t *testing.T) {
+	old := 200 * 24 * time.Hour
+	client := &mockKMSClient{keys: map[string]kmstypes.KeyMetadata{
+		"unused": kmsKey("unused", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, old),
+		"active": kmsKey("active", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, old),
+	}}
+	trail := &mockKMSActivityClient{
+		endlessAll: true,
+		events: map[string][]string{
+			"arn:aws:kms:us-east-1:123456789012:key/active": {"Encrypt"},
+		},
+	}
+	scanner := NewKMSScanner(client, trail, "us-east-1")
+	scanner.trail.interval = 0
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ResourceID != "unused" {
+		t.Fatalf("expected only unused key flagged, got %+v", result.Findings)
+	}
+	if trail.calls[cttypes.LookupAttributeKeyEventSource] != kmsMaxEventPages {
+		t.Fatalf("expected account-wide lookup capped at %d pages, got %d", kmsMaxEventPages, trail.calls[cttypes.LookupAttributeKeyEventSource])
+	}
+	if trail.calls[cttypes.LookupAttributeKeyResourceName] != 2 {
+		t.Fatalf("expected one per-key lookup per candidate, got %d", trail.calls[cttypes.LookupAttributeKeyResourceName])
+	}
+}
+
+func TestPacedTrail_SpacesCalls(t *testing.T) {
+	trail := &pacedTrail{client: &mockKMSActivityClient{}, interval: 20 * time.Millisecond}
+	input := &cloudtrail.LookupEventsInput{LookupAttributes: []cttypes.LookupAttribute{
+		{AttributeKey: cttypes.LookupAttributeKeyEventSource, AttributeValue: awssdk.String(kmsEventSource)},
+	}}
+
+	start := time.Now()
+	for range 3 {
+		if _, err := trail.LookupEvents(context.Background(), input); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("expected calls spaced by the interval, took %v", elapsed)
+	}
+}
+
+func TestKMSScanner_LookupErrorSkipsKey(t *testing.T) {
+	client := &mockKMSClient{keys: map[string]kmstypes.KeyMetadata{
+		"k1": kmsKey("k1", kmstypes.KeyManagerTypeCustomer, kmstypes.KeyStateEnabled, 200*24*time.Hour),
+	}}
+	scanner := NewKMSScanner(client, &mockKMSActivityClient{err: errors.New("throttled")}, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected key skipped on lookup error, got %+v", result.Findings)
+	}
+}
+
+func TestKMSScanner_APIError(t *testing.T) {
+	scanner := NewKMSScanner(&mockKMSClient{listErr: errors.New("denied")}, &mockKMSActivityClient{}, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -3,17 +3,41 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
-	"log/slog"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	// lambdaMemoryMaxUtilization flags functions whose peak memory use stays below
+	// this fraction of their configured memory.
+	lambdaMemoryMaxUtilization = 0.5
+	// lambdaMemoryHeadroom is applied to peak memory use when recommending a size.
+	lambdaMemoryHeadroom = 1.5
+	// lambdaMemoryStepMB rounds recommendations up to a familiar size.
+	lambdaMemoryStepMB = 64
+	// lambdaMinMemoryMB is the smallest memory size Lambda accepts.
+	lambdaMinMemoryMB = 128
+	// lambdaIdlePCUtilization is the average ProvisionedConcurrencyUtilization, a
+	// fraction of the allocated environments in use, below which provisioned
+	// concurrency counts as idle.
+	lambdaIdlePCUtilization = 0.01
 )
 
 // LambdaAPI is the minimal interface for Lambda operations.
 type LambdaAPI interface {
 	ListFunctions(ctx context.Context, input *lambda.ListFunctionsInput, opts ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error)
+	ListProvisionedConcurrencyConfigs(ctx context.Context, input *lambda.ListProvisionedConcurrencyConfigsInput, opts ...func(*lambda.Options)) (*lambda.ListProvisionedConcurrencyConfigsOutput, error)
 }
 
-// LambdaScanner detects Lambda functions with zero invocations.
+// LambdaScanner detects Lambda functions with zero invocations, active functions
+// configured with far more memory than they use, and idle provisioned concurrency.
 type LambdaScanner struct {
 	client  LambdaAPI
 	metrics *MetricsFetcher
@@ -30,7 +54,9 @@ func (s *LambdaScanner) Type() ResourceType {
 	return ResourceLambda
 }
 
-// Scan examines all Lambda functions for zero invocations over the idle window.
+// Scan examines all Lambda functions for zero invocations over the idle window,
+// active functions for over-provisioned memory, and every function for provisioned
+// concurrency that goes unused.
 func (s *LambdaScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
 	functions, err := s.listFunctions(ctx)
 	if err != nil {
@@ -64,8 +90,10 @@ func (s *LambdaScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult,
 		return result, nil
 	}
 
+	var active []string
 	for _, name := range names {
 		if invocations[name] > 0 {
+			active = append(active, name)
 			continue
 		}
 
@@ -96,9 +124,164 @@ func (s *LambdaScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult,
 		})
 	}
 
+	s.appendMemoryFindings(ctx, result, cfg, active, fnMap, invocations)
+	s.appendProvisionedConcurrencyFindings(ctx, result, cfg, names, fnMap)
+
 	return result, nil
 }
 
+// appendMemoryFindings recommends a smaller MemorySize for active functions whose peak
+// memory use, as reported by Lambda Insights, stays under lambdaMemoryMaxUtilization.
+// Functions without Lambda Insights publish no memory metric and are skipped. Savings
+// assume the average duration holds at the smaller size; less memory also means less
+// CPU, so CPU-bound functions may run longer.
+func (s *LambdaScanner) appendMemoryFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, names []string, fnMap map[string]lambdatypes.FunctionConfiguration, invocations map[string]float64) {
+	if len(names) == 0 {
+		return
+	}
+	usedSeries, err := s.metrics.FetchSeries(ctx, "LambdaInsights", "used_memory_max", "function_name", names, cfg.IdleDays, "Maximum")
+	if err != nil {
+		slog.Warn("Failed to fetch Lambda Insights memory metrics", "region", s.region, "error", err)
+		return
+	}
+	durations, err := s.metrics.FetchAverage(ctx, "AWS/Lambda", "Duration", "FunctionName", names, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch Lambda duration metrics", "region", s.region, "error", err)
+		return
+	}
+
+	for _, name := range names {
+		points, ok := usedSeries[name]
+		if !ok {
+			continue
+		}
+		var peakMB float64
+		for _, p := range points {
+			peakMB = max(peakMB, p.Value)
+		}
+
+		fn := fnMap[name]
+		currentMB := int(derefInt32(fn.MemorySize))
+		if currentMB == 0 || peakMB >= float64(currentMB)*lambdaMemoryMaxUtilization {
+			continue
+		}
+		recommendedMB := int(math.Ceil(peakMB*lambdaMemoryHeadroom/lambdaMemoryStepMB)) * lambdaMemoryStepMB
+		recommendedMB = max(recommendedMB, lambdaMinMemoryMB)
+		if recommendedMB >= currentMB {
+			continue
+		}
+
+		monthlyInvocations := invocations[name] * 30 / float64(cfg.IdleDays)
+		durationSec := durations[name] / 1000
+		savedGB := float64(currentMB-recommendedMB) / 1024
+		savings := savedGB * durationSec * monthlyInvocations * pricing.LambdaGBSecondCost(s.region)
+		if savings <= 0 {
+			continue
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingLambdaOverProvisioned,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceLambda,
+			ResourceID:            name,
+			ResourceName:          deref(fn.FunctionArn),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Peak memory %.0f of %d MB over %d days, reduce to %d MB", peakMB, currentMB, cfg.IdleDays, recommendedMB),
+			EstimatedMonthlyWaste: savings,
+			Metadata: map[string]any{
+				"memory_mb":             currentMB,
+				"recommended_memory_mb": recommendedMB,
+				"peak_memory_used_mb":   peakMB,
+				"avg_duration_ms":       durations[name],
+				"monthly_invocations":   math.Round(monthlyInvocations),
+				"runtime":               string(fn.Runtime),
+			},
+		})
+	}
+}
+
+// appendProvisionedConcurrencyFindings flags aliases and versions whose provisioned
+// concurrency sits nearly unused. Provisioned environments bill for every second they
+// are allocated, so waste is the full charge for the configured units at the
+// function's memory size. Lambda publishes no utilization datapoints while nothing
+// is invoked, so a missing metric counts as zero utilization.
+func (s *LambdaScanner) appendProvisionedConcurrencyFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, names []string, fnMap map[string]lambdatypes.FunctionConfiguration) {
+	for _, name := range names {
+		configs, err := s.listProvisionedConcurrency(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to list Lambda provisioned concurrency", "function", name, "error", err)
+			continue
+		}
+
+		fn := fnMap[name]
+		memoryGB := float64(derefInt32(fn.MemorySize)) / 1024
+		for _, pc := range configs {
+			if pc.Status != lambdatypes.ProvisionedConcurrencyStatusEnumReady {
+				continue
+			}
+			qualifier := qualifierFromARN(deref(pc.FunctionArn))
+			resource := name + ":" + qualifier
+			utilization, err := s.metrics.FetchAverageWithStaticDim(ctx, "AWS/Lambda", "ProvisionedConcurrencyUtilization", "Resource", []string{resource}, cfg.IdleDays,
+				[]cwtypes.Dimension{{Name: awssdk.String("FunctionName"), Value: awssdk.String(name)}})
+			if err != nil {
+				slog.Warn("Failed to fetch Lambda provisioned concurrency utilization", "function", resource, "error", err)
+				continue
+			}
+			avgUtilization := utilization[resource]
+			if avgUtilization >= lambdaIdlePCUtilization {
+				continue
+			}
+
+			units := int(derefInt32(pc.AllocatedProvisionedConcurrentExecutions))
+			cost := float64(units) * memoryGB * pricing.LambdaProvisionedConcurrencyCost(s.region)
+
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingLambdaIdleConcurrency,
+				Severity:              SeverityHigh,
+				ResourceType:          ResourceLambda,
+				ResourceID:            resource,
+				ResourceName:          deref(pc.FunctionArn),
+				Region:                s.region,
+				Message:               fmt.Sprintf("%d provisioned concurrency units at %.1f%% utilization over %d days", units, avgUtilization*100, cfg.IdleDays),
+				EstimatedMonthlyWaste: cost,
+				Metadata: map[string]any{
+					"qualifier":               qualifier,
+					"provisioned_units":       units,
+					"requested_units":         int(derefInt32(pc.RequestedProvisionedConcurrentExecutions)),
+					"avg_utilization_percent": math.Round(avgUtilization*1000) / 10,
+					"memory_mb":               int(derefInt32(fn.MemorySize)),
+				},
+			})
+		}
+	}
+}
+
+// qualifierFromARN returns the alias or version from a qualified function ARN.
+// e.g., "arn:aws:lambda:us-east-1:123456789012:function:api:live" → "live"
+func qualifierFromARN(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > 7 {
+		return parts[7]
+	}
+	return ""
+}
+
+func (s *LambdaScanner) listProvisionedConcurrency(ctx context.Context, name string) ([]lambdatypes.ProvisionedConcurrencyConfigListItem, error) {
+	var configs []lambdatypes.ProvisionedConcurrencyConfigListItem
+	paginator := lambda.NewListProvisionedConcurrencyConfigsPaginator(s.client, &lambda.ListProvisionedConcurrencyConfigsInput{
+		FunctionName: awssdk.String(name),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, page.ProvisionedConcurrencyConfigs...)
+	}
+	return configs, nil
+}
+
 func (s *LambdaScanner) listFunctions(ctx context.Context) ([]lambdatypes.FunctionConfiguration, error) {
 	var functions []lambdatypes.FunctionConfiguration
 	paginator := lambda.NewListFunctionsPaginator(s.client, &lambda.ListFunctionsInput{})
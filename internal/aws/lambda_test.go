@@ -13,13 +13,18 @@ import (
 )
 
 type mockLambdaClient struct {
-	functions []lambdatypes.FunctionConfiguration
+	functions          []lambdatypes.FunctionConfiguration
+	provisionedConfigs map[string][]lambdatypes.ProvisionedConcurrencyConfigListItem // function name -> configs
 }
 
 func (m *mockLambdaClient) ListFunctions(_ context.Context, _ *lambda.ListFunctionsInput, _ ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
 	return &lambda.ListFunctionsOutput{Functions: m.functions}, nil
 }
 
+func (m *mockLambdaClient) ListProvisionedConcurrencyConfigs(_ context.Context, input *lambda.ListProvisionedConcurrencyConfigsInput, _ ...func(*lambda.Options)) (*lambda.ListProvisionedConcurrencyConfigsOutput, error) {
+	return &lambda.ListProvisionedConcurrencyConfigsOutput{ProvisionedConcurrencyConfigs: m.provisionedConfigs[deref(input.FunctionName)]}, nil
+}
+
 func TestLambdaScanner_IdleFunction(t *testing.T) {
 	mock := &mockLambdaClient{
 		functions: []lambdatypes.FunctionConfiguration{
@@ -123,6 +128,97 @@ func TestLambdaScanner_ActiveFunction(t *testing.T) {
 	}
 }
 
+func TestLambdaScanner_OverProvisionedMemory(t *testing.T) {
+	function := func(name string, memoryMB int32) lambdatypes.FunctionConfiguration {
+		return lambdatypes.FunctionConfiguration{
+			FunctionName: awssdk.String(name),
+			FunctionArn:  awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:" + name),
+			Runtime:      lambdatypes.RuntimePython312,
+			MemorySize:   awssdk.Int32(memoryMB),
+		}
+	}
+	mock := &mockLambdaClient{functions: []lambdatypes.FunctionConfiguration{
+		function("oversized", 1024),
+		function("rightsized", 512),
+		function("no-insights", 1024),
+	}}
+	metrics := newMetricsByName(map[string]float64{
+		"Invocations/oversized":      700_000,
+		"Duration/oversized":         200,
+		"used_memory_max/oversized":  100,
+		"Invocations/rightsized":     700_000,
+		"Duration/rightsized":        200,
+		"used_memory_max/rightsized": 400,
+		"Invocations/no-insights":    700_000,
+	})
+
+	scanner := NewLambdaScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingLambdaOverProvisioned || f.ResourceID != "oversized" {
+		t.Fatalf("expected LAMBDA_OVER_PROVISIONED for oversized, got %s %s", f.ID, f.ResourceID)
+	}
+	// 100 MB peak * 1.5 rounds up to 192 MB. 3,000,000 invocations/month * 0.2s *
+	// (1024-192)/1024 GB * $0.0000166667 = $8.125
+	if f.EstimatedMonthlyWaste < 8.12 || f.EstimatedMonthlyWaste > 8.13 {
+		t.Fatalf("expected ~$8.13, got $%.4f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["memory_mb"] != 1024 || f.Metadata["recommended_memory_mb"] != 192 || f.Metadata["monthly_invocations"] != 3_000_000.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestLambdaScanner_IdleProvisionedConcurrency(t *testing.T) {
+	provisioned := func(name, qualifier string, units int32) lambdatypes.ProvisionedConcurrencyConfigListItem {
+		return lambdatypes.ProvisionedConcurrencyConfigListItem{
+			FunctionArn:                              awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:" + name + ":" + qualifier),
+			AllocatedProvisionedConcurrentExecutions: awssdk.Int32(units),
+			RequestedProvisionedConcurrentExecutions: awssdk.Int32(units),
+			Status:                                   lambdatypes.ProvisionedConcurrencyStatusEnumReady,
+		}
+	}
+	mock := &mockLambdaClient{
+		functions: []lambdatypes.FunctionConfiguration{
+			{FunctionName: awssdk.String("api"), MemorySize: awssdk.Int32(2048)},
+		},
+		provisionedConfigs: map[string][]lambdatypes.ProvisionedConcurrencyConfigListItem{
+			"api": {provisioned("api", "staging", 10), provisioned("api", "live", 50)},
+		},
+	}
+	metrics := newMetricsByName(map[string]float64{
+		"Invocations/api": 5000,
+		"ProvisionedConcurrencyUtilization/api:live": 0.45,
+	})
+
+	scanner := NewLambdaScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingLambdaIdleConcurrency || f.ResourceID != "api:staging" {
+		t.Fatalf("expected LAMBDA_IDLE_PROVISIONED_CONCURRENCY for api:staging, got %s %s", f.ID, f.ResourceID)
+	}
+	// 10 units * 2 GB * $0.015/GB-hour * 730 = $219.00
+	if f.EstimatedMonthlyWaste < 218.99 || f.EstimatedMonthlyWaste > 219.01 {
+		t.Fatalf("expected ~$219.00, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["provisioned_units"] != 10 || f.Metadata["avg_utilization_percent"] != 0.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
 func TestLambdaScanner_NoFunctions(t *testing.T) {
 	mock := &mockLambdaClient{functions: nil}
 	metrics := newMockMetricsFetcher(nil)
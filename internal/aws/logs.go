@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// logsNoRetentionMinBytes is the stored size below which a missing retention policy is not worth reporting.
+const logsNoRetentionMinBytes = 1 * bytesPerGiB
+
+// LogsAPI is the minimal interface for CloudWatch Logs operations.
+type LogsAPI interface {
+	DescribeLogGroups(ctx context.Context, input *cloudwatchlogs.DescribeLogGroupsInput, opts ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	DescribeLogStreams(ctx context.Context, input *cloudwatchlogs.DescribeLogStreamsInput, opts ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
+}
+
+// LogsScanner detects log groups that never expire data and log groups holding none.
+type LogsScanner struct {
+	client LogsAPI
+	region string
+}
+
+// NewLogsScanner creates a scanner for CloudWatch Logs log groups.
+func NewLogsScanner(client LogsAPI, region string) *LogsScanner {
+	return &LogsScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *LogsScanner) Type() ResourceType {
+	return ResourceLogGroup
+}
+
+// Scan examines log groups for a missing retention policy on significant stored data,
+// and for empty groups older than StaleDays.
+func (s *LogsScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	groups, err := s.listLogGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list log groups: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(groups)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, g := range groups {
+		name := deref(g.LogGroupName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(g.LogGroupArn), nil) {
+			continue
+		}
+		storedBytes := derefInt64(g.StoredBytes)
+
+		switch {
+		// LOGS_NO_RETENTION: data kept forever and growing
+		case g.RetentionInDays == nil && storedBytes >= logsNoRetentionMinBytes:
+			storedGiB := float64(storedBytes) / bytesPerGiB
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingLogsNoRetention,
+				Severity:              SeverityLow,
+				ResourceType:          ResourceLogGroup,
+				ResourceID:            name,
+				ResourceName:          deref(g.LogGroupArn),
+				Region:                s.region,
+				Message:               fmt.Sprintf("%.1f GiB stored with no retention policy (never expires)", storedGiB),
+				EstimatedMonthlyWaste: storedGiB * pricing.LogsStorageCostPerGB(s.region),
+				Metadata:              s.logGroupMetadata(ctx, g),
+			})
+
+		// LOGS_EMPTY_GROUP: nothing stored in a group older than the stale window
+		case storedBytes == 0 && g.CreationTime != nil && time.UnixMilli(*g.CreationTime).Before(cutoff):
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingLogsEmptyGroup,
+				Severity:              SeverityLow,
+				ResourceType:          ResourceLogGroup,
+				ResourceID:            name,
+				ResourceName:          deref(g.LogGroupArn),
+				Region:                s.region,
+				Message:               fmt.Sprintf("Empty log group older than %d days", cfg.StaleDays),
+				EstimatedMonthlyWaste: 0,
+				Hygiene:               true, // empty log groups bill nothing
+				Metadata:              s.logGroupMetadata(ctx, g),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// logGroupMetadata describes a flagged log group, including the most recent event time.
+func (s *LogsScanner) logGroupMetadata(ctx context.Context, g logstypes.LogGroup) map[string]any {
+	meta := map[string]any{
+		"stored_bytes":    derefInt64(g.StoredBytes),
+		"log_group_class": string(g.LogGroupClass),
+	}
+	if g.CreationTime != nil {
+		meta["creation_time"] = time.UnixMilli(*g.CreationTime).UTC().Format(time.RFC3339)
+	}
+
+	out, err := s.client.DescribeLogStreams(ctx, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: g.LogGroupName,
+		OrderBy:      logstypes.OrderByLastEventTime,
+		Descending:   awssdk.Bool(true),
+		Limit:        awssdk.Int32(1),
+	})
+	if err != nil {
+		slog.Warn("Failed to describe log streams", "log_group", deref(g.LogGroupName), "error", err)
+		return meta
+	}
+	if len(out.LogStreams) > 0 && out.LogStreams[0].LastEventTimestamp != nil {
+		meta["last_event_time"] = time.UnixMilli(*out.LogStreams[0].LastEventTimestamp).UTC().Format(time.RFC3339)
+	}
+	return meta
+}
+
+func (s *LogsScanner) listLogGroups(ctx context.Context) ([]logstypes.LogGroup, error) {
+	var groups []logstypes.LogGroup
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(s.client, &cloudwatchlogs.DescribeLogGroupsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, page.LogGroups...)
+	}
+	return groups, nil
+}
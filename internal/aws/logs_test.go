@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+type mockLogsClient struct {
+	groups    []logstypes.LogGroup
+	lastEvent map[string]time.Time
+	err       error
+}
+
+func (m *mockLogsClient) DescribeLogGroups(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: m.groups}, nil
+}
+
+func (m *mockLogsClient) DescribeLogStreams(_ context.Context, input *cloudwatchlogs.DescribeLogStreamsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	ts, ok := m.lastEvent[*input.LogGroupName]
+	if !ok {
+		return &cloudwatchlogs.DescribeLogStreamsOutput{}, nil
+	}
+	return &cloudwatchlogs.DescribeLogStreamsOutput{LogStreams: []logstypes.LogStream{
+		{LastEventTimestamp: awssdk.Int64(ts.UnixMilli())},
+	}}, nil
+}
+
+func logGroup(name string, storedBytes int64, retention *int32, age time.Duration) logstypes.LogGroup {
+	return logstypes.LogGroup{
+		LogGroupName:    awssdk.String(name),
+		LogGroupArn:     awssdk.String("arn:aws:logs:us-east-1:123456789012:log-group:" + name),
+		StoredBytes:     awssdk.Int64(storedBytes),
+		RetentionInDays: retention,
+		CreationTime:    awssdk.Int64(time.Now().Add(-age).UnixMilli()),
+		LogGroupClass:   logstypes.LogGroupClassStandard,
+	}
+}
+
+func TestLogsScanner_Findings(t *testing.T) {
+	day := 24 * time.Hour
+	client := &mockLogsClient{
+		groups: []logstypes.LogGroup{
+			logGroup("/aws/lambda/forever", 50*bytesPerGiB, nil, 400*day),
+			logGroup("/aws/lambda/small", 100*1024*1024, nil, 400*day),
+			logGroup("/aws/lambda/retained", 50*bytesPerGiB, awssdk.Int32(30), 400*day),
+			logGroup("/aws/lambda/empty-old", 0, nil, 200*day),
+			logGroup("/aws/lambda/empty-new", 0, nil, 10*day),
+		},
+		lastEvent: map[string]time.Time{"/aws/lambda/forever": time.Now().Add(-2 * time.Hour)},
+	}
+	scanner := NewLogsScanner(client, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	f := byID["/aws/lambda/forever"]
+	if f.ID != FindingLogsNoRetention {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 50 GiB * $0.03 = $1.50
+	if f.EstimatedMonthlyWaste < 1.49 || f.EstimatedMonthlyWaste > 1.51 {
+		t.Fatalf("expected ~$1.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["stored_bytes"] != int64(50*bytesPerGiB) {
+		t.Fatalf("unexpected stored_bytes: %v", f.Metadata["stored_bytes"])
+	}
+	if _, ok := f.Metadata["last_event_time"]; !ok {
+		t.Fatal("expected last_event_time in metadata")
+	}
+
+	empty := byID["/aws/lambda/empty-old"]
+	if empty.ID != FindingLogsEmptyGroup || !empty.Hygiene || empty.EstimatedMonthlyWaste != 0 {
+		t.Fatalf("unexpected empty-group finding: %+v", empty)
+	}
+}
+
+func TestLogsScanner_Excluded(t *testing.T) {
+	client := &mockLogsClient{groups: []logstypes.LogGroup{
+		logGroup("/app/audit", 50*bytesPerGiB, nil, 400*24*time.Hour),
+	}}
+	scanner := NewLogsScanner(client, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"/app/audit": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestLogsScanner_APIError(t *testing.T) {
+	scanner := NewLogsScanner(&mockLogsClient{err: errors.New("denied")}, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90}); err == nil {
+		t.Fatal("expected error")
+	}
+}
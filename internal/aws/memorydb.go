@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	memorydbtypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// MemoryDBAPI is the minimal interface for MemoryDB operations.
+type MemoryDBAPI interface {
+	DescribeClusters(ctx context.Context, input *memorydb.DescribeClustersInput, opts ...func(*memorydb.Options)) (*memorydb.DescribeClustersOutput, error)
+}
+
+// MemoryDBScanner detects MemoryDB clusters that no client connects to.
+type MemoryDBScanner struct {
+	client  MemoryDBAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewMemoryDBScanner creates a scanner for MemoryDB clusters.
+func NewMemoryDBScanner(client MemoryDBAPI, metrics *MetricsFetcher, region string) *MemoryDBScanner {
+	return &MemoryDBScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *MemoryDBScanner) Type() ResourceType {
+	return ResourceMemoryDB
+}
+
+// Scan examines available clusters for zero new connections across all nodes.
+// CurrConnections is reported but not used as the idle signal: replicas hold
+// persistent replication connections to their primary, so it never drops to zero.
+func (s *MemoryDBScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list MemoryDB clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(clusters)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	for _, c := range clusters {
+		name := deref(c.Name)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(c.ARN), nil) {
+			continue
+		}
+		if deref(c.Status) != "available" {
+			continue
+		}
+
+		nodeNames, created := memoryDBNodes(c)
+		if len(nodeNames) == 0 || created.After(cutoff) {
+			continue
+		}
+
+		clusterDim := []cwtypes.Dimension{{Name: awssdk.String("ClusterName"), Value: awssdk.String(name)}}
+		newConns, err := s.metrics.FetchSumWithStaticDim(ctx, "AWS/MemoryDB", "NewConnections", "NodeName", nodeNames, cfg.IdleDays, clusterDim)
+		if err != nil {
+			slog.Warn("Failed to fetch MemoryDB connection metrics", "cluster", name, "error", err)
+			continue
+		}
+		var totalNew float64
+		for _, v := range newConns {
+			totalNew += v
+		}
+		if totalNew > 0 {
+			continue
+		}
+
+		var avgCurr float64
+		currConns, err := s.metrics.FetchAverageWithStaticDim(ctx, "AWS/MemoryDB", "CurrConnections", "NodeName", nodeNames, cfg.IdleDays, clusterDim)
+		if err != nil {
+			slog.Warn("Failed to fetch MemoryDB current connections", "cluster", name, "error", err)
+		}
+		for _, v := range currConns {
+			avgCurr += v
+		}
+
+		shards := int(derefInt32(c.NumberOfShards))
+		replicasPerShard := 0
+		if len(c.Shards) > 0 {
+			replicasPerShard = max(int(derefInt32(c.Shards[0].NumberOfNodes))-1, 0)
+		}
+		nodeType := deref(c.NodeType)
+		cost := pricing.MonthlyMemoryDBCost(nodeType, len(nodeNames), s.region)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingMemoryDBIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceMemoryDB,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero new connections over %d days across %d nodes", cfg.IdleDays, len(nodeNames)),
+			EstimatedMonthlyWaste: cost,
+			Metadata: map[string]any{
+				"node_type":          nodeType,
+				"shard_count":        shards,
+				"replicas_per_shard": replicasPerShard,
+				"node_count":         len(nodeNames),
+				"curr_connections":   avgCurr,
+				"engine_version":     deref(c.EngineVersion),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// memoryDBNodes returns the cluster's node names and the earliest node creation time.
+func memoryDBNodes(c memorydbtypes.Cluster) ([]string, time.Time) {
+	var names []string
+	var created time.Time
+	for _, shard := range c.Shards {
+		for _, node := range shard.Nodes {
+			names = append(names, deref(node.Name))
+			if t := awssdk.ToTime(node.CreateTime); !t.IsZero() && (created.IsZero() || t.Before(created)) {
+				created = t
+			}
+		}
+	}
+	return names, created
+}
+
+func (s *MemoryDBScanner) listClusters(ctx context.Context) ([]memorydbtypes.Cluster, error) {
+	var clusters []memorydbtypes.Cluster
+	paginator := memorydb.NewDescribeClustersPaginator(s.client, &memorydb.DescribeClustersInput{
+		ShowShardDetails: awssdk.Bool(true),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.Clusters...)
+	}
+	return clusters, nil
+}
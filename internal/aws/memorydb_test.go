@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	memorydbtypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+)
+
+type mockMemoryDBClient struct {
+	clusters []memorydbtypes.Cluster
+}
+
+func (m *mockMemoryDBClient) DescribeClusters(_ context.Context, _ *memorydb.DescribeClustersInput, _ ...func(*memorydb.Options)) (*memorydb.DescribeClustersOutput, error) {
+	return &memorydb.DescribeClustersOutput{Clusters: m.clusters}, nil
+}
+
+// memoryDBCluster builds a cluster with shards × (1 + replicas) nodes named <name>-000N-00M.
+func memoryDBCluster(name string, shards, replicas int, age time.Duration) memorydbtypes.Cluster {
+	c := memorydbtypes.Cluster{
+		Name:           awssdk.String(name),
+		ARN:            awssdk.String("arn:aws:memorydb:us-east-1:123456789012:cluster/" + name),
+		Status:         awssdk.String("available"),
+		NodeType:       awssdk.String("db.r6g.large"),
+		NumberOfShards: awssdk.Int32(int32(shards)),
+	}
+	created := time.Now().Add(-age)
+	for i := 1; i <= shards; i++ {
+		shard := memorydbtypes.Shard{NumberOfNodes: awssdk.Int32(int32(1 + replicas))}
+		for j := 1; j <= 1+replicas; j++ {
+			shard.Nodes = append(shard.Nodes, memorydbtypes.Node{
+				Name:       awssdk.String(name + "-000" + string(rune('0'+i)) + "-00" + string(rune('0'+j))),
+				CreateTime: awssdk.Time(created),
+			})
+		}
+		c.Shards = append(c.Shards, shard)
+	}
+	return c
+}
+
+func TestMemoryDBScanner_IdleCluster(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockMemoryDBClient{
+		clusters: []memorydbtypes.Cluster{
+			memoryDBCluster("idle", 2, 1, 60*day),
+			memoryDBCluster("busy", 1, 0, 60*day),
+			memoryDBCluster("new", 1, 0, 2*day),
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"busy-0001-001": 12})
+
+	scanner := NewMemoryDBScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingMemoryDBIdle || f.ResourceID != "idle" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	// 4 nodes of db.r6g.large: $0.309 * 730 * 4 = $902.28
+	if f.EstimatedMonthlyWaste < 902.27 || f.EstimatedMonthlyWaste > 902.29 {
+		t.Fatalf("expected ~$902.28, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["shard_count"] != 2 || f.Metadata["replicas_per_shard"] != 1 || f.Metadata["node_type"] != "db.r6g.large" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestMemoryDBScanner_Excluded(t *testing.T) {
+	mock := &mockMemoryDBClient{clusters: []memorydbtypes.Cluster{memoryDBCluster("keep", 1, 0, 60*24*time.Hour)}}
+
+	scanner := NewMemoryDBScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"keep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	mqtypes "github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// mqIdleMetrics lists, per engine, the AWS/AmazonMQ metrics that must all be zero
+// for a broker to be idle: client connections and messages stored or published.
+var mqIdleMetrics = map[mqtypes.EngineType][]string{
+	mqtypes.EngineTypeActivemq: {"CurrentConnectionsCount", "TotalMessageCount"},
+	mqtypes.EngineTypeRabbitmq: {"ConnectionCount", "MessageCount", "PublishRate"},
+}
+
+// MQAPI is the minimal interface for Amazon MQ operations.
+type MQAPI interface {
+	ListBrokers(ctx context.Context, input *mq.ListBrokersInput, opts ...func(*mq.Options)) (*mq.ListBrokersOutput, error)
+	DescribeBroker(ctx context.Context, input *mq.DescribeBrokerInput, opts ...func(*mq.Options)) (*mq.DescribeBrokerOutput, error)
+}
+
+// MQScanner detects idle Amazon MQ brokers.
+type MQScanner struct {
+	client  MQAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewMQScanner creates a scanner for Amazon MQ brokers.
+func NewMQScanner(client MQAPI, metrics *MetricsFetcher, region string) *MQScanner {
+	return &MQScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *MQScanner) Type() ResourceType {
+	return ResourceMQ
+}
+
+// Scan examines running brokers for zero connections and zero messages.
+func (s *MQScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	brokers, err := s.listBrokers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list MQ brokers: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(brokers)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	for _, b := range brokers {
+		if b.BrokerState != mqtypes.BrokerStateRunning {
+			continue
+		}
+		if b.Created != nil && b.Created.After(cutoff) {
+			continue
+		}
+		name := deref(b.BrokerName)
+		brokerID := deref(b.BrokerId)
+
+		desc, err := s.client.DescribeBroker(ctx, &mq.DescribeBrokerInput{BrokerId: b.BrokerId})
+		if err != nil {
+			slog.Warn("Failed to describe MQ broker", "broker", name, "error", err)
+			continue
+		}
+		if cfg.Exclude.ShouldExclude(brokerID, desc.Tags) || cfg.Exclude.ShouldExclude(name, desc.Tags) {
+			continue
+		}
+
+		metricNames, ok := mqIdleMetrics[b.EngineType]
+		if !ok {
+			continue
+		}
+		dimIDs := mqBrokerDimensions(name, b.EngineType, b.DeploymentMode)
+
+		idle := true
+		for _, metric := range metricNames {
+			sums, err := s.metrics.FetchSum(ctx, "AWS/AmazonMQ", metric, "Broker", dimIDs, cfg.IdleDays)
+			if err != nil {
+				slog.Warn("Failed to fetch MQ metrics", "broker", name, "metric", metric, "error", err)
+				return result, nil
+			}
+			for _, v := range sums {
+				if v > 0 {
+					idle = false
+				}
+			}
+			if !idle {
+				break
+			}
+		}
+		if !idle {
+			continue
+		}
+
+		instanceType := deref(b.HostInstanceType)
+		nodes := mqBrokerNodes(b.DeploymentMode)
+		cost := pricing.MonthlyMQBrokerCost(instanceType, nodes, s.region)
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingMQIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceMQ,
+			ResourceID:            brokerID,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Broker %q has zero connections and no messages over %d days", name, cfg.IdleDays),
+			EstimatedMonthlyWaste: cost,
+			Metadata: withTags(map[string]any{
+				"engine_type":     string(b.EngineType),
+				"engine_version":  deref(desc.EngineVersion),
+				"deployment_mode": string(b.DeploymentMode),
+				"instance_type":   instanceType,
+				"broker_nodes":    nodes,
+			}, desc.Tags),
+		})
+	}
+
+	return result, nil
+}
+
+// mqBrokerNodes returns the number of billed broker instances for a deployment mode.
+func mqBrokerNodes(mode mqtypes.DeploymentMode) int {
+	switch mode {
+	case mqtypes.DeploymentModeActiveStandbyMultiAz:
+		return 2
+	case mqtypes.DeploymentModeClusterMultiAz:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// mqBrokerDimensions returns the values of the "Broker" metric dimension.
+// ActiveMQ reports per instance with a -1/-2 suffix; RabbitMQ reports per broker.
+func mqBrokerDimensions(name string, engine mqtypes.EngineType, mode mqtypes.DeploymentMode) []string {
+	if engine != mqtypes.EngineTypeActivemq {
+		return []string{name}
+	}
+	if mode == mqtypes.DeploymentModeActiveStandbyMultiAz {
+		return []string{name + "-1", name + "-2"}
+	}
+	return []string{name + "-1"}
+}
+
+func (s *MQScanner) listBrokers(ctx context.Context) ([]mqtypes.BrokerSummary, error) {
+	var brokers []mqtypes.BrokerSummary
+	paginator := mq.NewListBrokersPaginator(s.client, &mq.ListBrokersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		brokers = append(brokers, page.BrokerSummaries...)
+	}
+	return brokers, nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	mqtypes "github.com/aws/aws-sdk-go-v2/service/mq/types"
+)
+
+type mockMQClient struct {
+	brokers []mqtypes.BrokerSummary
+	tags    map[string]map[string]string
+}
+
+func (m *mockMQClient) ListBrokers(_ context.Context, _ *mq.ListBrokersInput, _ ...func(*mq.Options)) (*mq.ListBrokersOutput, error) {
+	return &mq.ListBrokersOutput{BrokerSummaries: m.brokers}, nil
+}
+
+func (m *mockMQClient) DescribeBroker(_ context.Context, input *mq.DescribeBrokerInput, _ ...func(*mq.Options)) (*mq.DescribeBrokerOutput, error) {
+	return &mq.DescribeBrokerOutput{
+		BrokerId:      input.BrokerId,
+		EngineVersion: awssdk.String("5.17.6"),
+		Tags:          m.tags[deref(input.BrokerId)],
+	}, nil
+}
+
+func mqBroker(id, name string, engine mqtypes.EngineType, mode mqtypes.DeploymentMode) mqtypes.BrokerSummary {
+	return mqtypes.BrokerSummary{
+		BrokerId:         awssdk.String(id),
+		BrokerName:       awssdk.String(name),
+		BrokerState:      mqtypes.BrokerStateRunning,
+		EngineType:       engine,
+		DeploymentMode:   mode,
+		HostInstanceType: awssdk.String("mq.m5.large"),
+		Created:          awssdk.Time(time.Now().Add(-60 * 24 * time.Hour)),
+	}
+}
+
+func TestMQScanner_IdleActiveStandby(t *testing.T) {
+	mock := &mockMQClient{
+		brokers: []mqtypes.BrokerSummary{
+			mqBroker("b-idle", "orders", mqtypes.EngineTypeActivemq, mqtypes.DeploymentModeActiveStandbyMultiAz),
+			mqBroker("b-busy", "events", mqtypes.EngineTypeRabbitmq, mqtypes.DeploymentModeSingleInstance),
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"events": 42})
+
+	scanner := NewMQScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 2 {
+		t.Fatalf("expected 2 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingMQIdle || f.ResourceName != "orders" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceName)
+	}
+	// mq.m5.large: $0.288 * 730 * 2 nodes = $420.48
+	if f.EstimatedMonthlyWaste < 420.47 || f.EstimatedMonthlyWaste > 420.49 {
+		t.Fatalf("expected ~$420.48, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["engine_type"] != "ACTIVEMQ" || f.Metadata["deployment_mode"] != "ACTIVE_STANDBY_MULTI_AZ" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestMQScanner_SkipsNewAndStopped(t *testing.T) {
+	newBroker := mqBroker("b-new", "fresh", mqtypes.EngineTypeRabbitmq, mqtypes.DeploymentModeSingleInstance)
+	newBroker.Created = awssdk.Time(time.Now().Add(-2 * 24 * time.Hour))
+	stopped := mqBroker("b-reboot", "rebooting", mqtypes.EngineTypeRabbitmq, mqtypes.DeploymentModeSingleInstance)
+	stopped.BrokerState = mqtypes.BrokerStateRebootInProgress
+
+	mock := &mockMQClient{brokers: []mqtypes.BrokerSummary{newBroker, stopped}}
+	scanner := NewMQScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestMQScanner_ExcludedByTag(t *testing.T) {
+	mock := &mockMQClient{
+		brokers: []mqtypes.BrokerSummary{
+			mqBroker("b-keep", "keep", mqtypes.EngineTypeRabbitmq, mqtypes.DeploymentModeClusterMultiAz),
+		},
+		tags: map[string]map[string]string{"b-keep": {"env": "dr"}},
+	}
+
+	scanner := NewMQScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"env": "dr"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestMQBrokerDimensions(t *testing.T) {
+	got := mqBrokerDimensions("orders", mqtypes.EngineTypeActivemq, mqtypes.DeploymentModeActiveStandbyMultiAz)
+	if len(got) != 2 || got[0] != "orders-1" || got[1] != "orders-2" {
+		t.Fatalf("unexpected ActiveMQ dimensions: %v", got)
+	}
+	got = mqBrokerDimensions("events", mqtypes.EngineTypeRabbitmq, mqtypes.DeploymentModeClusterMultiAz)
+	if len(got) != 1 || got[0] != "events" {
+		t.Fatalf("unexpected RabbitMQ dimensions: %v", got)
+	}
+}
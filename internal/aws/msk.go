@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	kafkatypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	mskNamespace      = "AWS/Kafka"
+	mskClusterNameDim = "Cluster Name"
+)
+
+// MSKAPI is the minimal interface for MSK operations.
+type MSKAPI interface {
+	ListClustersV2(ctx context.Context, input *kafka.ListClustersV2Input, opts ...func(*kafka.Options)) (*kafka.ListClustersV2Output, error)
+	ListTopics(ctx context.Context, input *kafka.ListTopicsInput, opts ...func(*kafka.Options)) (*kafka.ListTopicsOutput, error)
+}
+
+// MSKScanner detects idle provisioned and serverless MSK clusters.
+type MSKScanner struct {
+	client  MSKAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewMSKScanner creates a scanner for MSK clusters.
+func NewMSKScanner(client MSKAPI, metrics *MetricsFetcher, region string) *MSKScanner {
+	return &MSKScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *MSKScanner) Type() ResourceType {
+	return ResourceMSK
+}
+
+// Scan examines active MSK clusters for zero throughput over the idle window.
+func (s *MSKScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list MSK clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(clusters)}
+
+	for _, c := range clusters {
+		arn := deref(c.ClusterArn)
+		if cfg.Exclude.ShouldExclude(arn, c.Tags) || cfg.Exclude.ShouldExclude(deref(c.ClusterName), nil) {
+			continue
+		}
+		if c.State != kafkatypes.ClusterStateActive {
+			continue
+		}
+
+		var (
+			f   Finding
+			ok  bool
+			err error
+		)
+		switch c.ClusterType {
+		case kafkatypes.ClusterTypeProvisioned:
+			f, ok, err = s.provisionedFinding(ctx, c, cfg.IdleDays)
+		case kafkatypes.ClusterTypeServerless:
+			f, ok, err = s.serverlessFinding(ctx, c, cfg.IdleDays)
+		}
+		if err != nil {
+			slog.Warn("Failed to evaluate MSK cluster", "cluster", deref(c.ClusterName), "error", err)
+			continue
+		}
+		if ok {
+			result.Findings = append(result.Findings, f)
+		}
+	}
+
+	return result, nil
+}
+
+// provisionedFinding sums per-broker throughput; broker metrics carry Cluster Name and Broker ID.
+func (s *MSKScanner) provisionedFinding(ctx context.Context, c kafkatypes.Cluster, idleDays int) (Finding, bool, error) {
+	p := c.Provisioned
+	if p == nil {
+		return Finding{}, false, nil
+	}
+	name := deref(c.ClusterName)
+	brokers := int(derefInt32(p.NumberOfBrokerNodes))
+	brokerIDs := make([]string, 0, brokers)
+	for i := 1; i <= brokers; i++ {
+		brokerIDs = append(brokerIDs, strconv.Itoa(i))
+	}
+
+	bytes, err := s.sumThroughput(ctx, name, "Broker ID", brokerIDs, idleDays)
+	if err != nil || bytes > 0 {
+		return Finding{}, false, err
+	}
+
+	var instanceType string
+	var storageGiB int
+	if p.BrokerNodeGroupInfo != nil {
+		instanceType = deref(p.BrokerNodeGroupInfo.InstanceType)
+		if si := p.BrokerNodeGroupInfo.StorageInfo; si != nil && si.EbsStorageInfo != nil {
+			storageGiB = int(derefInt32(si.EbsStorageInfo.VolumeSize))
+		}
+	}
+
+	return Finding{
+		ID:                    FindingMSKIdle,
+		Severity:              SeverityHigh,
+		ResourceType:          ResourceMSK,
+		ResourceID:            deref(c.ClusterArn),
+		ResourceName:          name,
+		Region:                s.region,
+		Message:               fmt.Sprintf("Zero bytes in/out over %d days (%d x %s brokers)", idleDays, brokers, instanceType),
+		EstimatedMonthlyWaste: pricing.MonthlyMSKCost(instanceType, brokers, storageGiB, s.region),
+		Metadata: withTags(map[string]any{
+			"cluster_type":           string(c.ClusterType),
+			"broker_count":           brokers,
+			"instance_type":          instanceType,
+			"storage_gib_per_broker": storageGiB,
+		}, c.Tags),
+	}, true, nil
+}
+
+// serverlessFinding flags a serverless cluster only when it has no partitions or its
+// topics carried no traffic; serverless metrics are published per topic.
+func (s *MSKScanner) serverlessFinding(ctx context.Context, c kafkatypes.Cluster, idleDays int) (Finding, bool, error) {
+	topics, err := s.listTopics(ctx, deref(c.ClusterArn))
+	if err != nil {
+		return Finding{}, false, err
+	}
+
+	name := deref(c.ClusterName)
+	var topicNames []string
+	partitions := 0
+	for _, t := range topics {
+		topicNames = append(topicNames, deref(t.TopicName))
+		partitions += int(derefInt32(t.PartitionCount))
+	}
+
+	if len(topicNames) > 0 {
+		bytes, err := s.sumThroughput(ctx, name, "Topic", topicNames, idleDays)
+		if err != nil || bytes > 0 {
+			return Finding{}, false, err
+		}
+	}
+
+	return Finding{
+		ID:                    FindingMSKIdle,
+		Severity:              SeverityHigh,
+		ResourceType:          ResourceMSK,
+		ResourceID:            deref(c.ClusterArn),
+		ResourceName:          name,
+		Region:                s.region,
+		Message:               fmt.Sprintf("Serverless cluster with %d topics and zero bytes in/out over %d days", len(topicNames), idleDays),
+		EstimatedMonthlyWaste: pricing.MonthlyMSKServerlessCost(partitions, s.region),
+		Metadata: withTags(map[string]any{
+			"cluster_type":    string(c.ClusterType),
+			"broker_count":    0,
+			"topic_count":     len(topicNames),
+			"partition_count": partitions,
+		}, c.Tags),
+	}, true, nil
+}
+
+// sumThroughput returns total BytesInPerSec + BytesOutPerSec datapoints across ids.
+func (s *MSKScanner) sumThroughput(ctx context.Context, clusterName, dim string, ids []string, idleDays int) (float64, error) {
+	staticDims := []cwtypes.Dimension{{Name: awssdk.String(mskClusterNameDim), Value: awssdk.String(clusterName)}}
+	var total float64
+	for _, metric := range []string{"BytesInPerSec", "BytesOutPerSec"} {
+		sums, err := s.metrics.FetchSumWithStaticDim(ctx, mskNamespace, metric, dim, ids, idleDays, staticDims)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range sums {
+			total += v
+		}
+	}
+	return total, nil
+}
+
+func (s *MSKScanner) listClusters(ctx context.Context) ([]kafkatypes.Cluster, error) {
+	var clusters []kafkatypes.Cluster
+	paginator := kafka.NewListClustersV2Paginator(s.client, &kafka.ListClustersV2Input{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.ClusterInfoList...)
+	}
+	return clusters, nil
+}
+
+func (s *MSKScanner) listTopics(ctx context.Context, clusterARN string) ([]kafkatypes.TopicInfo, error) {
+	var topics []kafkatypes.TopicInfo
+	paginator := kafka.NewListTopicsPaginator(s.client, &kafka.ListTopicsInput{
+		ClusterArn: awssdk.String(clusterARN),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, page.Topics...)
+	}
+	return topics, nil
+}
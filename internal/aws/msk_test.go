@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	kafkatypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+)
+
+type mockMSKClient struct {
+	clusters []kafkatypes.Cluster
+	topics   map[string][]kafkatypes.TopicInfo
+}
+
+func (m *mockMSKClient) ListClustersV2(_ context.Context, _ *kafka.ListClustersV2Input, _ ...func(*kafka.Options)) (*kafka.ListClustersV2Output, error) {
+	return &kafka.ListClustersV2Output{ClusterInfoList: m.clusters}, nil
+}
+
+func (m *mockMSKClient) ListTopics(_ context.Context, input *kafka.ListTopicsInput, _ ...func(*kafka.Options)) (*kafka.ListTopicsOutput, error) {
+	return &kafka.ListTopicsOutput{Topics: m.topics[*input.ClusterArn]}, nil
+}
+
+func mskProvisionedCluster(name string, brokers int32) kafkatypes.Cluster {
+	return kafkatypes.Cluster{
+		ClusterArn:  awssdk.String("arn:aws:kafka:us-east-1:123456789012:cluster/" + name),
+		ClusterName: awssdk.String(name),
+		ClusterType: kafkatypes.ClusterTypeProvisioned,
+		State:       kafkatypes.ClusterStateActive,
+		Provisioned: &kafkatypes.Provisioned{
+			NumberOfBrokerNodes: awssdk.Int32(brokers),
+			BrokerNodeGroupInfo: &kafkatypes.BrokerNodeGroupInfo{
+				InstanceType: awssdk.String("kafka.m5.large"),
+				StorageInfo: &kafkatypes.StorageInfo{
+					EbsStorageInfo: &kafkatypes.EBSStorageInfo{VolumeSize: awssdk.Int32(100)},
+				},
+			},
+		},
+	}
+}
+
+func mskServerlessCluster(name string) kafkatypes.Cluster {
+	return kafkatypes.Cluster{
+		ClusterArn:  awssdk.String("arn:aws:kafka:us-east-1:123456789012:cluster/" + name),
+		ClusterName: awssdk.String(name),
+		ClusterType: kafkatypes.ClusterTypeServerless,
+		State:       kafkatypes.ClusterStateActive,
+		Serverless:  &kafkatypes.Serverless{},
+	}
+}
+
+func TestMSKScanner_IdleProvisioned(t *testing.T) {
+	mock := &mockMSKClient{clusters: []kafkatypes.Cluster{mskProvisionedCluster("events", 3)}}
+	scanner := NewMSKScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 1 {
+		t.Fatalf("expected 1 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingMSKIdle {
+		t.Fatalf("expected MSK_IDLE, got %s", f.ID)
+	}
+	// 3 * ($0.21 * 730 + 100 GiB * $0.10) = 3 * $163.30 = $489.90
+	if f.EstimatedMonthlyWaste < 489.8 || f.EstimatedMonthlyWaste > 490 {
+		t.Fatalf("expected ~$489.90, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["broker_count"] != 3 || f.Metadata["instance_type"] != "kafka.m5.large" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestMSKScanner_ActiveProvisioned(t *testing.T) {
+	mock := &mockMSKClient{clusters: []kafkatypes.Cluster{mskProvisionedCluster("events", 3)}}
+	metrics := newMockMetricsFetcher(map[string]float64{"2": 1024})
+	scanner := NewMSKScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for cluster with traffic, got %d", len(result.Findings))
+	}
+}
+
+func TestMSKScanner_ServerlessNoTopics(t *testing.T) {
+	mock := &mockMSKClient{clusters: []kafkatypes.Cluster{mskServerlessCluster("serverless")}}
+	scanner := NewMSKScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	// $0.75 * 730 = $547.50
+	if w := result.Findings[0].EstimatedMonthlyWaste; w < 547.4 || w > 547.6 {
+		t.Fatalf("expected ~$547.50, got $%.2f", w)
+	}
+}
+
+func TestMSKScanner_ServerlessActiveTopic(t *testing.T) {
+	c := mskServerlessCluster("serverless")
+	mock := &mockMSKClient{
+		clusters: []kafkatypes.Cluster{c},
+		topics: map[string][]kafkatypes.TopicInfo{
+			*c.ClusterArn: {{TopicName: awssdk.String("orders"), PartitionCount: awssdk.Int32(6)}},
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"orders": 2048})
+	scanner := NewMSKScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for serverless cluster with topic traffic, got %d", len(result.Findings))
+	}
+}
+
+func TestMSKScanner_ExcludedByTag(t *testing.T) {
+	c := mskProvisionedCluster("events", 3)
+	c.Tags = map[string]string{"env": "prod"}
+	mock := &mockMSKClient{clusters: []kafkatypes.Cluster{c}}
+	scanner := NewMSKScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{Tags: map[string]string{"env": "prod"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded cluster to be skipped, got %d findings", len(result.Findings))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -14,6 +15,15 @@ import (
 // NATGatewayAPI is the minimal interface for NAT Gateway operations.
 type NATGatewayAPI interface {
 	DescribeNatGateways(ctx context.Context, input *ec2.DescribeNatGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error)
+	DescribeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+}
+
+// lowTrafficNAT is a low-traffic gateway held back until its VPC's gateways are
+// all known, so that several in one VPC can be reported as a consolidation.
+type lowTrafficNAT struct {
+	gw        ec2types.NatGateway
+	monthlyGB float64
+	finding   Finding
 }
 
 // NATGatewayScanner detects NAT Gateways with zero bytes processed.
@@ -75,6 +85,8 @@ func (s *NATGatewayScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResu
 		bytesIn = make(map[string]float64)
 	}
 
+	lowTrafficByVPC := make(map[string][]lowTrafficNAT)
+	var vpcOrder []string
 	for _, id := range ids {
 		totalOut := bytesOut[id]
 		totalIn := bytesIn[id]
@@ -105,13 +117,15 @@ func (s *NATGatewayScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResu
 		}
 
 		// Low-traffic check: extrapolate to monthly and compare to threshold
-		monthlyBytes := totalBytes * (30.0 / float64(cfg.IdleDays))
-		monthlyGB := monthlyBytes / (1024 * 1024 * 1024)
+		scale := 30.0 / float64(cfg.IdleDays) / (1024 * 1024 * 1024)
+		monthlyGB := totalBytes * scale
 
 		if cfg.NATGWLowTrafficGB > 0 && monthlyGB < cfg.NATGWLowTrafficGB {
-			gatewayCost := pricing.MonthlyNATGatewayCost(s.region)
-			dataCost := monthlyGB * pricing.NATGatewayDataCostPerGB(s.region)
-			totalCost := gatewayCost + dataCost
+			// Bytes sent to destinations leave through the internet gateway and are
+			// billed as egress on top of processing; the reply traffic is free inbound.
+			egressGB := totalOut * scale
+			cost := pricing.EstimateNATGatewayCost(s.region, monthlyGB, egressGB)
+			totalCost := cost.Total()
 
 			meta := map[string]any{
 				"subnet_id":            deref(gw.SubnetId),
@@ -121,27 +135,138 @@ func (s *NATGatewayScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResu
 				"bytes_out":            totalOut,
 				"total_bytes":          totalBytes,
 				"estimated_monthly_gb": monthlyGB,
-				"gateway_monthly_cost": gatewayCost,
-				"data_processing_cost": dataCost,
+				"estimated_egress_gb":  egressGB,
+				"gateway_monthly_cost": cost.Gateway,
+				"data_processing_cost": cost.Processing,
+				"gateway_cost":         cost.Gateway,
+				"processing_cost":      cost.Processing,
+				"egress_cost":          cost.Egress,
 			}
 
-			result.Findings = append(result.Findings, Finding{
+			finding := Finding{
 				ID:                    FindingLowTrafficNATGateway,
 				Severity:              SeverityMedium,
 				ResourceType:          ResourceNATGateway,
 				ResourceID:            id,
 				ResourceName:          name,
 				Region:                s.region,
-				Message:               fmt.Sprintf("NAT Gateway %q processed %.2f GB/month (est.) — $%.2f gateway + $%.2f data = $%.2f/month", name, monthlyGB, gatewayCost, dataCost, totalCost),
+				Message:               fmt.Sprintf("NAT Gateway %q processed %.2f GB/month (est.) — $%.2f gateway + $%.2f processing + $%.2f egress = $%.2f/month", name, monthlyGB, cost.Gateway, cost.Processing, cost.Egress, totalCost),
 				EstimatedMonthlyWaste: totalCost,
 				Metadata:              meta,
-			})
+			}
+			if cfg.CostRanges {
+				// The hourly gateway rate is fixed; only the extrapolated data volume is uncertain.
+				dataCost := cost.Processing + cost.Egress
+				finding.EstimatedMonthlyWasteLow = cost.Gateway*0.95 + dataCost*0.8
+				finding.EstimatedMonthlyWasteHigh = cost.Gateway*1.05 + dataCost*1.2
+			}
+			vpcID := deref(gw.VpcId)
+			if _, seen := lowTrafficByVPC[vpcID]; !seen {
+				vpcOrder = append(vpcOrder, vpcID)
+			}
+			lowTrafficByVPC[vpcID] = append(lowTrafficByVPC[vpcID], lowTrafficNAT{gw: gw, monthlyGB: monthlyGB, finding: finding})
+		}
+	}
+
+	for _, vpcID := range vpcOrder {
+		gateways := lowTrafficByVPC[vpcID]
+		if len(gateways) == 1 {
+			result.Findings = append(result.Findings, gateways[0].finding)
+			continue
 		}
+		result.Findings = append(result.Findings, s.consolidationFindings(ctx, vpcID, gateways)...)
 	}
 
 	return result, nil
 }
 
+// consolidationFindings handles a VPC with several low-traffic gateways. The busiest
+// keeps its LOW_TRAFFIC_NAT_GATEWAY finding; each of the others gets a
+// NAT_GATEWAY_CONSOLIDATION finding instead, since routing its traffic through the
+// kept gateway saves the hourly charge but not the data processing. Traffic from
+// another AZ then pays inter-AZ transfer in both directions, which is subtracted.
+func (s *NATGatewayScanner) consolidationFindings(ctx context.Context, vpcID string, gateways []lowTrafficNAT) []Finding {
+	sort.SliceStable(gateways, func(i, j int) bool { return gateways[i].monthlyGB > gateways[j].monthlyGB })
+	kept := gateways[0]
+
+	subnetAZ := s.subnetZones(ctx, gateways)
+	trafficByAZ := make(map[string]float64)
+	ids := make([]string, 0, len(gateways))
+	for _, g := range gateways {
+		trafficByAZ[subnetAZ[deref(g.gw.SubnetId)]] += g.monthlyGB
+		ids = append(ids, deref(g.gw.NatGatewayId))
+	}
+
+	findings := []Finding{kept.finding}
+	keptID := deref(kept.gw.NatGatewayId)
+	keptAZ := subnetAZ[deref(kept.gw.SubnetId)]
+	gatewayCost := pricing.MonthlyNATGatewayCost(s.region)
+	for _, g := range gateways[1:] {
+		id := deref(g.gw.NatGatewayId)
+		az := subnetAZ[deref(g.gw.SubnetId)]
+		var transferCost float64
+		if az == "" || az != keptAZ {
+			transferCost = g.monthlyGB * 2 * pricing.InterAZTransferCostPerGB(s.region)
+		}
+		savings := gatewayCost - transferCost
+		if savings <= 0 {
+			findings = append(findings, g.finding)
+			continue
+		}
+
+		peers := make([]string, 0, len(ids)-1)
+		for _, peer := range ids {
+			if peer != id {
+				peers = append(peers, peer)
+			}
+		}
+		meta := map[string]any{
+			"vpc_id":                 vpcID,
+			"subnet_id":              deref(g.gw.SubnetId),
+			"availability_zone":      az,
+			"consolidate_into":       keptID,
+			"peer_gateway_ids":       peers,
+			"traffic_gb_by_az":       trafficByAZ,
+			"estimated_monthly_gb":   g.monthlyGB,
+			"gateway_monthly_cost":   gatewayCost,
+			"inter_az_transfer_cost": transferCost,
+		}
+		setTagsMetadata(meta, ec2TagsToMap(g.gw.Tags))
+
+		findings = append(findings, Finding{
+			ID:                    FindingNATGatewayConsolidation,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceNATGateway,
+			ResourceID:            id,
+			ResourceName:          natGatewayName(g.gw),
+			Region:                s.region,
+			Message:               fmt.Sprintf("%d low-traffic NAT Gateways in %s, route %.2f GB/month through %s instead", len(gateways), vpcID, g.monthlyGB, keptID),
+			EstimatedMonthlyWaste: savings,
+			Metadata:              meta,
+		})
+	}
+	return findings
+}
+
+// subnetZones maps the gateways' subnets to their availability zones. On failure
+// the map is empty and every gateway is treated as being in a different AZ.
+func (s *NATGatewayScanner) subnetZones(ctx context.Context, gateways []lowTrafficNAT) map[string]string {
+	subnetIDs := make([]string, 0, len(gateways))
+	for _, g := range gateways {
+		subnetIDs = append(subnetIDs, deref(g.gw.SubnetId))
+	}
+	zones := make(map[string]string, len(subnetIDs))
+	out, err := s.client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+	if err != nil {
+		slog.Warn("Failed to describe NAT Gateway subnets", "region", s.region, "error", err)
+		return zones
+	}
+	for _, subnet := range out.Subnets {
+		zones[deref(subnet.SubnetId)] = deref(subnet.AvailabilityZone)
+	}
+	return zones
+}
+
 func (s *NATGatewayScanner) listNATGateways(ctx context.Context) ([]ec2types.NatGateway, error) {
 	var gateways []ec2types.NatGateway
 	paginator := ec2.NewDescribeNatGatewaysPaginator(s.client, &ec2.DescribeNatGatewaysInput{
@@ -10,10 +10,22 @@ import (
 	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 type mockNATGatewayClient struct {
-	gateways []ec2types.NatGateway
+	gateways   []ec2types.NatGateway
+	subnetZone map[string]string // subnet ID -> availability zone
+}
+
+func (m *mockNATGatewayClient) DescribeSubnets(_ context.Context, input *ec2.DescribeSubnetsInput, _ ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	var subnets []ec2types.Subnet
+	for _, id := range input.SubnetIds {
+		if az, ok := m.subnetZone[id]; ok {
+			subnets = append(subnets, ec2types.Subnet{SubnetId: awssdk.String(id), AvailabilityZone: awssdk.String(az)})
+		}
+	}
+	return &ec2.DescribeSubnetsOutput{Subnets: subnets}, nil
 }
 
 func (m *mockNATGatewayClient) DescribeNatGateways(_ context.Context, _ *ec2.DescribeNatGatewaysInput, _ ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
@@ -223,6 +235,129 @@ func TestNATGatewayScanner_LowTraffic(t *testing.T) {
 	}
 }
 
+func TestNATGatewayScanner_LowTrafficCostBreakdown(t *testing.T) {
+	mock := &mockNATGatewayClient{
+		gateways: []ec2types.NatGateway{
+			{NatGatewayId: awssdk.String("nat-egress001"), State: ec2types.NatGatewayStateAvailable},
+		},
+	}
+
+	// Over 30 days: 0.6 GB out to destinations, 0.2 GB of replies in.
+	gib := float64(1024 * 1024 * 1024)
+	mockCW := &mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			results := make([]cwtypes.MetricDataResult, 0, len(input.MetricDataQueries))
+			for i, q := range input.MetricDataQueries {
+				value := 0.2 * gib
+				if awssdk.ToString(q.MetricStat.Metric.MetricName) == "BytesOutToDestination" {
+					value = 0.6 * gib
+				}
+				results = append(results, cwtypes.MetricDataResult{
+					Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+					Values: []float64{value},
+				})
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	}
+	scanner := NewNATGatewayScanner(mock, NewMetricsFetcher(mockCW), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30, NATGWLowTrafficGB: 1.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	want := pricing.EstimateNATGatewayCost("us-east-1", 0.8, 0.6)
+	for key, value := range map[string]float64{
+		"gateway_cost":    want.Gateway,
+		"processing_cost": want.Processing,
+		"egress_cost":     want.Egress,
+	} {
+		got, _ := f.Metadata[key].(float64)
+		if got < value-0.0001 || got > value+0.0001 {
+			t.Fatalf("expected %s $%.4f, got %v", key, value, f.Metadata[key])
+		}
+	}
+	if want.Egress == 0 {
+		t.Fatal("expected a non-zero egress cost")
+	}
+	if f.EstimatedMonthlyWaste < want.Total()-0.0001 || f.EstimatedMonthlyWaste > want.Total()+0.0001 {
+		t.Fatalf("expected waste to be the total $%.2f, got $%.2f", want.Total(), f.EstimatedMonthlyWaste)
+	}
+}
+
+func TestNATGatewayScanner_Consolidation(t *testing.T) {
+	gateway := func(id, subnet, vpc string) ec2types.NatGateway {
+		return ec2types.NatGateway{
+			NatGatewayId: awssdk.String(id),
+			SubnetId:     awssdk.String(subnet),
+			VpcId:        awssdk.String(vpc),
+			State:        ec2types.NatGatewayStateAvailable,
+		}
+	}
+	mock := &mockNATGatewayClient{
+		gateways: []ec2types.NatGateway{
+			gateway("nat-a", "subnet-a", "vpc-shared"),
+			gateway("nat-b", "subnet-b", "vpc-shared"),
+			gateway("nat-c", "subnet-c", "vpc-shared"),
+			gateway("nat-solo", "subnet-solo", "vpc-other"),
+		},
+		subnetZone: map[string]string{
+			"subnet-a": "us-east-1a",
+			"subnet-b": "us-east-1b",
+			"subnet-c": "us-east-1a",
+		},
+	}
+	gib := float64(1024 * 1024 * 1024)
+	// Over 7 days: 3.5 GiB, 7 GiB, 0.7 GiB, and 1.4 GiB out, i.e. 15, 30, 3, and 6 GB/month.
+	metrics := newMetricsByName(map[string]float64{
+		"BytesOutToDestination/nat-a":    3.5 * gib,
+		"BytesOutToDestination/nat-b":    7 * gib,
+		"BytesOutToDestination/nat-c":    0.7 * gib,
+		"BytesOutToDestination/nat-solo": 1.4 * gib,
+	})
+
+	scanner := NewNATGatewayScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, NATGWLowTrafficGB: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 4 {
+		t.Fatalf("expected 4 findings, got %d: %v", len(byID), result.Findings)
+	}
+	if byID["nat-b"].ID != FindingLowTrafficNATGateway || byID["nat-solo"].ID != FindingLowTrafficNATGateway {
+		t.Fatalf("expected the busiest gateway and a lone gateway to stay LOW_TRAFFIC_NAT_GATEWAY, got %s and %s", byID["nat-b"].ID, byID["nat-solo"].ID)
+	}
+
+	gatewayCost := pricing.MonthlyNATGatewayCost("us-east-1")
+	a := byID["nat-a"]
+	if a.ID != FindingNATGatewayConsolidation || a.Metadata["consolidate_into"] != "nat-b" {
+		t.Fatalf("expected nat-a consolidated into nat-b, got %s %v", a.ID, a.Metadata["consolidate_into"])
+	}
+	// nat-a is in another AZ: 15 GB/month crossing both ways at $0.01/GB = $0.30
+	if want := gatewayCost - 0.30; a.EstimatedMonthlyWaste < want-0.01 || a.EstimatedMonthlyWaste > want+0.01 {
+		t.Fatalf("expected ~$%.2f, got $%.2f", want, a.EstimatedMonthlyWaste)
+	}
+	peers, _ := a.Metadata["peer_gateway_ids"].([]string)
+	if len(peers) != 2 {
+		t.Fatalf("expected 2 peer gateways, got %v", a.Metadata["peer_gateway_ids"])
+	}
+	byAZ, _ := a.Metadata["traffic_gb_by_az"].(map[string]float64)
+	if got := byAZ["us-east-1a"]; got < 17.99 || got > 18.01 {
+		t.Fatalf("expected ~18 GB/month in us-east-1a, got %v", byAZ)
+	}
+
+	if byID["nat-c"].ID != FindingNATGatewayConsolidation {
+		t.Fatalf("expected nat-c consolidated, got %s", byID["nat-c"].ID)
+	}
+}
+
 func TestNATGatewayScanner_AboveThreshold(t *testing.T) {
 	mock := &mockNATGatewayClient{
 		gateways: []ec2types.NatGateway{
@@ -283,3 +418,34 @@ func TestNATGatewayName(t *testing.T) {
 		t.Fatalf("expected empty string, got %s", name)
 	}
 }
+
+func TestNATGatewayScanner_LowTrafficCostRange(t *testing.T) {
+	mock := &mockNATGatewayClient{
+		gateways: []ec2types.NatGateway{
+			{
+				NatGatewayId: awssdk.String("nat-low001"),
+				State:        ec2types.NatGatewayStateAvailable,
+			},
+		},
+	}
+
+	// ~100 MB over 7 days: non-zero but under the 1 GB/month threshold
+	metrics := newMockMetricsFetcher(map[string]float64{"nat-low001": 100 * 1024 * 1024})
+	scanner := NewNATGatewayScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, NATGWLowTrafficGB: 1, CostRanges: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingLowTrafficNATGateway {
+		t.Fatalf("expected LOW_TRAFFIC_NAT_GATEWAY, got %s", f.ID)
+	}
+	if f.EstimatedMonthlyWasteLow >= f.EstimatedMonthlyWaste || f.EstimatedMonthlyWasteHigh <= f.EstimatedMonthlyWaste {
+		t.Fatalf("expected range to bracket $%.2f, got $%.2f–$%.2f", f.EstimatedMonthlyWaste, f.EstimatedMonthlyWasteLow, f.EstimatedMonthlyWasteHigh)
+	}
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	neptunetypes "github.com/aws/aws-sdk-go-v2/service/neptune/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// NeptuneAPI is the minimal interface for Neptune operations.
+type NeptuneAPI interface {
+	DescribeDBInstances(ctx context.Context, input *neptune.DescribeDBInstancesInput, opts ...func(*neptune.Options)) (*neptune.DescribeDBInstancesOutput, error)
+	ListTagsForResource(ctx context.Context, input *neptune.ListTagsForResourceInput, opts ...func(*neptune.Options)) (*neptune.ListTagsForResourceOutput, error)
+}
+
+// NeptuneScanner detects idle Neptune instances.
+type NeptuneScanner struct {
+	client  NeptuneAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewNeptuneScanner creates a scanner for Neptune instances.
+func NewNeptuneScanner(client NeptuneAPI, metrics *MetricsFetcher, region string) *NeptuneScanner {
+	return &NeptuneScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *NeptuneScanner) Type() ResourceType {
+	return ResourceNeptune
+}
+
+// Scan examines available Neptune instances for zero query traffic and low CPU.
+func (s *NeptuneScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	instances, err := s.listInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Neptune instances: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(instances)}
+	if len(instances) == 0 {
+		return result, nil
+	}
+
+	var ids []string
+	instMap := make(map[string]neptunetypes.DBInstance, len(instances))
+	tagsByID := make(map[string]map[string]string, len(instances))
+	for _, inst := range instances {
+		id := deref(inst.DBInstanceIdentifier)
+		if cfg.Exclude.ShouldExclude(id, nil) {
+			continue
+		}
+		if deref(inst.DBInstanceStatus) != "available" {
+			continue
+		}
+		tags, err := s.instanceTags(ctx, deref(inst.DBInstanceArn))
+		if err != nil {
+			slog.Debug("Failed to list Neptune instance tags", "instance", id, "error", err)
+		}
+		if cfg.Exclude.ShouldExclude(id, tags) {
+			continue
+		}
+		ids = append(ids, id)
+		instMap[id] = inst
+		tagsByID[id] = tags
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	cpuMap, err := s.metrics.FetchAverage(ctx, "AWS/Neptune", "CPUUtilization", "DBInstanceIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch Neptune CPU metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	requests := make(map[string]float64, len(ids))
+	for _, metric := range []string{"GremlinRequestsPerSec", "SparqlRequestsPerSec"} {
+		sums, err := s.metrics.FetchSum(ctx, "AWS/Neptune", metric, "DBInstanceIdentifier", ids, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch Neptune request metrics", "region", s.region, "metric", metric, "error", err)
+			return result, nil
+		}
+		for id, v := range sums {
+			requests[id] += v
+		}
+	}
+
+	for _, id := range ids {
+		avgCPU, hasCPU := cpuMap[id]
+		if !hasCPU || avgCPU >= cfg.IdleCPUFor(ResourceNeptune) || requests[id] > 0 {
+			continue
+		}
+
+		inst := instMap[id]
+		instanceClass := deref(inst.DBInstanceClass)
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingNeptuneIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceNeptune,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero Gremlin/SPARQL requests over %d days, CPU %.1f%%", cfg.IdleDays, avgCPU),
+			EstimatedMonthlyWaste: pricing.MonthlyNeptuneCost(instanceClass, s.region),
+			Metadata: withTags(map[string]any{
+				"db_cluster_identifier": deref(inst.DBClusterIdentifier),
+				"instance_class":        instanceClass,
+				"engine_version":        deref(inst.EngineVersion),
+				"avg_cpu_percent":       avgCPU,
+			}, tagsByID[id]),
+		})
+	}
+
+	return result, nil
+}
+
+func (s *NeptuneScanner) listInstances(ctx context.Context) ([]neptunetypes.DBInstance, error) {
+	var instances []neptunetypes.DBInstance
+	paginator := neptune.NewDescribeDBInstancesPaginator(s.client, &neptune.DescribeDBInstancesInput{
+		// The Neptune API shares the RDS control plane; without this filter it returns RDS instances too.
+		Filters: []neptunetypes.Filter{{Name: awssdk.String("engine"), Values: []string{"neptune"}}},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, page.DBInstances...)
+	}
+	return instances, nil
+}
+
+func (s *NeptuneScanner) instanceTags(ctx context.Context, arn string) (map[string]string, error) {
+	if arn == "" {
+		return nil, nil
+	}
+	out, err := s.client.ListTagsForResource(ctx, &neptune.ListTagsForResourceInput{
+		ResourceName: awssdk.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(out.TagList))
+	for _, t := range out.TagList {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m, nil
+}
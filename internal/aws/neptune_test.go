@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	neptunetypes "github.com/aws/aws-sdk-go-v2/service/neptune/types"
+)
+
+type mockNeptuneClient struct {
+	instances []neptunetypes.DBInstance
+}
+
+func (m *mockNeptuneClient) DescribeDBInstances(_ context.Context, _ *neptune.DescribeDBInstancesInput, _ ...func(*neptune.Options)) (*neptune.DescribeDBInstancesOutput, error) {
+	return &neptune.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
+}
+
+func (m *mockNeptuneClient) ListTagsForResource(_ context.Context, _ *neptune.ListTagsForResourceInput, _ ...func(*neptune.Options)) (*neptune.ListTagsForResourceOutput, error) {
+	return &neptune.ListTagsForResourceOutput{}, nil
+}
+
+func neptuneInstance(id string) neptunetypes.DBInstance {
+	return neptunetypes.DBInstance{
+		DBInstanceIdentifier: awssdk.String(id),
+		DBClusterIdentifier:  awssdk.String("graph-cluster"),
+		DBInstanceClass:      awssdk.String("db.r5.large"),
+		DBInstanceStatus:     awssdk.String("available"),
+	}
+}
+
+func TestNeptuneScanner_IdleInstance(t *testing.T) {
+	mock := &mockNeptuneClient{instances: []neptunetypes.DBInstance{neptuneInstance("graph-1")}}
+	// CPU 1%; the request metrics return no datapoints, which counts as zero.
+	metrics := newRDSMockMetrics([]float64{1.0}, nil, 0)
+	scanner := NewNeptuneScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingNeptuneIdle {
+		t.Fatalf("expected NEPTUNE_IDLE, got %s", f.ID)
+	}
+	// db.r5.large $0.348/hr * 730 = ~$254.04
+	if f.EstimatedMonthlyWaste < 254 || f.EstimatedMonthlyWaste > 254.1 {
+		t.Fatalf("expected ~$254.04, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["db_cluster_identifier"] != "graph-cluster" || f.Metadata["instance_class"] != "db.r5.large" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestNeptuneScanner_ActiveRequests(t *testing.T) {
+	mock := &mockNeptuneClient{instances: []neptunetypes.DBInstance{neptuneInstance("graph-1")}}
+	metrics := newMockMetricsFetcher(map[string]float64{"graph-1": 2.5})
+	scanner := NewNeptuneScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for instance serving requests, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// publicIPv4LowTrafficBytes is the NetworkIn+NetworkOut total over the idle window
+// below which an instance is not treated as needing a public address. The metrics
+// include private traffic, so this is an upper bound on public traffic.
+const publicIPv4LowTrafficBytes = 100 * 1024 * 1024
+
+// Attached resource kinds reported in PUBLIC_IPV4_UNNEEDED metadata.
+const (
+	publicIPv4OwnerInstance     = "ec2_instance"
+	publicIPv4OwnerNATGateway   = "nat_gateway"
+	publicIPv4OwnerLoadBalancer = "load_balancer"
+	publicIPv4OwnerOther        = "other"
+)
+
+// PublicIPv4API is the minimal interface for enumerating public IPv4 addresses.
+type PublicIPv4API interface {
+	DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+}
+
+// PublicIPv4Scanner detects public IPv4 addresses on instances that barely use the network.
+// Every in-use public IPv4 address is billed hourly, associated or not.
+type PublicIPv4Scanner struct {
+	client  PublicIPv4API
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewPublicIPv4Scanner creates a scanner for public IPv4 addresses.
+func NewPublicIPv4Scanner(client PublicIPv4API, metrics *MetricsFetcher, region string) *PublicIPv4Scanner {
+	return &PublicIPv4Scanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *PublicIPv4Scanner) Type() ResourceType {
+	return ResourcePublicIPv4
+}
+
+// publicIPv4 is one public address and the resource it is attached to.
+type publicIPv4 struct {
+	address       string
+	eniID         string
+	ownerType     string
+	ownerID       string
+	isElasticIP   bool
+	tags          map[string]string
+	privateIP     string
+	interfaceType string
+}
+
+// Scan enumerates public IPv4 addresses on network interfaces in the region and
+// flags those attached to instances with very low network traffic.
+func (s *PublicIPv4Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	enis, err := s.listNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("describe network interfaces: %w", err)
+	}
+
+	addrs := publicIPv4Addresses(enis)
+	result := &ScanResult{ResourcesScanned: len(addrs)}
+
+	// NAT gateway and load balancer addresses are required for them to work;
+	// only instance addresses are candidates for removal.
+	var candidates []publicIPv4
+	var instanceIDs []string
+	seenInstance := make(map[string]bool)
+	for _, addr := range addrs {
+		if addr.ownerType != publicIPv4OwnerInstance {
+			continue
+		}
+		if cfg.Exclude.ShouldExclude(addr.address, addr.tags) ||
+			cfg.Exclude.ShouldExclude(addr.eniID, addr.tags) ||
+			cfg.Exclude.ShouldExclude(addr.ownerID, addr.tags) {
+			continue
+		}
+		candidates = append(candidates, addr)
+		if !seenInstance[addr.ownerID] {
+			seenInstance[addr.ownerID] = true
+			instanceIDs = append(instanceIDs, addr.ownerID)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	inMap, err := s.metrics.FetchSum(ctx, "AWS/EC2", "NetworkIn", "InstanceId", instanceIDs, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EC2 NetworkIn metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	outMap, err := s.metrics.FetchSum(ctx, "AWS/EC2", "NetworkOut", "InstanceId", instanceIDs, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch EC2 NetworkOut metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	cost := pricing.MonthlyPublicIPv4Cost()
+	for _, addr := range candidates {
+		totalBytes := inMap[addr.ownerID] + outMap[addr.ownerID]
+		if totalBytes >= publicIPv4LowTrafficBytes {
+			continue
+		}
+
+		kind := "Public IPv4"
+		if addr.isElasticIP {
+			kind = "Elastic IP"
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:           FindingPublicIPv4Unneeded,
+			Severity:     SeverityLow,
+			ResourceType: ResourcePublicIPv4,
+			ResourceID:   addr.address,
+			ResourceName: addr.ownerID,
+			Region:       s.region,
+			Message: fmt.Sprintf("%s %s on instance %s with %.1f MiB of network traffic over %d days; consider removing it",
+				kind, addr.address, addr.ownerID, totalBytes/(1024*1024), cfg.IdleDays),
+			EstimatedMonthlyWaste: cost,
+			Metadata: withTags(map[string]any{
+				"attached_resource_type": addr.ownerType,
+				"attached_resource_id":   addr.ownerID,
+				"network_interface_id":   addr.eniID,
+				"interface_type":         addr.interfaceType,
+				"private_ip":             addr.privateIP,
+				"is_elastic_ip":          addr.isElasticIP,
+				"network_bytes":          totalBytes,
+			}, addr.tags),
+		})
+	}
+
+	return result, nil
+}
+
+// publicIPv4Addresses extracts every public IPv4 address from the interfaces,
+// including secondary private IPs with their own association.
+func publicIPv4Addresses(enis []ec2types.NetworkInterface) []publicIPv4 {
+	var addrs []publicIPv4
+	seen := make(map[string]bool)
+	for _, eni := range enis {
+		ownerType, ownerID := publicIPv4Owner(eni)
+		tags := ec2TagsToMap(eni.TagSet)
+
+		add := func(assoc *ec2types.NetworkInterfaceAssociation, privateIP string) {
+			if assoc == nil || deref(assoc.PublicIp) == "" || seen[deref(assoc.PublicIp)] {
+				return
+			}
+			seen[deref(assoc.PublicIp)] = true
+			addrs = append(addrs, publicIPv4{
+				address:       deref(assoc.PublicIp),
+				eniID:         deref(eni.NetworkInterfaceId),
+				ownerType:     ownerType,
+				ownerID:       ownerID,
+				isElasticIP:   assoc.AllocationId != nil,
+				tags:          tags,
+				privateIP:     privateIP,
+				interfaceType: string(eni.InterfaceType),
+			})
+		}
+
+		add(eni.Association, deref(eni.PrivateIpAddress))
+		for _, pip := range eni.PrivateIpAddresses {
+			add(pip.Association, deref(pip.PrivateIpAddress))
+		}
+	}
+	return addrs
+}
+
+// publicIPv4Owner identifies the resource that owns a network interface.
+// NAT gateway and load balancer IDs are only exposed through the description.
+func publicIPv4Owner(eni ec2types.NetworkInterface) (string, string) {
+	description := deref(eni.Description)
+	switch {
+	case eni.Attachment != nil && deref(eni.Attachment.InstanceId) != "":
+		return publicIPv4OwnerInstance, deref(eni.Attachment.InstanceId)
+	case eni.InterfaceType == ec2types.NetworkInterfaceTypeNatGateway:
+		return publicIPv4OwnerNATGateway, strings.TrimPrefix(description, "Interface for NAT Gateway ")
+	case strings.HasPrefix(description, "ELB "):
+		return publicIPv4OwnerLoadBalancer, strings.TrimPrefix(description, "ELB ")
+	default:
+		return publicIPv4OwnerOther, deref(eni.NetworkInterfaceId)
+	}
+}
+
+func (s *PublicIPv4Scanner) listNetworkInterfaces(ctx context.Context) ([]ec2types.NetworkInterface, error) {
+	var enis []ec2types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(s.client, &ec2.DescribeNetworkInterfacesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		enis = append(enis, page.NetworkInterfaces...)
+	}
+	return enis, nil
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func publicIPv4TestInterfaces() []ec2types.NetworkInterface {
+	return []ec2types.NetworkInterface{
+		{
+			NetworkInterfaceId: awssdk.String("eni-quiet"),
+			InterfaceType:      ec2types.NetworkInterfaceTypeInterface,
+			PrivateIpAddress:   awssdk.String("10.0.0.10"),
+			Attachment:         &ec2types.NetworkInterfaceAttachment{InstanceId: awssdk.String("i-quiet")},
+			Association:        &ec2types.NetworkInterfaceAssociation{PublicIp: awssdk.String("3.3.3.3")},
+			PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
+				{
+					PrivateIpAddress: awssdk.String("10.0.0.10"),
+					Association:      &ec2types.NetworkInterfaceAssociation{PublicIp: awssdk.String("3.3.3.3")},
+				},
+				{
+					PrivateIpAddress: awssdk.String("10.0.0.11"),
+					Association: &ec2types.NetworkInterfaceAssociation{
+						PublicIp:     awssdk.String("4.4.4.4"),
+						AllocationId: awssdk.String("eipalloc-1"),
+					},
+				},
+			},
+		},
+		{
+			NetworkInterfaceId: awssdk.String("eni-busy"),
+			Attachment:         &ec2types.NetworkInterfaceAttachment{InstanceId: awssdk.String("i-busy")},
+			Association:        &ec2types.NetworkInterfaceAssociation{PublicIp: awssdk.String("5.5.5.5")},
+		},
+		{
+			NetworkInterfaceId: awssdk.String("eni-nat"),
+			InterfaceType:      ec2types.NetworkInterfaceTypeNatGateway,
+			Description:        awssdk.String("Interface for NAT Gateway nat-0abc"),
+			Association:        &ec2types.NetworkInterfaceAssociation{PublicIp: awssdk.String("6.6.6.6")},
+		},
+		{
+			NetworkInterfaceId: awssdk.String("eni-alb"),
+			InterfaceType:      ec2types.NetworkInterfaceTypeInterface,
+			Description:        awssdk.String("ELB app/web/123abc"),
+			Association:        &ec2types.NetworkInterfaceAssociation{PublicIp: awssdk.String("7.7.7.7")},
+		},
+		{
+			// Private-only interface
+			NetworkInterfaceId: awssdk.String("eni-private"),
+			Attachment:         &ec2types.NetworkInterfaceAttachment{InstanceId: awssdk.String("i-private")},
+		},
+	}
+}
+
+func TestPublicIPv4Scanner_LowTrafficInstance(t *testing.T) {
+	mock := &mockENIClient{interfaces: publicIPv4TestInterfaces()}
+	metrics := newMockMetricsFetcher(map[string]float64{
+		"i-quiet": 1024,
+		"i-busy":  5 * 1024 * 1024 * 1024,
+	})
+
+	scanner := NewPublicIPv4Scanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 public IPs scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	f, ok := byID["3.3.3.3"]
+	if !ok {
+		t.Fatal("expected finding for 3.3.3.3")
+	}
+	if f.ID != FindingPublicIPv4Unneeded {
+		t.Fatalf("expected PUBLIC_IPV4_UNNEEDED, got %s", f.ID)
+	}
+	if f.EstimatedMonthlyWaste != 3.65 {
+		t.Fatalf("expected $3.65, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["attached_resource_type"] != "ec2_instance" || f.Metadata["attached_resource_id"] != "i-quiet" {
+		t.Fatalf("unexpected attachment metadata: %v", f.Metadata)
+	}
+	if f.Metadata["is_elastic_ip"] != false {
+		t.Fatalf("expected is_elastic_ip=false, got %v", f.Metadata["is_elastic_ip"])
+	}
+
+	eip := byID["4.4.4.4"]
+	if eip.Metadata["is_elastic_ip"] != true || eip.Metadata["private_ip"] != "10.0.0.11" {
+		t.Fatalf("unexpected secondary address metadata: %v", eip.Metadata)
+	}
+}
+
+func TestPublicIPv4Scanner_Excluded(t *testing.T) {
+	mock := &mockENIClient{interfaces: publicIPv4TestInterfaces()}
+	metrics := newMockMetricsFetcher(map[string]float64{"i-quiet": 0, "i-busy": 0})
+
+	scanner := NewPublicIPv4Scanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"i-quiet": true, "5.5.5.5": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestPublicIPv4Owner(t *testing.T) {
+	tests := []struct {
+		eni      ec2types.NetworkInterface
+		wantType string
+		wantID   string
+	}{
+		{ec2types.NetworkInterface{Attachment: &ec2types.NetworkInterfaceAttachment{InstanceId: awssdk.String("i-1")}}, "ec2_instance", "i-1"},
+		{ec2types.NetworkInterface{InterfaceType: ec2types.NetworkInterfaceTypeNatGateway, Description: awssdk.String("Interface for NAT Gateway nat-1")}, "nat_gateway", "nat-1"},
+		{ec2types.NetworkInterface{InterfaceType: ec2types.NetworkInterfaceTypeNetworkLoadBalancer, Description: awssdk.String("ELB net/edge/abc")}, "load_balancer", "net/edge/abc"},
+		{ec2types.NetworkInterface{NetworkInterfaceId: awssdk.String("eni-x")}, "other", "eni-x"},
+	}
+	for _, tt := range tests {
+		gotType, gotID := publicIPv4Owner(tt.eni)
+		if gotType != tt.wantType || gotID != tt.wantID {
+			t.Errorf("publicIPv4Owner() = %s/%s, want %s/%s", gotType, gotID, tt.wantType, tt.wantID)
+		}
+	}
+}
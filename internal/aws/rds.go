@@ -4,18 +4,32 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
+const (
+	// rdsStorageFreeThreshold flags instances whose free storage never drops below this
+	// fraction of allocated storage over the lookback window.
+	rdsStorageFreeThreshold = 0.70
+	// rdsReplicaIdleReadIOPS is the average ReadIOPS below which a replica with no
+	// connections counts as unread. Applying replicated changes causes a trickle of reads.
+	rdsReplicaIdleReadIOPS = 1.0
+)
+
 // RDSAPI is the minimal interface for RDS operations.
 type RDSAPI interface {
 	DescribeDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+	DescribeDBClusters(ctx context.Context, input *rds.DescribeDBClustersInput, opts ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+	DescribeReservedDBInstances(ctx context.Context, input *rds.DescribeReservedDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error)
 }
 
-// RDSScanner detects idle RDS instances.
+// RDSScanner detects idle RDS instances, Aurora clusters, and read replicas, and
+// instances with far more allocated storage than they use.
 type RDSScanner struct {
 	client  RDSAPI
 	metrics *MetricsFetcher
@@ -44,6 +58,18 @@ func (s *RDSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 		return result, nil
 	}
 
+	// Aurora members are evaluated together so a cluster yields one finding.
+	clusters, err := s.listAuroraClusters(ctx)
+	if err != nil {
+		slog.Warn("Failed to list Aurora clusters, evaluating members individually", "region", s.region, "error", err)
+	}
+	memberOf := make(map[string]string)
+	for clusterID, cluster := range clusters {
+		for _, m := range cluster.DBClusterMembers {
+			memberOf[deref(m.DBInstanceIdentifier)] = clusterID
+		}
+	}
+
 	// Collect instance identifiers for metric lookup
 	var ids []string
 	instMap := make(map[string]rdstypes.DBInstance, len(instances))
@@ -85,64 +111,407 @@ func (s *RDSScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, err
 		memMap = make(map[string]float64)
 	}
 
+	clusterMembers := make(map[string][]string)
+	var clusterOrder, standalone, replicas []string
 	for _, id := range ids {
-		avgCPU, hasCPU := cpuMap[id]
-		totalConns := connMap[id]
+		clusterID, ok := memberOf[id]
+		if !ok {
+			standalone = append(standalone, id)
+			if instMap[id].ReadReplicaSourceDBInstanceIdentifier != nil {
+				replicas = append(replicas, id)
+				continue
+			}
+			s.evaluateInstance(result, cfg, instMap[id], cpuMap, connMap, memMap)
+			continue
+		}
+		if _, seen := clusterMembers[clusterID]; !seen {
+			clusterOrder = append(clusterOrder, clusterID)
+		}
+		clusterMembers[clusterID] = append(clusterMembers[clusterID], id)
+	}
 
-		// Flag if CPU is below threshold or zero connections
-		isIdle := (hasCPU && avgCPU < cfg.IdleCPUThreshold) || totalConns == 0
-		if !isIdle {
+	for _, clusterID := range clusterOrder {
+		cluster := clusters[clusterID]
+		if cfg.Exclude.ShouldExclude(clusterID, rdsTagsToMap(cluster.TagList)) {
 			continue
 		}
+		var members []rdstypes.DBInstance
+		for _, id := range clusterMembers[clusterID] {
+			members = append(members, instMap[id])
+		}
+		s.evaluateCluster(result, cfg, cluster, members, cpuMap, connMap, memMap)
+	}
 
-		inst := instMap[id]
-		instanceClass := deref(inst.DBInstanceClass)
+	s.appendReplicaFindings(ctx, result, cfg, replicas, instMap, connMap)
+	s.appendStorageFindings(ctx, result, cfg, standalone, instMap)
+
+	if cfg.CommitmentAware {
+		s.applyReservedInstances(ctx, result, instances)
+	}
 
-		// Check if memory utilization is high enough to override the idle signal
-		var memPct float64
-		var hasMem bool
-		freeableBytes, hasFreeable := memMap[id]
-		if hasFreeable {
-			totalBytes, known := pricing.RDSInstanceMemoryBytes(instanceClass)
-			if known && totalBytes > 0 {
-				memPct = (1 - freeableBytes/float64(totalBytes)) * 100
-				hasMem = true
-				if memPct >= cfg.HighMemoryThreshold {
-					slog.Debug("RDS instance has high memory usage — not idle",
-						"instance", id, "cpu", avgCPU, "memory_pct", memPct)
-					continue
-				}
+	return result, nil
+}
+
+// applyReservedInstances zeroes the waste of idle instances and read replicas that
+// active reserved DB instances pay for. Reservations are matched by instance class
+// and Multi-AZ; Aurora cluster findings, which sum several members, are left as is.
+func (s *RDSScanner) applyReservedInstances(ctx context.Context, result *ScanResult, instances []rdstypes.DBInstance) {
+	reserved := make(map[string]int)
+	paginator := rds.NewDescribeReservedDBInstancesPaginator(s.client, &rds.DescribeReservedDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			slog.Warn("Failed to describe reserved DB instances", "region", s.region, "error", err)
+			return
+		}
+		for _, ri := range page.ReservedDBInstances {
+			if deref(ri.State) != "active" {
+				continue
 			}
+			key := rdsReservationKey(deref(ri.DBInstanceClass), ri.MultiAZ != nil && *ri.MultiAZ)
+			reserved[key] += int(derefInt32(ri.DBInstanceCount))
 		}
+	}
 
-		multiAZ := inst.MultiAZ != nil && *inst.MultiAZ
-		cost := pricing.MonthlyRDSCost(instanceClass, s.region, multiAZ)
+	running := make(map[string]int)
+	for _, inst := range instances {
+		if deref(inst.DBInstanceStatus) == "available" {
+			running[rdsReservationKey(deref(inst.DBInstanceClass), inst.MultiAZ != nil && *inst.MultiAZ)]++
+		}
+	}
+
+	applyReservedCoverage(result.Findings, func(f Finding) (string, bool) {
+		if f.ID != FindingIdleRDS && f.ID != FindingRDSIdleReadReplica {
+			return "", false
+		}
+		if aurora, _ := f.Metadata["is_aurora_cluster"].(bool); aurora {
+			return "", false
+		}
+		class, ok := f.Metadata["instance_class"].(string)
+		multiAZ, _ := f.Metadata["multi_az"].(bool)
+		return rdsReservationKey(class, multiAZ), ok
+	}, running, reserved)
+}
+
+// rdsReservationKey groups instances that the same reserved DB instance can pay for.
+func rdsReservationKey(instanceClass string, multiAZ bool) string {
+	if multiAZ {
+		return instanceClass + "/multi-az"
+	}
+	return instanceClass
+}
+
+// appendReplicaFindings flags read replicas with no connections and no read traffic.
+// Replicas are reported apart from IDLE_RDS because the remediation differs: the
+// replica can be deleted without touching its source.
+func (s *RDSScanner) appendReplicaFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, ids []string, instMap map[string]rdstypes.DBInstance, connMap map[string]float64) {
+	if len(ids) == 0 {
+		return
+	}
+	readMap, err := s.metrics.FetchAverage(ctx, "AWS/RDS", "ReadIOPS", "DBInstanceIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch RDS replica read metrics", "region", s.region, "error", err)
+		return
+	}
+	lagMap, err := s.metrics.FetchAverage(ctx, "AWS/RDS", "ReplicaLag", "DBInstanceIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch RDS replica lag metrics", "region", s.region, "error", err)
+		lagMap = make(map[string]float64)
+	}
 
-		msg := rdsIdleMessage(avgCPU, memPct, hasMem, totalConns, cfg.IdleDays)
+	for _, id := range ids {
+		readIOPS, hasReads := readMap[id]
+		if !hasReads || connMap[id] > 0 || readIOPS >= rdsReplicaIdleReadIOPS {
+			continue
+		}
+
+		inst := instMap[id]
+		instanceClass := deref(inst.DBInstanceClass)
+		multiAZ := inst.MultiAZ != nil && *inst.MultiAZ
+		source := deref(inst.ReadReplicaSourceDBInstanceIdentifier)
 
 		result.Findings = append(result.Findings, Finding{
-			ID:                    FindingIdleRDS,
+			ID:                    FindingRDSIdleReadReplica,
 			Severity:              SeverityHigh,
 			ResourceType:          ResourceRDS,
 			ResourceID:            id,
 			ResourceName:          id,
 			Region:                s.region,
-			Message:               msg,
-			EstimatedMonthlyWaste: cost,
-			Metadata: map[string]any{
-				"instance_class":        instanceClass,
-				"engine":                deref(inst.Engine),
-				"multi_az":              multiAZ,
-				"avg_cpu_percent":       avgCPU,
-				"total_connections":     totalConns,
-				"avg_mem_percent":       memPct,
-				"freeable_memory_bytes": freeableBytes,
-				"has_mem_metrics":       hasMem,
-			},
+			Message:               fmt.Sprintf("Read replica of %s with zero connections over %d days, %.2f read IOPS", source, cfg.IdleDays, readIOPS),
+			EstimatedMonthlyWaste: pricing.MonthlyRDSCost(instanceClass, s.region, multiAZ),
+			Metadata: withTags(map[string]any{
+				"source_db_identifier": source,
+				"replica_lag_seconds":  lagMap[id],
+				"avg_read_iops":        readIOPS,
+				"instance_class":       instanceClass,
+				"engine":               deref(inst.Engine),
+				"multi_az":             multiAZ,
+			}, rdsTagsToMap(inst.TagList)),
 		})
+
+		if f, ok := s.monitoringFinding(inst, cfg.IdleDays); ok {
+			result.Findings = append(result.Findings, f)
+		}
 	}
+}
 
-	return result, nil
+// appendStorageFindings flags standalone instances whose FreeStorageSpace stays above
+// rdsStorageFreeThreshold of AllocatedStorage for the whole window, whether or not the
+// instance is idle. Aurora is skipped: its cluster volume grows with the data.
+// Allocated storage cannot shrink in place, so the free GiB are priced as waste that
+// a migration to a smaller instance would recover.
+func (s *RDSScanner) appendStorageFindings(ctx context.Context, result *ScanResult, cfg ScanConfig, ids []string, instMap map[string]rdstypes.DBInstance) {
+	if len(ids) == 0 {
+		return
+	}
+	series, err := s.metrics.FetchSeries(ctx, "AWS/RDS", "FreeStorageSpace", "DBInstanceIdentifier", ids, cfg.IdleDays, "Minimum")
+	if err != nil {
+		slog.Warn("Failed to fetch RDS free storage metrics", "region", s.region, "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		points, ok := series[id]
+		if !ok || len(points) == 0 {
+			continue
+		}
+		inst := instMap[id]
+		allocatedGiB := int(derefInt32(inst.AllocatedStorage))
+		if allocatedGiB == 0 {
+			continue
+		}
+
+		minFreeBytes := points[0].Value
+		for _, p := range points[1:] {
+			minFreeBytes = min(minFreeBytes, p.Value)
+		}
+		freeGiB := minFreeBytes / (1024 * 1024 * 1024)
+		if freeGiB < float64(allocatedGiB)*rdsStorageFreeThreshold {
+			continue
+		}
+
+		storageType := deref(inst.StorageType)
+		multiAZ := inst.MultiAZ != nil && *inst.MultiAZ
+		unusedGiB := int(min(freeGiB, float64(allocatedGiB)))
+		waste := pricing.MonthlyRDSStorageCost(storageType, unusedGiB, s.region, multiAZ)
+		if waste == 0 {
+			continue
+		}
+		usedGiB := float64(allocatedGiB) - freeGiB
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRDSStorageOverProvisioned,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceRDS,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                s.region,
+			Message:               fmt.Sprintf("At least %.0f of %d GiB free over %d days", freeGiB, allocatedGiB, cfg.IdleDays),
+			EstimatedMonthlyWaste: waste,
+			Metadata: withTags(map[string]any{
+				"storage_type":   storageType,
+				"allocated_gib":  allocatedGiB,
+				"used_gib":       math.Round(max(usedGiB, 0)*100) / 100,
+				"free_gib":       math.Round(freeGiB*100) / 100,
+				"free_percent":   math.Round(freeGiB/float64(allocatedGiB)*1000) / 10,
+				"multi_az":       multiAZ,
+				"instance_class": deref(inst.DBInstanceClass),
+				"engine":         deref(inst.Engine),
+			}, rdsTagsToMap(inst.TagList)),
+		})
+	}
+}
+
+// evaluateInstance flags a standalone (non-Aurora) instance that is idle.
+func (s *RDSScanner) evaluateInstance(result *ScanResult, cfg ScanConfig, inst rdstypes.DBInstance, cpuMap, connMap, memMap map[string]float64) {
+	id := deref(inst.DBInstanceIdentifier)
+	avgCPU, hasCPU := cpuMap[id]
+	totalConns := connMap[id]
+
+	// Flag if CPU is below threshold or zero connections
+	isIdle := (hasCPU && avgCPU < cfg.IdleCPUFor(ResourceRDS)) || totalConns == 0
+	if !isIdle {
+		return
+	}
+
+	instanceClass := deref(inst.DBInstanceClass)
+
+	// Check if memory utilization is high enough to override the idle signal
+	memPct, hasMem := rdsMemoryPercent(instanceClass, memMap, id)
+	if hasMem && memPct >= cfg.HighMemoryFor(ResourceRDS) {
+		slog.Debug("RDS instance has high memory usage — not idle",
+			"instance", id, "cpu", avgCPU, "memory_pct", memPct)
+		return
+	}
+
+	multiAZ := inst.MultiAZ != nil && *inst.MultiAZ
+	cost := pricing.MonthlyRDSCost(instanceClass, s.region, multiAZ)
+
+	msg := rdsIdleMessage(avgCPU, memPct, hasMem, totalConns, cfg.IdleDays)
+
+	result.Findings = append(result.Findings, Finding{
+		ID:                    FindingIdleRDS,
+		Severity:              SeverityHigh,
+		ResourceType:          ResourceRDS,
+		ResourceID:            id,
+		ResourceName:          id,
+		Region:                s.region,
+		Message:               msg,
+		EstimatedMonthlyWaste: cost,
+		Metadata: withTags(map[string]any{
+			"instance_class":        instanceClass,
+			"engine":                deref(inst.Engine),
+			"multi_az":              multiAZ,
+			"avg_cpu_percent":       avgCPU,
+			"total_connections":     totalConns,
+			"avg_mem_percent":       memPct,
+			"freeable_memory_bytes": memMap[id],
+			"has_mem_metrics":       hasMem,
+			"is_aurora_cluster":     false,
+			"member_count":          1,
+		}, rdsTagsToMap(inst.TagList)),
+	})
+
+	if f, ok := s.monitoringFinding(inst, cfg.IdleDays); ok {
+		result.Findings = append(result.Findings, f)
+	}
+}
+
+// evaluateCluster flags an Aurora cluster whose members are idle as a whole.
+// Connections are summed across the writer and readers; the cluster is idle
+// when there are none, or when every member's CPU is below the threshold.
+// Cost is the sum of all member instances.
+func (s *RDSScanner) evaluateCluster(result *ScanResult, cfg ScanConfig, cluster rdstypes.DBCluster, members []rdstypes.DBInstance, cpuMap, connMap, memMap map[string]float64) {
+	clusterID := deref(cluster.DBClusterIdentifier)
+
+	var totalConns, maxCPU, maxMemPct float64
+	allLowCPU, anyCPU, hasMem := true, false, false
+	for _, inst := range members {
+		id := deref(inst.DBInstanceIdentifier)
+		totalConns += connMap[id]
+		if cpu, ok := cpuMap[id]; ok {
+			anyCPU = true
+			maxCPU = max(maxCPU, cpu)
+			if cpu >= cfg.IdleCPUFor(ResourceRDS) {
+				allLowCPU = false
+			}
+		} else {
+			allLowCPU = false
+		}
+		if pct, ok := rdsMemoryPercent(deref(inst.DBInstanceClass), memMap, id); ok {
+			hasMem = true
+			maxMemPct = max(maxMemPct, pct)
+		}
+	}
+
+	isIdle := (anyCPU && allLowCPU) || totalConns == 0
+	if !isIdle {
+		return
+	}
+	if hasMem && maxMemPct >= cfg.HighMemoryFor(ResourceRDS) {
+		slog.Debug("Aurora cluster has high memory usage — not idle",
+			"cluster", clusterID, "cpu", maxCPU, "memory_pct", maxMemPct)
+		return
+	}
+
+	var cost float64
+	memberIDs := make([]string, 0, len(members))
+	classes := make([]string, 0, len(members))
+	for _, inst := range members {
+		memberIDs = append(memberIDs, deref(inst.DBInstanceIdentifier))
+		classes = append(classes, deref(inst.DBInstanceClass))
+		// Aurora storage is shared across AZs; each member is billed as a single-AZ instance.
+		cost += pricing.MonthlyRDSCost(deref(inst.DBInstanceClass), s.region, false)
+	}
+
+	msg := rdsIdleMessage(maxCPU, maxMemPct, hasMem, totalConns, cfg.IdleDays)
+	msg = fmt.Sprintf("Aurora cluster (%d instances): %s", len(members), msg)
+
+	result.Findings = append(result.Findings, Finding{
+		ID:                    FindingIdleRDS,
+		Severity:              SeverityHigh,
+		ResourceType:          ResourceRDS,
+		ResourceID:            clusterID,
+		ResourceName:          clusterID,
+		Region:                s.region,
+		Message:               msg,
+		EstimatedMonthlyWaste: cost,
+		Metadata: withTags(map[string]any{
+			"engine":            deref(cluster.Engine),
+			"avg_cpu_percent":   maxCPU,
+			"total_connections": totalConns,
+			"avg_mem_percent":   maxMemPct,
+			"has_mem_metrics":   hasMem,
+			"is_aurora_cluster": true,
+			"member_count":      len(members),
+			"members":           memberIDs,
+			"instance_classes":  classes,
+		}, rdsTagsToMap(cluster.TagList)),
+	})
+
+	for _, inst := range members {
+		if f, ok := s.monitoringFinding(inst, cfg.IdleDays); ok {
+			result.Findings = append(result.Findings, f)
+		}
+	}
+}
+
+// rdsMemoryPercent converts average FreeableMemory into a utilization percentage
+// using the known memory size of the instance class.
+func rdsMemoryPercent(instanceClass string, memMap map[string]float64, id string) (float64, bool) {
+	freeableBytes, ok := memMap[id]
+	if !ok {
+		return 0, false
+	}
+	totalBytes, known := pricing.RDSInstanceMemoryBytes(instanceClass)
+	if !known || totalBytes == 0 {
+		return 0, false
+	}
+	return (1 - freeableBytes/float64(totalBytes)) * 100, true
+}
+
+// monitoringFinding flags paid monitoring add-ons left enabled on an idle instance:
+// Performance Insights long-term retention (beyond the 7-day free tier) and Enhanced Monitoring.
+func (s *RDSScanner) monitoringFinding(inst rdstypes.DBInstance, idleDays int) (Finding, bool) {
+	piEnabled := inst.PerformanceInsightsEnabled != nil && *inst.PerformanceInsightsEnabled
+	retentionDays := 0
+	if piEnabled {
+		retentionDays = int(derefInt32(inst.PerformanceInsightsRetentionPeriod))
+	}
+	monitoringInterval := int(derefInt32(inst.MonitoringInterval))
+	enhancedMonitoring := monitoringInterval > 0
+
+	piCost := pricing.RDSPerformanceInsightsCost(retentionDays, s.region)
+	if piCost == 0 && !enhancedMonitoring {
+		return Finding{}, false
+	}
+
+	var features []string
+	if piCost > 0 {
+		features = append(features, fmt.Sprintf("Performance Insights (%d-day retention)", retentionDays))
+	}
+	if enhancedMonitoring {
+		features = append(features, fmt.Sprintf("Enhanced Monitoring (%ds interval)", monitoringInterval))
+	}
+
+	id := deref(inst.DBInstanceIdentifier)
+	return Finding{
+		ID:                    FindingRDSUnnecessaryMonitoring,
+		Severity:              SeverityLow,
+		ResourceType:          ResourceRDS,
+		ResourceID:            id,
+		ResourceName:          id,
+		Region:                s.region,
+		Message:               fmt.Sprintf("Idle over %d days with paid monitoring enabled: %s", idleDays, strings.Join(features, ", ")),
+		EstimatedMonthlyWaste: piCost,
+		Hygiene:               piCost == 0, // Enhanced Monitoring cost is CloudWatch Logs ingestion, not priced here.
+		Metadata: withTags(map[string]any{
+			"performance_insights_enabled":        piEnabled,
+			"performance_insights_retention_days": retentionDays,
+			"enhanced_monitoring_enabled":         enhancedMonitoring,
+			"monitoring_interval_seconds":         monitoringInterval,
+		}, rdsTagsToMap(inst.TagList)),
+	}, true
 }
 
 func rdsIdleMessage(avgCPU, memPct float64, hasMem bool, totalConns float64, idleDays int) string {
@@ -156,6 +525,14 @@ func rdsIdleMessage(avgCPU, memPct float64, hasMem bool, totalConns float64, idl
 	return fmt.Sprintf("CPU %.1f%%%s over %d days", avgCPU, memSuffix, idleDays)
 }
 
+// rdsForeignEngines lists engines served by the shared RDS control plane but
+// reported by their own scanners.
+var rdsForeignEngines = map[string]bool{
+	"docdb":   true,
+	"neptune": true,
+}
+
+// listDBInstances returns RDS instances, leaving out engines that have a dedicated scanner.
 func (s *RDSScanner) listDBInstances(ctx context.Context) ([]rdstypes.DBInstance, error) {
 	var instances []rdstypes.DBInstance
 	paginator := rds.NewDescribeDBInstancesPaginator(s.client, &rds.DescribeDBInstancesInput{})
@@ -165,7 +542,31 @@ func (s *RDSScanner) listDBInstances(ctx context.Context) ([]rdstypes.DBInstance
 		if err != nil {
 			return nil, err
 		}
-		instances = append(instances, page.DBInstances...)
+		for _, inst := range page.DBInstances {
+			if rdsForeignEngines[deref(inst.Engine)] {
+				continue
+			}
+			instances = append(instances, inst)
+		}
 	}
 	return instances, nil
 }
+
+// listAuroraClusters returns Aurora clusters keyed by cluster identifier.
+func (s *RDSScanner) listAuroraClusters(ctx context.Context) (map[string]rdstypes.DBCluster, error) {
+	clusters := make(map[string]rdstypes.DBCluster)
+	paginator := rds.NewDescribeDBClustersPaginator(s.client, &rds.DescribeDBClustersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.DBClusters {
+			if strings.HasPrefix(deref(c.Engine), "aurora") {
+				clusters[deref(c.DBClusterIdentifier)] = c
+			}
+		}
+	}
+	return clusters, nil
+}
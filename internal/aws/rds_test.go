@@ -10,16 +10,27 @@ import (
 	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 type mockRDSClient struct {
 	instances []rdstypes.DBInstance
+	clusters  []rdstypes.DBCluster
+	reserved  []rdstypes.ReservedDBInstance
 }
 
 func (m *mockRDSClient) DescribeDBInstances(_ context.Context, _ *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
 	return &rds.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
 }
 
+func (m *mockRDSClient) DescribeDBClusters(_ context.Context, _ *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return &rds.DescribeDBClustersOutput{DBClusters: m.clusters}, nil
+}
+
+func (m *mockRDSClient) DescribeReservedDBInstances(_ context.Context, _ *rds.DescribeReservedDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error) {
+	return &rds.DescribeReservedDBInstancesOutput{ReservedDBInstances: m.reserved}, nil
+}
+
 // newRDSMockMetrics creates a mock MetricsFetcher that dispatches on metric name.
 // freeableMemoryBytes is the average FreeableMemory to return (0 means no data).
 func newRDSMockMetrics(cpuValues []float64, connValues []float64, freeableBytes float64) *MetricsFetcher {
@@ -104,6 +115,45 @@ func TestRDSScanner_IdleCPU(t *testing.T) {
 	}
 }
 
+func TestRDSScanner_ReservedInstanceCoverage(t *testing.T) {
+	mock := &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("reserved-db"),
+				DBInstanceClass:      awssdk.String("db.t3.medium"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("postgres"),
+				MultiAZ:              awssdk.Bool(false),
+			},
+		},
+		reserved: []rdstypes.ReservedDBInstance{
+			{DBInstanceClass: awssdk.String("db.t3.medium"), DBInstanceCount: awssdk.Int32(1), MultiAZ: awssdk.Bool(false), State: awssdk.String("active")},
+			{DBInstanceClass: awssdk.String("db.t3.medium"), DBInstanceCount: awssdk.Int32(1), MultiAZ: awssdk.Bool(true), State: awssdk.String("active")},
+		},
+	}
+
+	metrics := newRDSMockMetrics([]float64{2.0}, []float64{0}, 3*1024*1024*1024)
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+
+	cfg := ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0, CommitmentAware: true}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f, ok := findingsByResourceID(result.Findings)["reserved-db"]
+	if !ok || f.ID != FindingIdleRDS {
+		t.Fatalf("expected IDLE_RDS for reserved-db, got %+v", result.Findings)
+	}
+	if f.EstimatedMonthlyWaste != 0 || f.Metadata["covered_by_commitment"] != true {
+		t.Fatalf("expected reserved instance covered at $0, got $%.2f %v", f.EstimatedMonthlyWaste, f.Metadata)
+	}
+	want := pricing.MonthlyRDSCost("db.t3.medium", "us-east-1", false)
+	if f.Metadata["on_demand_monthly_cost"] != want {
+		t.Fatalf("expected on_demand_monthly_cost $%.2f, got %v", want, f.Metadata["on_demand_monthly_cost"])
+	}
+}
+
 func TestRDSScanner_ZeroConnections(t *testing.T) {
 	mock := &mockRDSClient{
 		instances: []rdstypes.DBInstance{
@@ -203,6 +253,74 @@ func TestRDSScanner_NoInstances(t *testing.T) {
 	}
 }
 
+func TestRDSScanner_SkipsDocumentDBInstances(t *testing.T) {
+	mock := &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("docs-1"),
+				DBInstanceClass:      awssdk.String("db.r5.large"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("docdb"),
+			},
+			{
+				DBInstanceIdentifier: awssdk.String("unused-db"),
+				DBInstanceClass:      awssdk.String("db.r5.large"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("mysql"),
+			},
+		},
+		clusters: []rdstypes.DBCluster{
+			{
+				DBClusterIdentifier: awssdk.String("docs"),
+				Engine:              awssdk.String("docdb"),
+				DBClusterMembers:    []rdstypes.DBClusterMember{{DBInstanceIdentifier: awssdk.String("docs-1")}},
+			},
+		},
+	}
+
+	// DocumentDB shares the RDS control plane; its instances belong to the DocumentDB scanner.
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 12*1024*1024*1024)
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 1 {
+		t.Fatalf("expected 1 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ResourceID != "unused-db" {
+		t.Fatalf("expected only unused-db to be flagged, got %v", result.Findings)
+	}
+}
+
+func TestRDSScanner_SkipsNeptuneInstances(t *testing.T) {
+	mock := &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("graph-1"),
+				DBInstanceClass:      awssdk.String("db.r5.large"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("neptune"),
+			},
+		},
+	}
+
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 12*1024*1024*1024)
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 0 {
+		t.Fatalf("expected 0 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
 func TestRDSScanner_ExcludedInstance(t *testing.T) {
 	mock := &mockRDSClient{
 		instances: []rdstypes.DBInstance{
@@ -356,9 +474,287 @@ func TestRDSScanner_UnknownClass_FallbackCPUOnly(t *testing.T) {
 	}
 }
 
+func TestRDSScanner_IdleReadReplica(t *testing.T) {
+	instance := func(id, source string) rdstypes.DBInstance {
+		inst := rdstypes.DBInstance{
+			DBInstanceIdentifier: awssdk.String(id),
+			DBInstanceClass:      awssdk.String("db.t3.medium"),
+			DBInstanceStatus:     awssdk.String("available"),
+			Engine:               awssdk.String("postgres"),
+			MultiAZ:              awssdk.Bool(false),
+		}
+		if source != "" {
+			inst.ReadReplicaSourceDBInstanceIdentifier = awssdk.String(source)
+		}
+		return inst
+	}
+	mock := &mockRDSClient{instances: []rdstypes.DBInstance{
+		instance("primary", ""),
+		instance("replica-idle", "primary"),
+		instance("replica-busy", "primary"),
+	}}
+	metrics := newMetricsByName(map[string]float64{
+		"CPUUtilization/primary":           40,
+		"DatabaseConnections/primary":      200,
+		"CPUUtilization/replica-idle":      2,
+		"ReadIOPS/replica-idle":            0.2,
+		"ReplicaLag/replica-idle":          3,
+		"CPUUtilization/replica-busy":      2,
+		"DatabaseConnections/replica-busy": 40,
+		"ReadIOPS/replica-busy":            150,
+	})
+
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingRDSIdleReadReplica || f.ResourceID != "replica-idle" {
+		t.Fatalf("expected RDS_IDLE_READ_REPLICA for replica-idle, got %s %s", f.ID, f.ResourceID)
+	}
+	if f.Severity != SeverityHigh {
+		t.Fatalf("expected high severity, got %s", f.Severity)
+	}
+	expected := pricing.MonthlyRDSCost("db.t3.medium", "us-east-1", false)
+	if f.EstimatedMonthlyWaste != expected {
+		t.Fatalf("expected $%.2f, got $%.2f", expected, f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["source_db_identifier"] != "primary" || f.Metadata["replica_lag_seconds"] != 3.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestRDSScanner_StorageOverProvisioned(t *testing.T) {
+	gib := float64(1024 * 1024 * 1024)
+	instance := func(id string, allocated int32) rdstypes.DBInstance {
+		return rdstypes.DBInstance{
+			DBInstanceIdentifier: awssdk.String(id),
+			DBInstanceClass:      awssdk.String("db.t3.medium"),
+			DBInstanceStatus:     awssdk.String("available"),
+			Engine:               awssdk.String("postgres"),
+			MultiAZ:              awssdk.Bool(false),
+			StorageType:          awssdk.String("gp3"),
+			AllocatedStorage:     awssdk.Int32(allocated),
+		}
+	}
+	mock := &mockRDSClient{instances: []rdstypes.DBInstance{
+		instance("mostly-empty", 1000),
+		instance("well-used", 1000),
+	}}
+	metrics := NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			free := map[string][]float64{
+				"mostly-empty": {950 * gib, 900 * gib, 920 * gib},
+				"well-used":    {900 * gib, 400 * gib},
+			}
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				switch *q.MetricStat.Metric.MetricName {
+				case "FreeStorageSpace":
+					results = append(results, cwtypes.MetricDataResult{
+						Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+						Values: free[*q.MetricStat.Metric.Dimensions[0].Value],
+					})
+				case "CPUUtilization":
+					results = append(results, cwtypes.MetricDataResult{Id: awssdk.String(fmt.Sprintf("m%d", i)), Values: []float64{50}})
+				case "DatabaseConnections":
+					results = append(results, cwtypes.MetricDataResult{Id: awssdk.String(fmt.Sprintf("m%d", i)), Values: []float64{100}})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingRDSStorageOverProvisioned || f.ResourceID != "mostly-empty" {
+		t.Fatalf("expected RDS_STORAGE_OVER_PROVISIONED for mostly-empty, got %s %s", f.ID, f.ResourceID)
+	}
+	// Minimum free 900 GiB of gp3 at $0.115 = $103.50
+	if f.EstimatedMonthlyWaste < 103.49 || f.EstimatedMonthlyWaste > 103.51 {
+		t.Fatalf("expected ~$103.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["allocated_gib"] != 1000 || f.Metadata["used_gib"] != 100.0 || f.Metadata["free_gib"] != 900.0 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
 func TestRDSScanner_Type(t *testing.T) {
 	scanner := &RDSScanner{}
 	if scanner.Type() != ResourceRDS {
 		t.Fatalf("expected ResourceRDS, got %s", scanner.Type())
 	}
 }
+
+func TestRDSScanner_IdleWithLongTermPerformanceInsights(t *testing.T) {
+	mock := &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier:               awssdk.String("dev-db"),
+				DBInstanceClass:                    awssdk.String("db.t3.medium"),
+				DBInstanceStatus:                   awssdk.String("available"),
+				Engine:                             awssdk.String("postgres"),
+				MultiAZ:                            awssdk.Bool(false),
+				PerformanceInsightsEnabled:         awssdk.Bool(true),
+				PerformanceInsightsRetentionPeriod: awssdk.Int32(731),
+				MonitoringInterval:                 awssdk.Int32(60),
+			},
+		},
+	}
+
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 0)
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected idle and monitoring findings, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[1]
+	if f.ID != FindingRDSUnnecessaryMonitoring {
+		t.Fatalf("expected RDS_UNNECESSARY_MONITORING, got %s", f.ID)
+	}
+	if f.EstimatedMonthlyWaste == 0 {
+		t.Fatal("expected non-zero Performance Insights retention cost")
+	}
+	if f.Metadata["performance_insights_retention_days"] != 731 {
+		t.Fatalf("expected retention 731 in metadata, got %v", f.Metadata["performance_insights_retention_days"])
+	}
+	if f.Metadata["enhanced_monitoring_enabled"] != true {
+		t.Fatalf("expected enhanced monitoring flagged, got %v", f.Metadata["enhanced_monitoring_enabled"])
+	}
+}
+
+func TestRDSScanner_IdleWithFreeTierMonitoringOnly(t *testing.T) {
+	mock := &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier:               awssdk.String("dev-db"),
+				DBInstanceClass:                    awssdk.String("db.t3.medium"),
+				DBInstanceStatus:                   awssdk.String("available"),
+				PerformanceInsightsEnabled:         awssdk.Bool(true),
+				PerformanceInsightsRetentionPeriod: awssdk.Int32(7),
+				MonitoringInterval:                 awssdk.Int32(0),
+			},
+		},
+	}
+
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 0)
+	scanner := NewRDSScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected only the idle finding for free-tier Performance Insights, got %d", len(result.Findings))
+	}
+}
+
+func auroraClusterMock() *mockRDSClient {
+	return &mockRDSClient{
+		instances: []rdstypes.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("aurora-writer"),
+				DBInstanceClass:      awssdk.String("db.r5.large"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("aurora-postgresql"),
+				DBClusterIdentifier:  awssdk.String("aurora-prod"),
+			},
+			{
+				DBInstanceIdentifier: awssdk.String("aurora-reader"),
+				DBInstanceClass:      awssdk.String("db.r5.large"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("aurora-postgresql"),
+				DBClusterIdentifier:  awssdk.String("aurora-prod"),
+			},
+		},
+		clusters: []rdstypes.DBCluster{
+			{
+				DBClusterIdentifier: awssdk.String("aurora-prod"),
+				Engine:              awssdk.String("aurora-postgresql"),
+				DBClusterMembers: []rdstypes.DBClusterMember{
+					{DBInstanceIdentifier: awssdk.String("aurora-writer"), IsClusterWriter: awssdk.Bool(true)},
+					{DBInstanceIdentifier: awssdk.String("aurora-reader"), IsClusterWriter: awssdk.Bool(false)},
+				},
+			},
+		},
+	}
+}
+
+func TestRDSScanner_AuroraClusterSingleFinding(t *testing.T) {
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 0)
+	scanner := NewRDSScanner(auroraClusterMock(), metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 cluster finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingIdleRDS || f.ResourceID != "aurora-prod" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	if f.Metadata["is_aurora_cluster"] != true {
+		t.Fatalf("expected is_aurora_cluster=true, got %v", f.Metadata["is_aurora_cluster"])
+	}
+	if f.Metadata["member_count"] != 2 {
+		t.Fatalf("expected member_count=2, got %v", f.Metadata["member_count"])
+	}
+
+	single := pricing.MonthlyRDSCost("db.r5.large", "us-east-1", false)
+	if single == 0 || f.EstimatedMonthlyWaste != 2*single {
+		t.Fatalf("expected cost summed across members (%.2f), got %.2f", 2*single, f.EstimatedMonthlyWaste)
+	}
+}
+
+func TestRDSScanner_AuroraClusterActive(t *testing.T) {
+	// Busy CPU and active connections across the cluster
+	metrics := newRDSMockMetrics([]float64{40.0}, []float64{25.0}, 0)
+	scanner := NewRDSScanner(auroraClusterMock(), metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5.0, HighMemoryThreshold: 50.0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestRDSScanner_AuroraClusterExcluded(t *testing.T) {
+	metrics := newRDSMockMetrics([]float64{1.0}, []float64{0}, 0)
+	scanner := NewRDSScanner(auroraClusterMock(), metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays:            7,
+		IdleCPUThreshold:    5.0,
+		HighMemoryThreshold: 50.0,
+		Exclude:             ExcludeConfig{ResourceIDs: map[string]bool{"aurora-prod": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// RDSProxyAPI is the minimal interface for RDS Proxy operations.
+type RDSProxyAPI interface {
+	DescribeDBProxies(ctx context.Context, input *rds.DescribeDBProxiesInput, opts ...func(*rds.Options)) (*rds.DescribeDBProxiesOutput, error)
+	DescribeDBProxyTargets(ctx context.Context, input *rds.DescribeDBProxyTargetsInput, opts ...func(*rds.Options)) (*rds.DescribeDBProxyTargetsOutput, error)
+	DescribeDBProxyEndpoints(ctx context.Context, input *rds.DescribeDBProxyEndpointsInput, opts ...func(*rds.Options)) (*rds.DescribeDBProxyEndpointsOutput, error)
+	DescribeDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// RDSProxyScanner detects RDS Proxies with no client connections.
+type RDSProxyScanner struct {
+	client  RDSProxyAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewRDSProxyScanner creates a scanner for RDS Proxies.
+func NewRDSProxyScanner(client RDSProxyAPI, metrics *MetricsFetcher, region string) *RDSProxyScanner {
+	return &RDSProxyScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *RDSProxyScanner) Type() ResourceType {
+	return ResourceRDSProxy
+}
+
+// Scan examines available proxies for zero client connections. RDS Proxy bills per
+// vCPU-hour of its target instances, so waste is derived from the target classes.
+func (s *RDSProxyScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	proxies, err := s.listProxies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list RDS proxies: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(proxies)}
+
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	var names []string
+	proxyMap := make(map[string]rdstypes.DBProxy, len(proxies))
+	for _, p := range proxies {
+		name := deref(p.DBProxyName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(p.DBProxyArn), nil) {
+			continue
+		}
+		if p.Status != rdstypes.DBProxyStatusAvailable {
+			continue
+		}
+		if p.CreatedDate != nil && p.CreatedDate.After(cutoff) {
+			continue
+		}
+		names = append(names, name)
+		proxyMap[name] = p
+	}
+
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	clientConns, err := s.metrics.FetchSum(ctx, "AWS/RDS", "ClientConnections", "ProxyName", names, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch RDS Proxy connection metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	var instanceClasses map[string]string
+	for _, name := range names {
+		if clientConns[name] > 0 {
+			continue
+		}
+
+		if instanceClasses == nil {
+			instanceClasses, err = s.instanceClasses(ctx)
+			if err != nil {
+				slog.Warn("Failed to list RDS instances for proxy pricing", "region", s.region, "error", err)
+				instanceClasses = make(map[string]string)
+			}
+		}
+
+		targets, err := s.proxyTargets(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to describe RDS Proxy targets", "proxy", name, "error", err)
+			continue
+		}
+		endpoints, err := s.proxyEndpointCount(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to describe RDS Proxy endpoints", "proxy", name, "error", err)
+		}
+
+		var targetIDs []string
+		vcpus := 0
+		for _, t := range targets {
+			id := deref(t.RdsResourceId)
+			targetIDs = append(targetIDs, id)
+			if t.Type != rdstypes.TargetTypeRdsInstance {
+				continue
+			}
+			if n, ok := pricing.RDSInstanceVCPUs(instanceClasses[id]); ok {
+				vcpus += n
+			}
+		}
+		cost := pricing.MonthlyRDSProxyCost(vcpus, s.region)
+
+		p := proxyMap[name]
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRDSProxyIdle,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceRDSProxy,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero client connections over %d days (%d targets, %d target vCPUs)", cfg.IdleDays, len(targetIDs), vcpus),
+			EstimatedMonthlyWaste: cost,
+			Metadata: map[string]any{
+				"engine_family":  deref(p.EngineFamily),
+				"target_db_ids":  targetIDs,
+				"target_vcpus":   vcpus,
+				"endpoint_count": endpoints,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// instanceClasses maps every DB instance identifier to its instance class.
+func (s *RDSProxyScanner) instanceClasses(ctx context.Context) (map[string]string, error) {
+	classes := make(map[string]string)
+	paginator := rds.NewDescribeDBInstancesPaginator(s.client, &rds.DescribeDBInstancesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range page.DBInstances {
+			classes[deref(inst.DBInstanceIdentifier)] = deref(inst.DBInstanceClass)
+		}
+	}
+	return classes, nil
+}
+
+func (s *RDSProxyScanner) proxyTargets(ctx context.Context, name string) ([]rdstypes.DBProxyTarget, error) {
+	var targets []rdstypes.DBProxyTarget
+	paginator := rds.NewDescribeDBProxyTargetsPaginator(s.client, &rds.DescribeDBProxyTargetsInput{DBProxyName: &name})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, page.Targets...)
+	}
+	return targets, nil
+}
+
+// proxyEndpointCount counts the proxy's endpoints, including the default one.
+func (s *RDSProxyScanner) proxyEndpointCount(ctx context.Context, name string) (int, error) {
+	count := 0
+	paginator := rds.NewDescribeDBProxyEndpointsPaginator(s.client, &rds.DescribeDBProxyEndpointsInput{DBProxyName: &name})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return count, err
+		}
+		count += len(page.DBProxyEndpoints)
+	}
+	return count, nil
+}
+
+func (s *RDSProxyScanner) listProxies(ctx context.Context) ([]rdstypes.DBProxy, error) {
+	var proxies []rdstypes.DBProxy
+	paginator := rds.NewDescribeDBProxiesPaginator(s.client, &rds.DescribeDBProxiesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, page.DBProxies...)
+	}
+	return proxies, nil
+}
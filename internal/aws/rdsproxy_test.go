@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+type mockRDSProxyClient struct {
+	proxies   []rdstypes.DBProxy
+	targets   map[string][]rdstypes.DBProxyTarget
+	endpoints map[string]int
+	instances []rdstypes.DBInstance
+}
+
+func (m *mockRDSProxyClient) DescribeDBProxies(_ context.Context, _ *rds.DescribeDBProxiesInput, _ ...func(*rds.Options)) (*rds.DescribeDBProxiesOutput, error) {
+	return &rds.DescribeDBProxiesOutput{DBProxies: m.proxies}, nil
+}
+
+func (m *mockRDSProxyClient) DescribeDBProxyTargets(_ context.Context, input *rds.DescribeDBProxyTargetsInput, _ ...func(*rds.Options)) (*rds.DescribeDBProxyTargetsOutput, error) {
+	return &rds.DescribeDBProxyTargetsOutput{Targets: m.targets[deref(input.DBProxyName)]}, nil
+}
+
+func (m *mockRDSProxyClient) DescribeDBProxyEndpoints(_ context.Context, input *rds.DescribeDBProxyEndpointsInput, _ ...func(*rds.Options)) (*rds.DescribeDBProxyEndpointsOutput, error) {
+	return &rds.DescribeDBProxyEndpointsOutput{DBProxyEndpoints: make([]rdstypes.DBProxyEndpoint, m.endpoints[deref(input.DBProxyName)])}, nil
+}
+
+func (m *mockRDSProxyClient) DescribeDBInstances(_ context.Context, _ *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return &rds.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
+}
+
+func rdsProxy(name string, age time.Duration) rdstypes.DBProxy {
+	return rdstypes.DBProxy{
+		DBProxyName:  awssdk.String(name),
+		DBProxyArn:   awssdk.String("arn:aws:rds:us-east-1:123456789012:db-proxy:prx-" + name),
+		Status:       rdstypes.DBProxyStatusAvailable,
+		EngineFamily: awssdk.String("POSTGRESQL"),
+		CreatedDate:  awssdk.Time(time.Now().Add(-age)),
+	}
+}
+
+func TestRDSProxyScanner_IdleProxy(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockRDSProxyClient{
+		proxies: []rdstypes.DBProxy{
+			rdsProxy("idle-proxy", 30*day),
+			rdsProxy("busy-proxy", 30*day),
+			rdsProxy("new-proxy", 1*day),
+		},
+		targets: map[string][]rdstypes.DBProxyTarget{
+			"idle-proxy": {
+				{RdsResourceId: awssdk.String("aurora-cluster"), Type: rdstypes.TargetTypeTrackedCluster},
+				{RdsResourceId: awssdk.String("aurora-writer"), Type: rdstypes.TargetTypeRdsInstance},
+				{RdsResourceId: awssdk.String("aurora-reader"), Type: rdstypes.TargetTypeRdsInstance},
+			},
+		},
+		endpoints: map[string]int{"idle-proxy": 2},
+		instances: []rdstypes.DBInstance{
+			{DBInstanceIdentifier: awssdk.String("aurora-writer"), DBInstanceClass: awssdk.String("db.r5.xlarge")},
+			{DBInstanceIdentifier: awssdk.String("aurora-reader"), DBInstanceClass: awssdk.String("db.r5.xlarge")},
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"busy-proxy": 300})
+
+	scanner := NewRDSProxyScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 3 {
+		t.Fatalf("expected 3 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingRDSProxyIdle || f.ResourceID != "idle-proxy" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceID)
+	}
+	// 2 × db.r5.xlarge = 8 vCPUs * $0.015 * 730 = $87.60
+	if f.EstimatedMonthlyWaste < 87.59 || f.EstimatedMonthlyWaste > 87.61 {
+		t.Fatalf("expected ~$87.60, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["endpoint_count"] != 2 || f.Metadata["target_vcpus"] != 8 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+	if ids, ok := f.Metadata["target_db_ids"].([]string); !ok || len(ids) != 3 {
+		t.Fatalf("expected 3 target IDs, got %v", f.Metadata["target_db_ids"])
+	}
+}
+
+func TestRDSProxyScanner_Excluded(t *testing.T) {
+	mock := &mockRDSProxyClient{proxies: []rdstypes.DBProxy{rdsProxy("keep-proxy", 30*24*time.Hour)}}
+
+	scanner := NewRDSProxyScanner(mock, zeroTrafficMetrics(), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"keep-proxy": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
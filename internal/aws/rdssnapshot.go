@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// RDSSnapshotAPI is the minimal interface for RDS snapshot operations.
+type RDSSnapshotAPI interface {
+	DescribeDBSnapshots(ctx context.Context, input *rds.DescribeDBSnapshotsInput, opts ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error)
+	DescribeDBClusterSnapshots(ctx context.Context, input *rds.DescribeDBClusterSnapshotsInput, opts ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error)
+	DescribeDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+	DescribeDBClusters(ctx context.Context, input *rds.DescribeDBClustersInput, opts ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+}
+
+// RDSSnapshotScanner detects stale manual RDS and Aurora snapshots whose source is gone.
+type RDSSnapshotScanner struct {
+	client RDSSnapshotAPI
+	region string
+}
+
+// NewRDSSnapshotScanner creates a scanner for manual RDS and Aurora snapshots.
+func NewRDSSnapshotScanner(client RDSSnapshotAPI, region string) *RDSSnapshotScanner {
+	return &RDSSnapshotScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *RDSSnapshotScanner) Type() ResourceType {
+	return ResourceRDSSnapshot
+}
+
+// Scan examines manual instance and cluster snapshots older than StaleDays whose source
+// DB instance or cluster no longer exists. Snapshots of live databases are kept as
+// deliberate restore points.
+func (s *RDSSnapshotScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	instanceSnaps, err := s.listDBSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list RDS snapshots: %w", err)
+	}
+	clusterSnaps, err := s.listDBClusterSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list RDS cluster snapshots: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(instanceSnaps) + len(clusterSnaps)}
+	if result.ResourcesScanned == 0 {
+		return result, nil
+	}
+
+	instances, err := s.liveInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list RDS instances: %w", err)
+	}
+	clusters, err := s.liveClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list RDS clusters: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, snap := range instanceSnaps {
+		id := deref(snap.DBSnapshotIdentifier)
+		source := deref(snap.DBInstanceIdentifier)
+		if cfg.Exclude.ShouldExclude(id, rdsTagsToMap(snap.TagList)) || cfg.Exclude.ShouldExclude(deref(snap.DBSnapshotArn), nil) || instances[source] {
+			continue
+		}
+		if f, ok := s.staleFinding(cfg, now, id, deref(snap.DBSnapshotArn), source, deref(snap.Engine), snap.SnapshotCreateTime, derefInt32(snap.AllocatedStorage)); ok {
+			setTagsMetadata(f.Metadata, rdsTagsToMap(snap.TagList))
+			result.Findings = append(result.Findings, f)
+		}
+	}
+	for _, snap := range clusterSnaps {
+		id := deref(snap.DBClusterSnapshotIdentifier)
+		source := deref(snap.DBClusterIdentifier)
+		if cfg.Exclude.ShouldExclude(id, rdsTagsToMap(snap.TagList)) || cfg.Exclude.ShouldExclude(deref(snap.DBClusterSnapshotArn), nil) || clusters[source] {
+			continue
+		}
+		if f, ok := s.staleFinding(cfg, now, id, deref(snap.DBClusterSnapshotArn), source, deref(snap.Engine), snap.SnapshotCreateTime, derefInt32(snap.AllocatedStorage)); ok {
+			f.Metadata["cluster_snapshot"] = true
+			setTagsMetadata(f.Metadata, rdsTagsToMap(snap.TagList))
+			result.Findings = append(result.Findings, f)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *RDSSnapshotScanner) staleFinding(cfg ScanConfig, now time.Time, id, arn, source, engine string, created *time.Time, allocatedGiB int32) (Finding, bool) {
+	if created == nil {
+		return Finding{}, false
+	}
+	ageDays := int(now.Sub(*created).Hours() / 24)
+	if ageDays < cfg.StaleDays {
+		return Finding{}, false
+	}
+
+	return Finding{
+		ID:                    FindingRDSStaleSnapshot,
+		Severity:              SeverityMedium,
+		ResourceType:          ResourceRDSSnapshot,
+		ResourceID:            id,
+		ResourceName:          arn,
+		Region:                s.region,
+		Message:               fmt.Sprintf("Manual snapshot %d days old, source %s no longer exists (%d GiB)", ageDays, source, allocatedGiB),
+		EstimatedMonthlyWaste: pricing.MonthlyRDSSnapshotCost(int(allocatedGiB), s.region),
+		Metadata: map[string]any{
+			"source_db_identifier": source,
+			"engine":               engine,
+			"age_days":             ageDays,
+			"allocated_storage":    allocatedGiB,
+		},
+	}, true
+}
+
+func (s *RDSSnapshotScanner) listDBSnapshots(ctx context.Context) ([]rdstypes.DBSnapshot, error) {
+	var snaps []rdstypes.DBSnapshot
+	paginator := rds.NewDescribeDBSnapshotsPaginator(s.client, &rds.DescribeDBSnapshotsInput{
+		SnapshotType: awssdk.String("manual"),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, page.DBSnapshots...)
+	}
+	return snaps, nil
+}
+
+func (s *RDSSnapshotScanner) listDBClusterSnapshots(ctx context.Context) ([]rdstypes.DBClusterSnapshot, error) {
+	var snaps []rdstypes.DBClusterSnapshot
+	paginator := rds.NewDescribeDBClusterSnapshotsPaginator(s.client, &rds.DescribeDBClusterSnapshotsInput{
+		SnapshotType: awssdk.String("manual"),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, page.DBClusterSnapshots...)
+	}
+	return snaps, nil
+}
+
+// liveInstances returns the set of existing DB instance identifiers.
+func (s *RDSSnapshotScanner) liveInstances(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := rds.NewDescribeDBInstancesPaginator(s.client, &rds.DescribeDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range page.DBInstances {
+			ids[deref(inst.DBInstanceIdentifier)] = true
+		}
+	}
+	return ids, nil
+}
+
+// liveClusters returns the set of existing DB cluster identifiers.
+func (s *RDSSnapshotScanner) liveClusters(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	paginator := rds.NewDescribeDBClustersPaginator(s.client, &rds.DescribeDBClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.DBClusters {
+			ids[deref(c.DBClusterIdentifier)] = true
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+type mockRDSSnapshotClient struct {
+	snapshots        []rdstypes.DBSnapshot
+	clusterSnapshots []rdstypes.DBClusterSnapshot
+	instances        []rdstypes.DBInstance
+	clusters         []rdstypes.DBCluster
+	err              error
+}
+
+func (m *mockRDSSnapshotClient) DescribeDBSnapshots(_ context.Context, _ *rds.DescribeDBSnapshotsInput, _ ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.DescribeDBSnapshotsOutput{DBSnapshots: m.snapshots}, nil
+}
+
+func (m *mockRDSSnapshotClient) DescribeDBClusterSnapshots(_ context.Context, _ *rds.DescribeDBClusterSnapshotsInput, _ ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	return &rds.DescribeDBClusterSnapshotsOutput{DBClusterSnapshots: m.clusterSnapshots}, nil
+}
+
+func (m *mockRDSSnapshotClient) DescribeDBInstances(_ context.Context, _ *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return &rds.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
+}
+
+func (m *mockRDSSnapshotClient) DescribeDBClusters(_ context.Context, _ *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return &rds.DescribeDBClustersOutput{DBClusters: m.clusters}, nil
+}
+
+func dbSnapshot(id, source string, age time.Duration, sizeGiB int32) rdstypes.DBSnapshot {
+	return rdstypes.DBSnapshot{
+		DBSnapshotIdentifier: awssdk.String(id),
+		DBSnapshotArn:        awssdk.String("arn:aws:rds:us-east-1:123456789012:snapshot:" + id),
+		DBInstanceIdentifier: awssdk.String(source),
+		Engine:               awssdk.String("postgres"),
+		SnapshotCreateTime:   awssdk.Time(time.Now().Add(-age)),
+		AllocatedStorage:     awssdk.Int32(sizeGiB),
+	}
+}
+
+func TestRDSSnapshotScanner_StaleSnapshots(t *testing.T) {
+	day := 24 * time.Hour
+	client := &mockRDSSnapshotClient{
+		snapshots: []rdstypes.DBSnapshot{
+			dbSnapshot("orphan-old", "deleted-db", 200*day, 100),
+			dbSnapshot("live-old", "live-db", 200*day, 100),
+			dbSnapshot("orphan-new", "deleted-db", 10*day, 100),
+		},
+		clusterSnapshots: []rdstypes.DBClusterSnapshot{
+			{
+				DBClusterSnapshotIdentifier: awssdk.String("aurora-final"),
+				DBClusterSnapshotArn:        awssdk.String("arn:aws:rds:us-east-1:123456789012:cluster-snapshot:aurora-final"),
+				DBClusterIdentifier:         awssdk.String("deleted-cluster"),
+				Engine:                      awssdk.String("aurora-mysql"),
+				SnapshotCreateTime:          awssdk.Time(time.Now().Add(-120 * day)),
+				AllocatedStorage:            awssdk.Int32(20),
+			},
+		},
+		instances: []rdstypes.DBInstance{{DBInstanceIdentifier: awssdk.String("live-db")}},
+	}
+	scanner := NewRDSSnapshotScanner(client, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	f := byID["orphan-old"]
+	if f.ID != FindingRDSStaleSnapshot {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 100 GiB * $0.095 = $9.50
+	if f.EstimatedMonthlyWaste < 9.49 || f.EstimatedMonthlyWaste > 9.51 {
+		t.Fatalf("expected ~$9.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["source_db_identifier"] != "deleted-db" || f.Metadata["engine"] != "postgres" || f.Metadata["age_days"] != 200 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+
+	c := byID["aurora-final"]
+	if c.Metadata["cluster_snapshot"] != true || c.Metadata["engine"] != "aurora-mysql" {
+		t.Fatalf("unexpected cluster snapshot metadata: %v", c.Metadata)
+	}
+}
+
+func TestRDSSnapshotScanner_Excluded(t *testing.T) {
+	client := &mockRDSSnapshotClient{snapshots: []rdstypes.DBSnapshot{
+		dbSnapshot("keep-me", "deleted-db", 200*24*time.Hour, 100),
+	}}
+	scanner := NewRDSSnapshotScanner(client, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"keep-me": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestRDSSnapshotScanner_APIError(t *testing.T) {
+	scanner := NewRDSSnapshotScanner(&mockRDSSnapshotClient{err: errors.New("denied")}, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90}); err == nil {
+		t.Fatal("expected error")
+	}
+}
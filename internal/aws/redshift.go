@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	rstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// redshiftPauseableQuietHours is the minimum contiguous run of connection-free
+// hours of the day (a strict majority of 24) for a cluster to be worth pausing on a schedule.
+const redshiftPauseableQuietHours = 13
+
+// RedshiftAPI is the minimal interface for Redshift operations.
+type RedshiftAPI interface {
+	DescribeClusters(ctx context.Context, input *redshift.DescribeClustersInput, opts ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
+}
+
+// RedshiftScanner detects idle and pauseable provisioned Redshift clusters.
+type RedshiftScanner struct {
+	client  RedshiftAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewRedshiftScanner creates a scanner for Redshift clusters.
+func NewRedshiftScanner(client RedshiftAPI, metrics *MetricsFetcher, region string) *RedshiftScanner {
+	return &RedshiftScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *RedshiftScanner) Type() ResourceType {
+	return ResourceRedshift
+}
+
+// Scan examines available Redshift clusters for idle CPU and connection patterns.
+// DescribeClusters returns provisioned clusters only; Redshift Serverless workgroups
+// bill per RPU-second used and cost nothing while idle, so they are not scanned.
+func (s *RedshiftScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Redshift clusters: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(clusters)}
+	if len(clusters) == 0 {
+		return result, nil
+	}
+
+	var ids []string
+	clusterMap := make(map[string]rstypes.Cluster, len(clusters))
+	for _, c := range clusters {
+		id := deref(c.ClusterIdentifier)
+		if cfg.Exclude.ShouldExclude(id, redshiftTagsToMap(c.Tags)) {
+			continue
+		}
+		// Paused and transitioning clusters are not billed for compute.
+		if deref(c.ClusterStatus) != "available" {
+			continue
+		}
+		ids = append(ids, id)
+		clusterMap[id] = c
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	cpuMap, err := s.metrics.FetchAverage(ctx, "AWS/Redshift", "CPUUtilization", "ClusterIdentifier", ids, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch Redshift CPU metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	connSeries, err := s.metrics.FetchSeries(ctx, "AWS/Redshift", "DatabaseConnections", "ClusterIdentifier", ids, cfg.IdleDays, "Maximum")
+	if err != nil {
+		slog.Warn("Failed to fetch Redshift connection metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, id := range ids {
+		c := clusterMap[id]
+		nodeType := deref(c.NodeType)
+		nodeCount := int(derefInt32(c.NumberOfNodes))
+		cost := pricing.MonthlyRedshiftCost(nodeType, nodeCount, s.region)
+
+		avgCPU, hasCPU := cpuMap[id]
+		points := connSeries[id]
+		totalConns := 0.0
+		for _, p := range points {
+			totalConns += p.Value
+		}
+
+		meta := map[string]any{
+			"deployment_type":   "provisioned",
+			"node_type":         nodeType,
+			"number_of_nodes":   nodeCount,
+			"avg_cpu_percent":   avgCPU,
+			"total_connections": totalConns,
+		}
+		setTagsMetadata(meta, redshiftTagsToMap(c.Tags))
+
+		// REDSHIFT_IDLE: CPU below threshold and zero connections
+		if hasCPU && avgCPU < cfg.IdleCPUFor(ResourceRedshift) && totalConns == 0 {
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingRedshiftIdle,
+				Severity:              SeverityHigh,
+				ResourceType:          ResourceRedshift,
+				ResourceID:            id,
+				ResourceName:          id,
+				Region:                s.region,
+				Message:               fmt.Sprintf("Zero connections over %d days, CPU %.1f%% (%d x %s)", cfg.IdleDays, avgCPU, nodeCount, nodeType),
+				EstimatedMonthlyWaste: cost,
+				Metadata:              meta,
+			})
+			continue
+		}
+
+		// REDSHIFT_PAUSEABLE: activity confined to part of the day
+		quietHours, startHour := longestQuietHours(points)
+		if quietHours < redshiftPauseableQuietHours || quietHours == 24 {
+			continue
+		}
+		meta["quiet_hours_per_day"] = quietHours
+		meta["quiet_start_hour_utc"] = startHour
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRedshiftPauseable,
+			Severity:              SeverityMedium,
+			ResourceType:          ResourceRedshift,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                s.region,
+			Message:               fmt.Sprintf("No connections for %d contiguous hours a day from %02d:00 UTC over %d days; schedule pause/resume", quietHours, startHour, cfg.IdleDays),
+			EstimatedMonthlyWaste: cost * float64(quietHours) / 24,
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+// longestQuietHours folds a connection series onto hours of the day (UTC) and returns
+// the longest contiguous run of hours, wrapping past midnight, with zero connections
+// on every day, along with the hour that run starts. A series without any datapoints
+// returns zero.
+func longestQuietHours(points []MetricPoint) (int, int) {
+	if len(points) == 0 {
+		return 0, 0
+	}
+
+	var active [24]bool
+	for _, p := range points {
+		if p.Value > 0 {
+			active[p.Timestamp.UTC().Hour()] = true
+		}
+	}
+
+	best, bestStart := 0, 0
+	run, runStart := 0, 0
+	// Walk two days so a run that crosses midnight is counted in one piece.
+	for i := 0; i < 48; i++ {
+		if active[i%24] {
+			run = 0
+			continue
+		}
+		if run == 0 {
+			runStart = i % 24
+		}
+		run++
+		if run > best {
+			best, bestStart = run, runStart
+		}
+	}
+	if best > 24 {
+		best = 24
+	}
+	return best, bestStart
+}
+
+func (s *RedshiftScanner) listClusters(ctx context.Context) ([]rstypes.Cluster, error) {
+	var clusters []rstypes.Cluster
+	paginator := redshift.NewDescribeClustersPaginator(s.client, &redshift.DescribeClustersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.Clusters...)
+	}
+	return clusters, nil
+}
+
+func redshiftTagsToMap(tags []rstypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m
+}
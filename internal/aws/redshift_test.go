@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	rstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+)
+
+type mockRedshiftClient struct {
+	clusters []rstypes.Cluster
+}
+
+func (m *mockRedshiftClient) DescribeClusters(_ context.Context, _ *redshift.DescribeClustersInput, _ ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error) {
+	return &redshift.DescribeClustersOutput{Clusters: m.clusters}, nil
+}
+
+func redshiftCluster(id string) rstypes.Cluster {
+	return rstypes.Cluster{
+		ClusterIdentifier: awssdk.String(id),
+		ClusterStatus:     awssdk.String("available"),
+		NodeType:          awssdk.String("ra3.4xlarge"),
+		NumberOfNodes:     awssdk.Int32(2),
+	}
+}
+
+// newRedshiftMockMetrics returns a fixed CPU average and an hourly connection series
+// produced by connAt for each of the last days*24 hours.
+func newRedshiftMockMetrics(cpu float64, days int, connAt func(hour int) float64) *MetricsFetcher {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var timestamps []time.Time
+	var conns []float64
+	for h := 0; h < days*24; h++ {
+		ts := start.Add(time.Duration(h) * time.Hour)
+		timestamps = append(timestamps, ts)
+		conns = append(conns, connAt(ts.Hour()))
+	}
+
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				id := awssdk.String(fmt.Sprintf("m%d", i))
+				switch *q.MetricStat.Metric.MetricName {
+				case "CPUUtilization":
+					results = append(results, cwtypes.MetricDataResult{Id: id, Values: []float64{cpu}})
+				case "DatabaseConnections":
+					results = append(results, cwtypes.MetricDataResult{Id: id, Values: conns, Timestamps: timestamps})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestRedshiftScanner_IdleCluster(t *testing.T) {
+	mock := &mockRedshiftClient{clusters: []rstypes.Cluster{redshiftCluster("analytics-old")}}
+	metrics := newRedshiftMockMetrics(0.5, 7, func(int) float64 { return 0 })
+	scanner := NewRedshiftScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingRedshiftIdle {
+		t.Fatalf("expected REDSHIFT_IDLE, got %s", f.ID)
+	}
+	if f.EstimatedMonthlyWaste == 0 {
+		t.Fatal("expected non-zero waste estimate")
+	}
+	if f.Metadata["deployment_type"] != "provisioned" {
+		t.Fatalf("expected deployment_type provisioned, got %v", f.Metadata["deployment_type"])
+	}
+}
+
+func TestRedshiftScanner_PauseableCluster(t *testing.T) {
+	mock := &mockRedshiftClient{clusters: []rstypes.Cluster{redshiftCluster("analytics-daytime")}}
+	// Connections only between 08:00 and 17:59 UTC.
+	metrics := newRedshiftMockMetrics(20, 7, func(hour int) float64 {
+		if hour >= 8 && hour < 18 {
+			return 4
+		}
+		return 0
+	})
+	scanner := NewRedshiftScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingRedshiftPauseable {
+		t.Fatalf("expected REDSHIFT_PAUSEABLE, got %s", f.ID)
+	}
+	if f.Metadata["quiet_hours_per_day"] != 14 {
+		t.Fatalf("expected 14 quiet hours, got %v", f.Metadata["quiet_hours_per_day"])
+	}
+	if f.Metadata["quiet_start_hour_utc"] != 18 {
+		t.Fatalf("expected quiet window to start at 18:00, got %v", f.Metadata["quiet_start_hour_utc"])
+	}
+}
+
+func TestRedshiftScanner_BusyCluster(t *testing.T) {
+	mock := &mockRedshiftClient{clusters: []rstypes.Cluster{redshiftCluster("analytics-prod")}}
+	metrics := newRedshiftMockMetrics(40, 7, func(int) float64 { return 3 })
+	scanner := NewRedshiftScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(result.Findings))
+	}
+}
+
+func TestRedshiftScanner_SkipsPausedCluster(t *testing.T) {
+	c := redshiftCluster("analytics-paused")
+	c.ClusterStatus = awssdk.String("paused")
+	mock := &mockRedshiftClient{clusters: []rstypes.Cluster{c}}
+	metrics := newRedshiftMockMetrics(0, 7, func(int) float64 { return 0 })
+	scanner := NewRedshiftScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, IdleCPUThreshold: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 1 || len(result.Findings) != 0 {
+		t.Fatalf("expected 1 scanned and no findings, got %d scanned, %d findings", result.ResourcesScanned, len(result.Findings))
+	}
+}
@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	r53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	route53FindingRegion = "global"
+	// route53DefaultRecordSets is the NS and SOA pair every hosted zone is created with.
+	route53DefaultRecordSets = 2
+)
+
+// Route53API is the minimal interface for Route53 operations.
+type Route53API interface {
+	ListHealthChecks(ctx context.Context, input *route53.ListHealthChecksInput, opts ...func(*route53.Options)) (*route53.ListHealthChecksOutput, error)
+	ListHostedZones(ctx context.Context, input *route53.ListHostedZonesInput, opts ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error)
+	ListResourceRecordSets(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+}
+
+// Route53Scanner detects unreferenced health checks and empty hosted zones.
+// Route53 is a global service, so the scanner runs once outside the per-region loop.
+type Route53Scanner struct {
+	client Route53API
+}
+
+// NewRoute53Scanner creates a scanner for Route53 health checks and hosted zones.
+func NewRoute53Scanner(client Route53API) *Route53Scanner {
+	return &Route53Scanner{client: client}
+}
+
+// Type returns the resource type.
+func (s *Route53Scanner) Type() ResourceType {
+	return ResourceRoute53
+}
+
+// Scan lists hosted zones and health checks and matches health checks against the
+// record sets that reference them.
+func (s *Route53Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	zones, err := s.listHostedZones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Route53 hosted zones: %w", err)
+	}
+	checks, err := s.listHealthChecks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Route53 health checks: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(zones) + len(checks)}
+
+	// Record sets are only needed to resolve health check references.
+	refs := make(map[string]int)
+	refsComplete := true
+	for _, z := range zones {
+		if len(checks) == 0 || derefInt64(z.ResourceRecordSetCount) <= route53DefaultRecordSets {
+			continue
+		}
+		if err := s.countHealthCheckRefs(ctx, deref(z.Id), refs); err != nil {
+			slog.Warn("Failed to list Route53 record sets", "zone", deref(z.Name), "error", err)
+			refsComplete = false
+		}
+	}
+	// Children of calculated health checks are in use even without a record set.
+	for _, hc := range checks {
+		if hc.HealthCheckConfig != nil {
+			for _, child := range hc.HealthCheckConfig.ChildHealthChecks {
+				refs[child]++
+			}
+		}
+	}
+
+	for _, z := range zones {
+		id := strings.TrimPrefix(deref(z.Id), "/hostedzone/")
+		if cfg.Exclude.ShouldExclude(id, nil) || z.LinkedService != nil {
+			continue
+		}
+		count := derefInt64(z.ResourceRecordSetCount)
+		if count > route53DefaultRecordSets {
+			continue
+		}
+		private := z.Config != nil && z.Config.PrivateZone
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRoute53EmptyZone,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceRoute53,
+			ResourceID:            id,
+			ResourceName:          deref(z.Name),
+			Region:                route53FindingRegion,
+			Message:               "Hosted zone contains only the default NS and SOA records",
+			EstimatedMonthlyWaste: pricing.MonthlyRoute53HostedZoneCost(),
+			Metadata: map[string]any{
+				"record_set_count": count,
+				"private_zone":     private,
+			},
+		})
+	}
+
+	// A failed record set listing could hide references, so skip health check findings.
+	if !refsComplete {
+		result.Errors = append(result.Errors, "route53: record sets incomplete, health check findings skipped")
+		return result, nil
+	}
+
+	for _, hc := range checks {
+		id := deref(hc.Id)
+		if cfg.Exclude.ShouldExclude(id, nil) || hc.LinkedService != nil {
+			continue
+		}
+		if refs[id] > 0 {
+			continue
+		}
+
+		meta := map[string]any{"referencing_record_count": refs[id]}
+		if c := hc.HealthCheckConfig; c != nil {
+			meta["type"] = string(c.Type)
+			if c.FullyQualifiedDomainName != nil {
+				meta["fqdn"] = deref(c.FullyQualifiedDomainName)
+			}
+			if c.IPAddress != nil {
+				meta["ip_address"] = deref(c.IPAddress)
+			}
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingRoute53UnusedHealthCheck,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceRoute53,
+			ResourceID:            id,
+			ResourceName:          id,
+			Region:                route53FindingRegion,
+			Message:               "Health check is not referenced by any record set or calculated health check",
+			EstimatedMonthlyWaste: pricing.MonthlyRoute53HealthCheckCost(),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *Route53Scanner) countHealthCheckRefs(ctx context.Context, zoneID string, refs map[string]int) error {
+	paginator := route53.NewListResourceRecordSetsPaginator(s.client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: &zoneID,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, rs := range page.ResourceRecordSets {
+			if rs.HealthCheckId != nil {
+				refs[*rs.HealthCheckId]++
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Route53Scanner) listHostedZones(ctx context.Context) ([]r53types.HostedZone, error) {
+	var zones []r53types.HostedZone
+	paginator := route53.NewListHostedZonesPaginator(s.client, &route53.ListHostedZonesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, page.HostedZones...)
+	}
+	return zones, nil
+}
+
+func (s *Route53Scanner) listHealthChecks(ctx context.Context) ([]r53types.HealthCheck, error) {
+	var checks []r53types.HealthCheck
+	paginator := route53.NewListHealthChecksPaginator(s.client, &route53.ListHealthChecksInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, page.HealthChecks...)
+	}
+	return checks, nil
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	r53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+type mockRoute53Client struct {
+	zones      []r53types.HostedZone
+	checks     []r53types.HealthCheck
+	recordSets map[string][]r53types.ResourceRecordSet
+	recordErr  error
+}
+
+func (m *mockRoute53Client) ListHealthChecks(_ context.Context, _ *route53.ListHealthChecksInput, _ ...func(*route53.Options)) (*route53.ListHealthChecksOutput, error) {
+	return &route53.ListHealthChecksOutput{HealthChecks: m.checks}, nil
+}
+
+func (m *mockRoute53Client) ListHostedZones(_ context.Context, _ *route53.ListHostedZonesInput, _ ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error) {
+	return &route53.ListHostedZonesOutput{HostedZones: m.zones}, nil
+}
+
+func (m *mockRoute53Client) ListResourceRecordSets(_ context.Context, input *route53.ListResourceRecordSetsInput, _ ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+	if m.recordErr != nil {
+		return nil, m.recordErr
+	}
+	return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: m.recordSets[*input.HostedZoneId]}, nil
+}
+
+func hostedZone(id, name string, records int64) r53types.HostedZone {
+	return r53types.HostedZone{
+		Id:                     awssdk.String("/hostedzone/" + id),
+		Name:                   awssdk.String(name),
+		ResourceRecordSetCount: awssdk.Int64(records),
+	}
+}
+
+func healthCheck(id string, children ...string) r53types.HealthCheck {
+	hcType := r53types.HealthCheckTypeHttps
+	if len(children) > 0 {
+		hcType = r53types.HealthCheckTypeCalculated
+	}
+	return r53types.HealthCheck{
+		Id: awssdk.String(id),
+		HealthCheckConfig: &r53types.HealthCheckConfig{
+			Type:              hcType,
+			ChildHealthChecks: children,
+		},
+	}
+}
+
+func TestRoute53Scanner_UnusedHealthChecksAndEmptyZones(t *testing.T) {
+	mock := &mockRoute53Client{
+		zones: []r53types.HostedZone{
+			hostedZone("ZEMPTY", "empty.example.com.", 2),
+			hostedZone("ZUSED", "example.com.", 5),
+		},
+		checks: []r53types.HealthCheck{
+			healthCheck("hc-record"),
+			healthCheck("hc-child"),
+			healthCheck("hc-calculated", "hc-child"),
+			healthCheck("hc-orphan"),
+		},
+		recordSets: map[string][]r53types.ResourceRecordSet{
+			"/hostedzone/ZUSED": {
+				{Name: awssdk.String("api.example.com."), Type: r53types.RRTypeA, HealthCheckId: awssdk.String("hc-record")},
+				{Name: awssdk.String("www.example.com."), Type: r53types.RRTypeA, HealthCheckId: awssdk.String("hc-calculated")},
+			},
+		},
+	}
+	scanner := NewRoute53Scanner(mock)
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 6 {
+		t.Fatalf("expected 6 resources scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %+v", len(result.Findings), result.Findings)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	zone := byID["ZEMPTY"]
+	if zone.ID != FindingRoute53EmptyZone || zone.Region != "global" {
+		t.Fatalf("unexpected zone finding: %+v", zone)
+	}
+	if zone.EstimatedMonthlyWaste != 0.50 {
+		t.Fatalf("expected $0.50 hosted zone waste, got $%.2f", zone.EstimatedMonthlyWaste)
+	}
+	hc := byID["hc-orphan"]
+	if hc.ID != FindingRoute53UnusedHealthCheck {
+		t.Fatalf("expected ROUTE53_UNUSED_HEALTHCHECK for hc-orphan, got %+v", hc)
+	}
+	if hc.Metadata["referencing_record_count"] != 0 || hc.Metadata["type"] != "HTTPS" {
+		t.Fatalf("unexpected health check metadata: %v", hc.Metadata)
+	}
+}
+
+func TestRoute53Scanner_RecordSetErrorSkipsHealthChecks(t *testing.T) {
+	mock := &mockRoute53Client{
+		zones:     []r53types.HostedZone{hostedZone("ZUSED", "example.com.", 5)},
+		checks:    []r53types.HealthCheck{healthCheck("hc-1")},
+		recordErr: errors.New("throttled"),
+	}
+	scanner := NewRoute53Scanner(mock)
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no health check findings when record sets are incomplete, got %d", len(result.Findings))
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %v", result.Errors)
+	}
+}
@@ -0,0 +1,422 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	s3Namespace = "AWS/S3"
+	// s3NoLifecycleMinGiB is the Standard-class size below which a missing lifecycle policy is not worth reporting.
+	s3NoLifecycleMinGiB = 10
+	// s3StorageClassMinGiB is the Standard-class size below which a storage-class change is not worth reporting.
+	s3StorageClassMinGiB = 100
+	// s3ColdReadsPerGiB is the GET requests per GiB over the idle window below which a bucket is treated as cold.
+	s3ColdReadsPerGiB = 1.0
+	// s3MaxSizedUploads caps the stale multipart uploads per bucket whose parts are listed for sizing.
+	s3MaxSizedUploads = 50
+	bytesPerGiB       = 1024 * 1024 * 1024
+)
+
+// S3API is the minimal interface for S3 operations.
+type S3API interface {
+	ListBuckets(ctx context.Context, input *s3.ListBucketsInput, opts ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+	GetBucketLifecycleConfiguration(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
+	GetBucketTagging(ctx context.Context, input *s3.GetBucketTaggingInput, opts ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+	ListMultipartUploads(ctx context.Context, input *s3.ListMultipartUploadsInput, opts ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)
+	ListParts(ctx context.Context, input *s3.ListPartsInput, opts ...func(*s3.Options)) (*s3.ListPartsOutput, error)
+}
+
+// S3Scanner detects empty buckets, large buckets without lifecycle rules, cold
+// Standard storage, and abandoned multipart uploads.
+type S3Scanner struct {
+	client  S3API
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewS3Scanner creates a scanner for S3 buckets.
+func NewS3Scanner(client S3API, metrics *MetricsFetcher, region string) *S3Scanner {
+	return &S3Scanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *S3Scanner) Type() ResourceType {
+	return ResourceS3
+}
+
+// Scan examines the buckets located in this scanner's region. The bucket list is
+// account-wide, so it is filtered server-side by bucket region to keep each bucket
+// reported exactly once across a multi-region scan.
+func (s *S3Scanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	buckets, err := s.listBuckets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list S3 buckets: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(buckets)}
+	if len(buckets) == 0 {
+		return result, nil
+	}
+
+	var names []string
+	bucketMap := make(map[string]s3types.Bucket, len(buckets))
+	tagsByBucket := make(map[string]map[string]string, len(buckets))
+	for _, b := range buckets {
+		name := deref(b.Name)
+		if cfg.Exclude.ShouldExclude(name, nil) {
+			continue
+		}
+		tags, err := s.bucketTags(ctx, name)
+		if err != nil {
+			slog.Debug("Failed to get S3 bucket tags", "bucket", name, "error", err)
+		}
+		if cfg.Exclude.ShouldExclude(name, tags) {
+			continue
+		}
+		names = append(names, name)
+		bucketMap[name] = b
+		tagsByBucket[name] = tags
+	}
+
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	// Parts of incomplete uploads are billed but not counted in the storage metrics below.
+	uploadCutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+	for _, name := range names {
+		f, ok, err := s.incompleteMultipartFinding(ctx, name, uploadCutoff)
+		if err != nil {
+			slog.Warn("Failed to list S3 multipart uploads", "bucket", name, "error", err)
+			continue
+		}
+		if ok {
+			result.Findings = append(result.Findings, f)
+		}
+	}
+
+	// S3 storage metrics are published daily per storage class.
+	sizeMap, err := s.metrics.FetchAverageWithStaticDim(ctx, s3Namespace, "BucketSizeBytes", "BucketName", names, cfg.IdleDays, s3StorageTypeDim("StandardStorage"))
+	if err != nil {
+		slog.Warn("Failed to fetch S3 size metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+	objectMap, err := s.metrics.FetchAverageWithStaticDim(ctx, s3Namespace, "NumberOfObjects", "BucketName", names, cfg.IdleDays, s3StorageTypeDim("AllStorageTypes"))
+	if err != nil {
+		slog.Warn("Failed to fetch S3 object count metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	readMap := s.fetchColdCandidateReads(ctx, names, sizeMap, cfg.IdleDays)
+
+	now := time.Now().UTC()
+	for _, name := range names {
+		b := bucketMap[name]
+		// S3 publishes no storage metrics for empty buckets, so a missing count means zero objects.
+		objects := objectMap[name]
+		sizeBytes := sizeMap[name]
+
+		// S3_EMPTY_BUCKET: no objects and older than the stale threshold
+		if objects == 0 {
+			if b.CreationDate == nil {
+				continue
+			}
+			ageDays := int(now.Sub(*b.CreationDate).Hours() / 24)
+			if ageDays < cfg.StaleDays {
+				continue
+			}
+			result.Findings = append(result.Findings, Finding{
+				ID:           FindingS3EmptyBucket,
+				Severity:     SeverityLow,
+				ResourceType: ResourceS3,
+				ResourceID:   name,
+				ResourceName: name,
+				Region:       s.region,
+				Message:      fmt.Sprintf("Bucket has no objects, created %d days ago", ageDays),
+				Hygiene:      true,
+				Metadata: map[string]any{
+					"age_days": ageDays,
+				},
+			})
+			continue
+		}
+
+		// S3_NO_LIFECYCLE: non-trivial Standard storage with no expiration or transition rules
+		sizeGiB := int(sizeBytes / bytesPerGiB)
+		if sizeGiB < s3NoLifecycleMinGiB {
+			continue
+		}
+		hasRules, err := s.hasLifecycleRules(ctx, name)
+		if err != nil {
+			slog.Warn("Failed to get S3 lifecycle configuration", "bucket", name, "error", err)
+			continue
+		}
+		if hasRules {
+			continue
+		}
+
+		// S3_STORAGE_CLASS_OPPORTUNITY replaces S3_NO_LIFECYCLE when request metrics show the data is rarely read.
+		if reads, ok := readMap[name]; ok && reads < s3ColdReadsPerGiB*float64(sizeGiB) {
+			result.Findings = append(result.Findings, s.storageClassFinding(ctx, name, sizeGiB, reads, cfg.IdleDays))
+			continue
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingS3NoLifecycle,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceS3,
+			ResourceID:            name,
+			ResourceName:          name,
+			Region:                s.region,
+			Message:               fmt.Sprintf("%d GiB in Standard storage with no expiration or transition rules", sizeGiB),
+			EstimatedMonthlyWaste: pricing.MonthlyS3StandardCost(sizeGiB, s.region),
+			Metadata: map[string]any{
+				"standard_size_gib": sizeGiB,
+				"object_count":      objects,
+			},
+		})
+	}
+
+	for _, f := range result.Findings {
+		setTagsMetadata(f.Metadata, tagsByBucket[f.ResourceID])
+	}
+	return result, nil
+}
+
+// fetchColdCandidateReads returns GET request counts over the idle window for buckets
+// large enough to be worth a storage-class change. Request metrics exist only for buckets
+// with an "EntireBucket" request metrics filter; other buckets are absent from the map.
+func (s *S3Scanner) fetchColdCandidateReads(ctx context.Context, names []string, sizeMap map[string]float64, idleDays int) map[string]float64 {
+	var candidates []string
+	for _, name := range names {
+		if int(sizeMap[name]/bytesPerGiB) >= s3StorageClassMinGiB {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	filter := []cwtypes.Dimension{{Name: awssdk.String("FilterId"), Value: awssdk.String("EntireBucket")}}
+	reads, err := s.metrics.FetchSumWithStaticDim(ctx, s3Namespace, "GetRequests", "BucketName", candidates, idleDays, filter)
+	if err != nil {
+		slog.Warn("Failed to fetch S3 request metrics", "region", s.region, "error", err)
+		return nil
+	}
+	return reads
+}
+
+// s3StorageClasses are the StorageType dimension values reported in the storage-class breakdown.
+var s3StorageClasses = []string{
+	"StandardStorage",
+	"StandardIAStorage",
+	"OneZoneIAStorage",
+	"IntelligentTieringFAStorage",
+	"IntelligentTieringIAStorage",
+	"GlacierInstantRetrievalStorage",
+	"GlacierStorage",
+	"DeepArchiveStorage",
+}
+
+// storageClassFinding recommends moving a rarely read bucket's Standard data to
+// Intelligent-Tiering or Standard-IA. Savings are the Standard minus Standard-IA
+// storage price for the Standard portion, before retrieval charges.
+func (s *S3Scanner) storageClassFinding(ctx context.Context, name string, standardGiB int, reads float64, idleDays int) Finding {
+	breakdown := make(map[string]int)
+	for _, class := range s3StorageClasses {
+		sizes, err := s.metrics.FetchAverageWithStaticDim(ctx, s3Namespace, "BucketSizeBytes", "BucketName", []string{name}, idleDays, s3StorageTypeDim(class))
+		if err != nil {
+			slog.Debug("Failed to fetch S3 storage class size", "bucket", name, "class", class, "error", err)
+			continue
+		}
+		if gib := int(sizes[name] / bytesPerGiB); gib > 0 {
+			breakdown[class] = gib
+		}
+	}
+
+	savings := pricing.MonthlyS3StandardCost(standardGiB, s.region) - pricing.MonthlyS3StandardIACost(standardGiB, s.region)
+	return Finding{
+		ID:                    FindingS3StorageClassOpportunity,
+		Severity:              SeverityLow,
+		ResourceType:          ResourceS3,
+		ResourceID:            name,
+		ResourceName:          name,
+		Region:                s.region,
+		Message:               fmt.Sprintf("%d GiB in Standard storage read %.0f times over %d days; move to Intelligent-Tiering or Standard-IA", standardGiB, reads, idleDays),
+		EstimatedMonthlyWaste: savings,
+		Metadata: map[string]any{
+			"standard_size_gib":         standardGiB,
+			"get_requests":              reads,
+			"storage_class_gib":         breakdown,
+			"projected_monthly_savings": savings,
+		},
+	}
+}
+
+// incompleteMultipartFinding reports multipart uploads in a bucket initiated before the
+// cutoff. Waste is the size of their uploaded parts at the Standard storage rate; parts
+// are listed for at most s3MaxSizedUploads uploads per bucket.
+func (s *S3Scanner) incompleteMultipartFinding(ctx context.Context, bucket string, cutoff time.Time) (Finding, bool, error) {
+	var stale []s3types.MultipartUpload
+	paginator := s3.NewListMultipartUploadsPaginator(s.client, &s3.ListMultipartUploadsInput{
+		Bucket: &bucket,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return Finding{}, false, err
+		}
+		for _, u := range page.Uploads {
+			if u.Initiated != nil && u.Initiated.Before(cutoff) {
+				stale = append(stale, u)
+			}
+		}
+	}
+	if len(stale) == 0 {
+		return Finding{}, false, nil
+	}
+
+	oldest := *stale[0].Initiated
+	var sizeBytes int64
+	sized := 0
+	for _, u := range stale {
+		if u.Initiated.Before(oldest) {
+			oldest = *u.Initiated
+		}
+		if sized == s3MaxSizedUploads {
+			continue
+		}
+		n, err := s.uploadedBytes(ctx, bucket, u)
+		if err != nil {
+			slog.Debug("Failed to list S3 multipart upload parts", "bucket", bucket, "key", deref(u.Key), "error", err)
+			continue
+		}
+		sizeBytes += n
+		sized++
+	}
+
+	sizeGiB := float64(sizeBytes) / bytesPerGiB
+	cost := pricing.MonthlyS3StandardCost(int(math.Round(sizeGiB)), s.region)
+	ageDays := int(time.Since(oldest).Hours() / 24)
+	return Finding{
+		ID:                    FindingS3IncompleteMultipart,
+		Severity:              SeverityLow,
+		ResourceType:          ResourceS3,
+		ResourceID:            bucket,
+		ResourceName:          bucket,
+		Region:                s.region,
+		Message:               fmt.Sprintf("%d incomplete multipart uploads (%.1f GiB of parts), oldest started %d days ago; add an AbortIncompleteMultipartUpload lifecycle rule", len(stale), sizeGiB, ageDays),
+		EstimatedMonthlyWaste: cost,
+		Hygiene:               cost == 0, // parts too small to price still need a lifecycle rule
+		Metadata: map[string]any{
+			"upload_count":     len(stale),
+			"sized_uploads":    sized,
+			"parts_size_gib":   sizeGiB,
+			"oldest_initiated": oldest.UTC().Format(time.RFC3339),
+		},
+	}, true, nil
+}
+
+// uploadedBytes sums the sizes of the parts uploaded so far for one multipart upload.
+func (s *S3Scanner) uploadedBytes(ctx context.Context, bucket string, u s3types.MultipartUpload) (int64, error) {
+	var total int64
+	paginator := s3.NewListPartsPaginator(s.client, &s3.ListPartsInput{
+		Bucket:   &bucket,
+		Key:      u.Key,
+		UploadId: u.UploadId,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range page.Parts {
+			total += awssdk.ToInt64(p.Size)
+		}
+	}
+	return total, nil
+}
+
+// hasLifecycleRules reports whether a bucket has an enabled rule that expires or transitions objects.
+func (s *S3Scanner) hasLifecycleRules(ctx context.Context, bucket string) (bool, error) {
+	out, err := s.client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		if s3ErrorCode(err) == "NoSuchLifecycleConfiguration" {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, rule := range out.Rules {
+		if rule.Status != s3types.ExpirationStatusEnabled {
+			continue
+		}
+		if rule.Expiration != nil || len(rule.Transitions) > 0 ||
+			rule.NoncurrentVersionExpiration != nil || len(rule.NoncurrentVersionTransitions) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *S3Scanner) bucketTags(ctx context.Context, bucket string) (map[string]string, error) {
+	out, err := s.client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		if s3ErrorCode(err) == "NoSuchTagSet" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	m := make(map[string]string, len(out.TagSet))
+	for _, t := range out.TagSet {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m, nil
+}
+
+func (s *S3Scanner) listBuckets(ctx context.Context) ([]s3types.Bucket, error) {
+	var buckets []s3types.Bucket
+	paginator := s3.NewListBucketsPaginator(s.client, &s3.ListBucketsInput{
+		BucketRegion: awssdk.String(s.region),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, page.Buckets...)
+	}
+	return buckets, nil
+}
+
+func s3StorageTypeDim(storageType string) []cwtypes.Dimension {
+	return []cwtypes.Dimension{{Name: awssdk.String("StorageType"), Value: awssdk.String(storageType)}}
+}
+
+// s3ErrorCode returns the service error code of an S3 API error, or "" for other errors.
+func s3ErrorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return ""
+}
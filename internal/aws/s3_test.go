@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+type mockS3Client struct {
+	buckets      []s3types.Bucket
+	lifecycles   map[string][]s3types.LifecycleRule
+	tags         map[string][]s3types.Tag
+	uploads      map[string][]s3types.MultipartUpload
+	parts        map[string][]int64 // upload ID -> part sizes in bytes
+	listedRegion string
+}
+
+func (m *mockS3Client) ListBuckets(_ context.Context, input *s3.ListBucketsInput, _ ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	m.listedRegion = deref(input.BucketRegion)
+	return &s3.ListBucketsOutput{Buckets: m.buckets}, nil
+}
+
+func (m *mockS3Client) GetBucketLifecycleConfiguration(_ context.Context, input *s3.GetBucketLifecycleConfigurationInput, _ ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	rules, ok := m.lifecycles[*input.Bucket]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	}
+	return &s3.GetBucketLifecycleConfigurationOutput{Rules: rules}, nil
+}
+
+func (m *mockS3Client) GetBucketTagging(_ context.Context, input *s3.GetBucketTaggingInput, _ ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	tags, ok := m.tags[*input.Bucket]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+	}
+	return &s3.GetBucketTaggingOutput{TagSet: tags}, nil
+}
+
+func (m *mockS3Client) ListMultipartUploads(_ context.Context, input *s3.ListMultipartUploadsInput, _ ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	return &s3.ListMultipartUploadsOutput{Uploads: m.uploads[*input.Bucket]}, nil
+}
+
+func (m *mockS3Client) ListParts(_ context.Context, input *s3.ListPartsInput, _ ...func(*s3.Options)) (*s3.ListPartsOutput, error) {
+	var parts []s3types.Part
+	for _, size := range m.parts[*input.UploadId] {
+		parts = append(parts, s3types.Part{Size: awssdk.Int64(size)})
+	}
+	return &s3.ListPartsOutput{Parts: parts}, nil
+}
+
+func multipartUpload(key, uploadID string, ageDays int) s3types.MultipartUpload {
+	return s3types.MultipartUpload{
+		Key:       awssdk.String(key),
+		UploadId:  awssdk.String(uploadID),
+		Initiated: awssdk.Time(time.Now().Add(-time.Duration(ageDays) * 24 * time.Hour)),
+	}
+}
+
+func s3Bucket(name string, ageDays int) s3types.Bucket {
+	return s3types.Bucket{
+		Name:         awssdk.String(name),
+		CreationDate: awssdk.Time(time.Now().Add(-time.Duration(ageDays) * 24 * time.Hour)),
+	}
+}
+
+// newS3MockMetrics serves BucketSizeBytes and NumberOfObjects per bucket name.
+func newS3MockMetrics(sizes, objects map[string]float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				bucket := *q.MetricStat.Metric.Dimensions[0].Value
+				var values map[string]float64
+				switch *q.MetricStat.Metric.MetricName {
+				case "BucketSizeBytes":
+					values = sizes
+				case "NumberOfObjects":
+					values = objects
+				}
+				if v, ok := values[bucket]; ok {
+					results = append(results, cwtypes.MetricDataResult{
+						Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+						Values: []float64{v},
+					})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestS3Scanner_EmptyBucket(t *testing.T) {
+	mock := &mockS3Client{buckets: []s3types.Bucket{s3Bucket("old-empty", 200), s3Bucket("new-empty", 3)}}
+	scanner := NewS3Scanner(mock, newS3MockMetrics(nil, nil), "eu-west-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.listedRegion != "eu-west-1" {
+		t.Fatalf("expected bucket listing filtered to eu-west-1, got %q", mock.listedRegion)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingS3EmptyBucket || f.ResourceID != "old-empty" {
+		t.Fatalf("expected S3_EMPTY_BUCKET for old-empty, got %s for %s", f.ID, f.ResourceID)
+	}
+	if !f.Hygiene {
+		t.Fatal("expected empty bucket finding to be hygiene")
+	}
+}
+
+func TestS3Scanner_NoLifecycle(t *testing.T) {
+	mock := &mockS3Client{
+		buckets: []s3types.Bucket{s3Bucket("logs", 400), s3Bucket("archive", 400), s3Bucket("tiny", 400)},
+		lifecycles: map[string][]s3types.LifecycleRule{
+			"archive": {{
+				Status:      s3types.ExpirationStatusEnabled,
+				Transitions: []s3types.Transition{{Days: awssdk.Int32(30), StorageClass: s3types.TransitionStorageClassGlacier}},
+			}},
+		},
+	}
+	metrics := newS3MockMetrics(
+		map[string]float64{"logs": 500 * bytesPerGiB, "archive": 500 * bytesPerGiB, "tiny": 1 * bytesPerGiB},
+		map[string]float64{"logs": 1000, "archive": 1000, "tiny": 10},
+	)
+	scanner := NewS3Scanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingS3NoLifecycle || f.ResourceID != "logs" {
+		t.Fatalf("expected S3_NO_LIFECYCLE for logs, got %s for %s", f.ID, f.ResourceID)
+	}
+	// 500 GiB * $0.023 = $11.50
+	if f.EstimatedMonthlyWaste < 11.4 || f.EstimatedMonthlyWaste > 11.6 {
+		t.Fatalf("expected ~$11.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+}
+
+func TestS3Scanner_DisabledLifecycleRuleIgnored(t *testing.T) {
+	mock := &mockS3Client{
+		buckets: []s3types.Bucket{s3Bucket("data", 400)},
+		lifecycles: map[string][]s3types.LifecycleRule{
+			"data": {{
+				Status:     s3types.ExpirationStatusDisabled,
+				Expiration: &s3types.LifecycleExpiration{Days: awssdk.Int32(30)},
+			}},
+		},
+	}
+	metrics := newS3MockMetrics(map[string]float64{"data": 50 * bytesPerGiB}, map[string]float64{"data": 10})
+	scanner := NewS3Scanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ID != FindingS3NoLifecycle {
+		t.Fatalf("expected S3_NO_LIFECYCLE for bucket with only a disabled rule, got %+v", result.Findings)
+	}
+}
+
+func TestS3Scanner_ExcludedByTag(t *testing.T) {
+	mock := &mockS3Client{
+		buckets: []s3types.Bucket{s3Bucket("keep-me", 200)},
+		tags: map[string][]s3types.Tag{
+			"keep-me": {{Key: awssdk.String("keep"), Value: awssdk.String("true")}},
+		},
+	}
+	scanner := NewS3Scanner(mock, newS3MockMetrics(nil, nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays:  7,
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{Tags: map[string]string{"keep": "true"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded bucket to produce no findings, got %d", len(result.Findings))
+	}
+}
+
+func TestS3Scanner_IncompleteMultipart(t *testing.T) {
+	mock := &mockS3Client{
+		buckets: []s3types.Bucket{s3Bucket("uploads", 400), s3Bucket("clean", 400)},
+		uploads: map[string][]s3types.MultipartUpload{
+			"uploads": {
+				multipartUpload("backups/a.tar", "u-old", 200),
+				multipartUpload("backups/b.tar", "u-older", 300),
+				multipartUpload("backups/c.tar", "u-fresh", 2),
+			},
+			"clean": {multipartUpload("in-flight.bin", "u-new", 1)},
+		},
+		parts: map[string][]int64{
+			"u-old":   {50 * bytesPerGiB, 30 * bytesPerGiB},
+			"u-older": {20 * bytesPerGiB},
+			"u-fresh": {500 * bytesPerGiB},
+		},
+	}
+	sizes := map[string]float64{"uploads": 1 * bytesPerGiB, "clean": 1 * bytesPerGiB}
+	objects := map[string]float64{"uploads": 10, "clean": 10}
+	scanner := NewS3Scanner(mock, newS3MockMetrics(sizes, objects), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %+v", len(result.Findings), result.Findings)
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingS3IncompleteMultipart || f.ResourceID != "uploads" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// 100 GiB of stale parts at $0.023/GiB; the fresh upload is not counted.
+	if f.EstimatedMonthlyWaste < 2.29 || f.EstimatedMonthlyWaste > 2.31 {
+		t.Fatalf("expected ~$2.30, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["upload_count"] != 2 {
+		t.Fatalf("expected 2 stale uploads, got %v", f.Metadata["upload_count"])
+	}
+	oldest, err := time.Parse(time.RFC3339, f.Metadata["oldest_initiated"].(string))
+	if err != nil {
+		t.Fatalf("unexpected oldest_initiated: %v", f.Metadata["oldest_initiated"])
+	}
+	if days := int(time.Since(oldest).Hours() / 24); days != 300 {
+		t.Fatalf("expected oldest upload 300 days old, got %d", days)
+	}
+}
+
+// newS3ClassMetrics serves sizes keyed by StorageType, object counts, and GET request counts.
+func newS3ClassMetrics(bucket string, classSizes map[string]float64, objects float64, reads *float64) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				var value float64
+				var ok bool
+				switch *q.MetricStat.Metric.MetricName {
+				case "BucketSizeBytes":
+					value, ok = classSizes[*q.MetricStat.Metric.Dimensions[1].Value]
+				case "NumberOfObjects":
+					value, ok = objects, true
+				case "GetRequests":
+					if reads != nil {
+						value, ok = *reads, true
+					}
+				}
+				if ok && *q.MetricStat.Metric.Dimensions[0].Value == bucket {
+					results = append(results, cwtypes.MetricDataResult{
+						Id:     awssdk.String(fmt.Sprintf("m%d", i)),
+						Values: []float64{value},
+					})
+				}
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestS3Scanner_StorageClassOpportunity(t *testing.T) {
+	classSizes := map[string]float64{
+		"StandardStorage": 1000 * bytesPerGiB,
+		"GlacierStorage":  200 * bytesPerGiB,
+	}
+	mock := &mockS3Client{buckets: []s3types.Bucket{s3Bucket("cold-data", 400)}}
+	reads := 12.0
+	scanner := NewS3Scanner(mock, newS3ClassMetrics("cold-data", classSizes, 5000, &reads), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %+v", len(result.Findings), result.Findings)
+	}
+	f := result.Findings[0]
+	if f.ID != FindingS3StorageClassOpportunity {
+		t.Fatalf("expected S3_STORAGE_CLASS_OPPORTUNITY, got %s", f.ID)
+	}
+	// 1000 GiB * ($0.023 - $0.0125) = $10.50
+	if f.EstimatedMonthlyWaste < 10.49 || f.EstimatedMonthlyWaste > 10.51 {
+		t.Fatalf("expected ~$10.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	breakdown, ok := f.Metadata["storage_class_gib"].(map[string]int)
+	if !ok || breakdown["StandardStorage"] != 1000 || breakdown["GlacierStorage"] != 200 || len(breakdown) != 2 {
+		t.Fatalf("unexpected storage class breakdown: %v", f.Metadata["storage_class_gib"])
+	}
+}
+
+func TestS3Scanner_StorageClassNeedsRequestMetrics(t *testing.T) {
+	classSizes := map[string]float64{"StandardStorage": 1000 * bytesPerGiB}
+	mock := &mockS3Client{buckets: []s3types.Bucket{s3Bucket("unknown-access", 400)}}
+	scanner := NewS3Scanner(mock, newS3ClassMetrics("unknown-access", classSizes, 5000, nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ID != FindingS3NoLifecycle {
+		t.Fatalf("expected S3_NO_LIFECYCLE without request metrics, got %+v", result.Findings)
+	}
+}
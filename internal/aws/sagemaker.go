@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// SageMakerAPI is the minimal interface for SageMaker operations.
+type SageMakerAPI interface {
+	ListNotebookInstances(ctx context.Context, input *sagemaker.ListNotebookInstancesInput, opts ...func(*sagemaker.Options)) (*sagemaker.ListNotebookInstancesOutput, error)
+	ListEndpoints(ctx context.Context, input *sagemaker.ListEndpointsInput, opts ...func(*sagemaker.Options)) (*sagemaker.ListEndpointsOutput, error)
+	DescribeEndpoint(ctx context.Context, input *sagemaker.DescribeEndpointInput, opts ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointOutput, error)
+	DescribeEndpointConfig(ctx context.Context, input *sagemaker.DescribeEndpointConfigInput, opts ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointConfigOutput, error)
+}
+
+// SageMakerScanner detects long-running notebook instances and endpoints with no invocations.
+type SageMakerScanner struct {
+	client  SageMakerAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewSageMakerScanner creates a scanner for SageMaker notebooks and endpoints.
+func NewSageMakerScanner(client SageMakerAPI, metrics *MetricsFetcher, region string) *SageMakerScanner {
+	return &SageMakerScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *SageMakerScanner) Type() ResourceType {
+	return ResourceSageMaker
+}
+
+// Scan examines InService notebook instances and real-time endpoints.
+func (s *SageMakerScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	notebooks, err := s.listNotebooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list SageMaker notebook instances: %w", err)
+	}
+	endpoints, err := s.listEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list SageMaker endpoints: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(notebooks) + len(endpoints)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	// Notebook instances publish no CloudWatch metrics without an agent, so one that has
+	// stayed InService, unmodified and never stopped, for the whole idle window is flagged.
+	for _, nb := range notebooks {
+		name := deref(nb.NotebookInstanceName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(nb.NotebookInstanceArn), nil) {
+			continue
+		}
+		if nb.LastModifiedTime != nil && nb.LastModifiedTime.After(cutoff) {
+			continue
+		}
+		instanceType := string(nb.InstanceType)
+		meta := map[string]any{
+			"instance_type": instanceType,
+		}
+		if lc := deref(nb.NotebookInstanceLifecycleConfigName); lc != "" {
+			meta["lifecycle_config"] = lc
+		}
+		if nb.LastModifiedTime != nil {
+			meta["last_modified"] = nb.LastModifiedTime.UTC().Format(time.RFC3339)
+		}
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingSageMakerIdleNotebook,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceSageMaker,
+			ResourceID:            name,
+			ResourceName:          deref(nb.NotebookInstanceArn),
+			Region:                s.region,
+			Message:               fmt.Sprintf("Notebook instance (%s) InService for over %d days without a stop", instanceType, cfg.IdleDays),
+			EstimatedMonthlyWaste: pricing.MonthlySageMakerCost(instanceType, s.region),
+			Metadata:              meta,
+		})
+	}
+
+	for _, ep := range endpoints {
+		name := deref(ep.EndpointName)
+		if cfg.Exclude.ShouldExclude(name, nil) || cfg.Exclude.ShouldExclude(deref(ep.EndpointArn), nil) {
+			continue
+		}
+		// Endpoints created within the idle window have not had time to see traffic.
+		if ep.CreationTime != nil && ep.CreationTime.After(cutoff) {
+			continue
+		}
+		f, ok, err := s.endpointFinding(ctx, ep, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to evaluate SageMaker endpoint", "endpoint", name, "error", err)
+			continue
+		}
+		if ok {
+			result.Findings = append(result.Findings, f)
+		}
+	}
+
+	return result, nil
+}
+
+// endpointFinding sums Invocations across an endpoint's variants and prices the
+// instances behind them. Serverless variants have no idle cost.
+func (s *SageMakerScanner) endpointFinding(ctx context.Context, ep smtypes.EndpointSummary, idleDays int) (Finding, bool, error) {
+	name := deref(ep.EndpointName)
+	desc, err := s.client.DescribeEndpoint(ctx, &sagemaker.DescribeEndpointInput{EndpointName: &name})
+	if err != nil {
+		return Finding{}, false, err
+	}
+	// Asynchronous endpoints report InvocationsProcessed rather than Invocations.
+	if desc.AsyncInferenceConfig != nil || len(desc.ProductionVariants) == 0 {
+		return Finding{}, false, nil
+	}
+
+	cfgOut, err := s.client.DescribeEndpointConfig(ctx, &sagemaker.DescribeEndpointConfigInput{EndpointConfigName: desc.EndpointConfigName})
+	if err != nil {
+		return Finding{}, false, err
+	}
+	instanceTypes := make(map[string]string, len(cfgOut.ProductionVariants))
+	for _, v := range cfgOut.ProductionVariants {
+		instanceTypes[deref(v.VariantName)] = string(v.InstanceType)
+	}
+
+	var variants []string
+	for _, v := range desc.ProductionVariants {
+		variants = append(variants, deref(v.VariantName))
+	}
+	staticDims := []cwtypes.Dimension{{Name: awssdk.String("EndpointName"), Value: awssdk.String(name)}}
+	invocations, err := s.metrics.FetchSumWithStaticDim(ctx, "AWS/SageMaker", "Invocations", "VariantName", variants, idleDays, staticDims)
+	if err != nil {
+		return Finding{}, false, err
+	}
+
+	var total float64
+	var cost float64
+	instances := 0
+	var types []string
+	for _, v := range desc.ProductionVariants {
+		variant := deref(v.VariantName)
+		total += invocations[variant]
+		if v.CurrentServerlessConfig != nil {
+			continue
+		}
+		count := int(derefInt32(v.CurrentInstanceCount))
+		instanceType := instanceTypes[variant]
+		instances += count
+		types = append(types, instanceType)
+		cost += float64(count) * pricing.MonthlySageMakerCost(instanceType, s.region)
+	}
+	if total > 0 {
+		return Finding{}, false, nil
+	}
+
+	return Finding{
+		ID:                    FindingSageMakerIdleEndpoint,
+		Severity:              SeverityHigh,
+		ResourceType:          ResourceSageMaker,
+		ResourceID:            name,
+		ResourceName:          deref(ep.EndpointArn),
+		Region:                s.region,
+		Message:               fmt.Sprintf("Zero invocations over %d days (%d variants, %d instances)", idleDays, len(variants), instances),
+		EstimatedMonthlyWaste: cost,
+		Hygiene:               instances == 0, // serverless-only endpoints bill per request
+		Metadata: map[string]any{
+			"variant_count":  len(variants),
+			"instance_count": instances,
+			"instance_types": types,
+		},
+	}, true, nil
+}
+
+func (s *SageMakerScanner) listNotebooks(ctx context.Context) ([]smtypes.NotebookInstanceSummary, error) {
+	var notebooks []smtypes.NotebookInstanceSummary
+	paginator := sagemaker.NewListNotebookInstancesPaginator(s.client, &sagemaker.ListNotebookInstancesInput{
+		StatusEquals: smtypes.NotebookInstanceStatusInService,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		notebooks = append(notebooks, page.NotebookInstances...)
+	}
+	return notebooks, nil
+}
+
+func (s *SageMakerScanner) listEndpoints(ctx context.Context) ([]smtypes.EndpointSummary, error) {
+	var endpoints []smtypes.EndpointSummary
+	paginator := sagemaker.NewListEndpointsPaginator(s.client, &sagemaker.ListEndpointsInput{
+		StatusEquals: smtypes.EndpointStatusInService,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, page.Endpoints...)
+	}
+	return endpoints, nil
+}
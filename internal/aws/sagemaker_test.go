@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+)
+
+type mockSageMakerClient struct {
+	notebooks []smtypes.NotebookInstanceSummary
+	endpoints []smtypes.EndpointSummary
+	describe  map[string]*sagemaker.DescribeEndpointOutput
+	configs   map[string]*sagemaker.DescribeEndpointConfigOutput
+}
+
+func (m *mockSageMakerClient) ListNotebookInstances(_ context.Context, _ *sagemaker.ListNotebookInstancesInput, _ ...func(*sagemaker.Options)) (*sagemaker.ListNotebookInstancesOutput, error) {
+	return &sagemaker.ListNotebookInstancesOutput{NotebookInstances: m.notebooks}, nil
+}
+
+func (m *mockSageMakerClient) ListEndpoints(_ context.Context, _ *sagemaker.ListEndpointsInput, _ ...func(*sagemaker.Options)) (*sagemaker.ListEndpointsOutput, error) {
+	return &sagemaker.ListEndpointsOutput{Endpoints: m.endpoints}, nil
+}
+
+func (m *mockSageMakerClient) DescribeEndpoint(_ context.Context, input *sagemaker.DescribeEndpointInput, _ ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointOutput, error) {
+	return m.describe[*input.EndpointName], nil
+}
+
+func (m *mockSageMakerClient) DescribeEndpointConfig(_ context.Context, input *sagemaker.DescribeEndpointConfigInput, _ ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointConfigOutput, error) {
+	return m.configs[*input.EndpointConfigName], nil
+}
+
+func sageMakerEndpoint(name string, instances int32) *mockSageMakerClient {
+	return &mockSageMakerClient{
+		endpoints: []smtypes.EndpointSummary{{
+			EndpointName: awssdk.String(name),
+			EndpointArn:  awssdk.String("arn:aws:sagemaker:us-east-1:123456789012:endpoint/" + name),
+			CreationTime: awssdk.Time(time.Now().Add(-30 * 24 * time.Hour)),
+		}},
+		describe: map[string]*sagemaker.DescribeEndpointOutput{
+			name: {
+				EndpointName:       awssdk.String(name),
+				EndpointConfigName: awssdk.String(name + "-config"),
+				ProductionVariants: []smtypes.ProductionVariantSummary{
+					{VariantName: awssdk.String("AllTraffic"), CurrentInstanceCount: awssdk.Int32(instances)},
+				},
+			},
+		},
+		configs: map[string]*sagemaker.DescribeEndpointConfigOutput{
+			name + "-config": {ProductionVariants: []smtypes.ProductionVariant{
+				{VariantName: awssdk.String("AllTraffic"), InstanceType: smtypes.ProductionVariantInstanceTypeMlM5Large},
+			}},
+		},
+	}
+}
+
+func TestSageMakerScanner_IdleNotebook(t *testing.T) {
+	mock := &mockSageMakerClient{notebooks: []smtypes.NotebookInstanceSummary{
+		{
+			NotebookInstanceName: awssdk.String("research"),
+			InstanceType:         smtypes.InstanceTypeMlT3Medium,
+			LastModifiedTime:     awssdk.Time(time.Now().Add(-20 * 24 * time.Hour)),
+		},
+		{
+			NotebookInstanceName: awssdk.String("today"),
+			InstanceType:         smtypes.InstanceTypeMlT3Medium,
+			LastModifiedTime:     awssdk.Time(time.Now().Add(-2 * time.Hour)),
+		},
+	}}
+	scanner := NewSageMakerScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingSageMakerIdleNotebook || f.ResourceID != "research" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// ml.t3.medium $0.05/hr * 730 = $36.50
+	if f.EstimatedMonthlyWaste < 36.4 || f.EstimatedMonthlyWaste > 36.6 {
+		t.Fatalf("expected ~$36.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["instance_type"] != "ml.t3.medium" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestSageMakerScanner_IdleEndpoint(t *testing.T) {
+	mock := sageMakerEndpoint("fraud-model", 2)
+	scanner := NewSageMakerScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingSageMakerIdleEndpoint {
+		t.Fatalf("expected SAGEMAKER_IDLE_ENDPOINT, got %s", f.ID)
+	}
+	// 2 x ml.m5.large $0.115/hr * 730 = $167.90
+	if f.EstimatedMonthlyWaste < 167.8 || f.EstimatedMonthlyWaste > 168 {
+		t.Fatalf("expected ~$167.90, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["variant_count"] != 1 || f.Metadata["instance_count"] != 2 {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestSageMakerScanner_ActiveEndpoint(t *testing.T) {
+	mock := sageMakerEndpoint("fraud-model", 2)
+	metrics := newMockMetricsFetcher(map[string]float64{"AllTraffic": 4200})
+	scanner := NewSageMakerScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for endpoint with invocations, got %d", len(result.Findings))
+	}
+}
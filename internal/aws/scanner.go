@@ -4,19 +4,53 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -63,7 +97,7 @@ func (s *MultiRegionScanner) ScanAll(ctx context.Context) (*ScanResult, error) {
 		combined ScanResult
 	)
 
-	// WO-189: CloudFront is global, so scan it once outside the per-region loop.
+	// WO-189: CloudFront, Route53, and Global Accelerator are global, so scan them once outside the per-region loop.
 	globalResult, err := s.scanGlobal(ctx)
 	if err != nil {
 		combined.Errors = append(combined.Errors, fmt.Sprintf("%s: %v", cloudFrontFindingRegion, err))
@@ -103,6 +137,12 @@ func (s *MultiRegionScanner) ScanAll(ctx context.Context) (*ScanResult, error) {
 		return nil, err
 	}
 
+	if s.scanConfig.CostRanges {
+		for i := range combined.Findings {
+			applyCostRange(&combined.Findings[i])
+		}
+	}
+
 	combined.RegionsScanned = len(s.regions)
 	return &combined, nil
 }
@@ -207,20 +247,47 @@ func (s *MultiRegionScanner) awsConfigForRegion(region string) awssdk.Config {
 
 func (s *MultiRegionScanner) buildRegionalScanners(cfg awssdk.Config, region string) []ResourceScanner {
 	if s.regionalScannerBuilder != nil {
-		return s.regionalScannerBuilder(cfg, region)
+		return filterScanners(s.regionalScannerBuilder(cfg, region), s.scanConfig.ResourceTypes)
 	}
-	return buildScanners(cfg, region)
+	return buildScanners(cfg, region, s.scanConfig.ResourceTypes)
 }
 
 func (s *MultiRegionScanner) buildGlobalScanners(cfg awssdk.Config) []ResourceScanner {
 	if s.globalScannerBuilder != nil {
-		return s.globalScannerBuilder(cfg)
+		return filterScanners(s.globalScannerBuilder(cfg), s.scanConfig.ResourceTypes)
 	}
-	return buildGlobalScanners(cfg)
+	return buildGlobalScanners(cfg, s.scanConfig.ResourceTypes)
 }
 
-// buildScanners creates all resource scanners for a given region.
-func buildScanners(cfg awssdk.Config, region string) []ResourceScanner {
+// ScannerTypes returns the type of every regional and global scanner, sorted.
+func ScannerTypes() []ResourceType {
+	var cfg awssdk.Config
+	scanners := append(buildScanners(cfg, "", nil), buildGlobalScanners(cfg, nil)...)
+	types := make([]ResourceType, 0, len(scanners))
+	for _, s := range scanners {
+		types = append(types, s.Type())
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// filterScanners keeps the scanners whose type is in include. A nil include keeps all.
+func filterScanners(scanners []ResourceScanner, include map[ResourceType]bool) []ResourceScanner {
+	if include == nil {
+		return scanners
+	}
+	var filtered []ResourceScanner
+	for _, s := range scanners {
+		if include[s.Type()] {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// buildScanners creates the resource scanners for a given region whose type is in
+// include, or all of them when include is nil.
+func buildScanners(cfg awssdk.Config, region string, include map[ResourceType]bool) []ResourceScanner {
 	ec2Client := ec2.NewFromConfig(cfg)
 	cwClient := cloudwatch.NewFromConfig(cfg)
 	metrics := NewMetricsFetcher(cwClient)
@@ -232,10 +299,41 @@ func buildScanners(cfg awssdk.Config, region string) []ResourceScanner {
 	firehoseClient := firehose.NewFromConfig(cfg)
 	sqsClient := sqs.NewFromConfig(cfg)
 	snsClient := sns.NewFromConfig(cfg)
-
-	return []ResourceScanner{
+	dynamoDBClient := dynamodb.NewFromConfig(cfg)
+	redshiftClient := redshift.NewFromConfig(cfg)
+	s3Client := s3.NewFromConfig(cfg)
+	efsClient := efs.NewFromConfig(cfg)
+	eksClient := eks.NewFromConfig(cfg)
+	ecsClient := ecs.NewFromConfig(cfg)
+	docdbClient := docdb.NewFromConfig(cfg)
+	neptuneClient := neptune.NewFromConfig(cfg)
+	mskClient := kafka.NewFromConfig(cfg)
+	apiGatewayClient := apigateway.NewFromConfig(cfg)
+	apiGatewayV2Client := apigatewayv2.NewFromConfig(cfg)
+	sfnClient := sfn.NewFromConfig(cfg)
+	ecrClient := ecr.NewFromConfig(cfg)
+	glueClient := glue.NewFromConfig(cfg)
+	sageMakerClient := sagemaker.NewFromConfig(cfg)
+	workspacesClient := workspaces.NewFromConfig(cfg)
+	fsxClient := fsx.NewFromConfig(cfg)
+	secretsClient := secretsmanager.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+	trailClient := cloudtrail.NewFromConfig(cfg)
+	beanstalkClient := elasticbeanstalk.NewFromConfig(cfg)
+	appRunnerClient := apprunner.NewFromConfig(cfg)
+	emrClient := emr.NewFromConfig(cfg)
+	logsClient := cloudwatchlogs.NewFromConfig(cfg)
+	dmsClient := dms.NewFromConfig(cfg)
+	mqClient := mq.NewFromConfig(cfg)
+	memoryDBClient := memorydb.NewFromConfig(cfg)
+	cognitoClient := cognitoidentityprovider.NewFromConfig(cfg)
+	appSyncClient := appsync.NewFromConfig(cfg)
+	timestreamClient := timestreamwrite.NewFromConfig(cfg)
+	aossClient := opensearchserverless.NewFromConfig(cfg)
+
+	return filterScanners([]ResourceScanner{
 		NewEC2Scanner(ec2Client, metrics, region),
-		NewEBSScanner(ec2Client, region),
+		NewEBSScanner(ec2Client, trailClient, metrics, region),
 		NewEIPScanner(ec2Client, region),
 		NewSnapshotScanner(ec2Client, region),
 		NewSecurityGroupScanner(ec2Client, region),
@@ -247,15 +345,63 @@ func buildScanners(cfg awssdk.Config, region string) []ResourceScanner {
 		NewFirehoseScanner(firehoseClient, metrics, region),
 		NewSQSScanner(sqsClient, metrics, region),
 		NewSNSScanner(snsClient, metrics, region),
-	}
+		NewTransitGatewayScanner(ec2Client, metrics, region),
+		NewDynamoDBScanner(dynamoDBClient, metrics, region),
+		NewRedshiftScanner(redshiftClient, metrics, region),
+		NewS3Scanner(s3Client, metrics, region),
+		NewEFSScanner(efsClient, metrics, region),
+		NewEKSScanner(eksClient, ec2Client, region),
+		NewECSScanner(ecsClient, metrics, region),
+		NewDocumentDBScanner(docdbClient, metrics, region),
+		NewNeptuneScanner(neptuneClient, metrics, region),
+		NewMSKScanner(mskClient, metrics, region),
+		NewAPIGatewayScanner(apiGatewayClient, apiGatewayV2Client, metrics, region),
+		NewStepFunctionsScanner(sfnClient, metrics, region),
+		NewECRScanner(ecrClient, region),
+		NewGlueScanner(glueClient, region),
+		NewSageMakerScanner(sageMakerClient, metrics, region),
+		NewVPNScanner(ec2Client, metrics, region),
+		NewWorkSpacesScanner(workspacesClient, metrics, region),
+		NewFSxScanner(fsxClient, metrics, region),
+		NewSecretsManagerScanner(secretsClient, region),
+		NewKMSScanner(kmsClient, trailClient, region),
+		NewBeanstalkScanner(beanstalkClient, ec2Client, metrics, region),
+		NewAppRunnerScanner(appRunnerClient, metrics, region),
+		NewEMRScanner(emrClient, metrics, region),
+		NewLogsScanner(logsClient, region),
+		NewRDSSnapshotScanner(rdsClient, region),
+		NewENIScanner(ec2Client, region),
+		NewPublicIPv4Scanner(ec2Client, metrics, region),
+		NewOrphanedTargetGroupScanner(elbClient, region),
+		NewAMIScanner(ec2Client, region),
+		NewDMSScanner(dmsClient, metrics, region),
+		NewMQScanner(mqClient, metrics, region),
+		NewMemoryDBScanner(memoryDBClient, metrics, region),
+		NewRDSProxyScanner(rdsClient, metrics, region),
+		NewAlarmScanner(cwClient, ec2Client, rdsClient, region),
+		NewCognitoScanner(cognitoClient, metrics, region),
+		NewAppSyncScanner(appSyncClient, metrics, region),
+		NewTimestreamScanner(timestreamClient, metrics, region),
+		NewOpenSearchServerlessScanner(aossClient, metrics, region),
+	}, include)
 }
 
-func buildGlobalScanners(cfg awssdk.Config) []ResourceScanner {
+func buildGlobalScanners(cfg awssdk.Config, include map[ResourceType]bool) []ResourceScanner {
 	cloudFrontClient := cloudfront.NewFromConfig(cfg)
 	cloudWatchClient := cloudwatch.NewFromConfig(cfg)
+	route53Client := route53.NewFromConfig(cfg)
 	metrics := NewMetricsFetcher(cloudWatchClient)
 
-	return []ResourceScanner{
+	// Global Accelerator's API and metrics are served only from us-west-2.
+	gaCfg := cfg.Copy()
+	gaCfg.Region = globalAcceleratorControlPlaneRegion
+	gaClient := globalaccelerator.NewFromConfig(gaCfg)
+	gaMetrics := NewMetricsFetcher(cloudwatch.NewFromConfig(gaCfg))
+
+	return filterScanners([]ResourceScanner{
 		NewCloudFrontScanner(cloudFrontClient, metrics),
-	}
+		NewRoute53Scanner(route53Client),
+		NewGlobalAcceleratorScanner(gaClient, gaMetrics),
+		NewDashboardScanner(cloudWatchClient),
+	}, include)
 }
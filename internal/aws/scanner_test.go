@@ -21,11 +21,11 @@ func TestNewMultiRegionScanner_CustomConcurrency(t *testing.T) {
 	}
 }
 
-func TestBuildScanners_Returns13Scanners(t *testing.T) {
+func TestBuildScanners_Returns51Scanners(t *testing.T) {
 	cfg := awssdk.Config{Region: "us-east-1"}
-	scanners := buildScanners(cfg, "us-east-1")
-	if len(scanners) != 13 {
-		t.Fatalf("expected 13 scanners, got %d", len(scanners))
+	scanners := buildScanners(cfg, "us-east-1", nil)
+	if len(scanners) != 51 {
+		t.Fatalf("expected 51 scanners, got %d", len(scanners))
 	}
 
 	types := make(map[ResourceType]bool)
@@ -37,6 +37,15 @@ func TestBuildScanners_Returns13Scanners(t *testing.T) {
 		ResourceEC2, ResourceEBS, ResourceEIP, ResourceSnapshot, ResourceSecurityGroup,
 		ResourceALB, ResourceNATGateway, ResourceRDS, ResourceLambda,
 		ResourceKinesis, ResourceFirehose, ResourceSQS, ResourceSNS,
+		ResourceTransitGateway, ResourceDynamoDB, ResourceRedshift, ResourceS3,
+		ResourceEFS, ResourceEKS, ResourceECS, ResourceDocumentDB, ResourceNeptune,
+		ResourceMSK, ResourceAPIGateway, ResourceStepFunctions,
+		ResourceECR, ResourceGlue, ResourceSageMaker, ResourceVPN, ResourceWorkSpaces, ResourceFSx,
+		ResourceSecret, ResourceKMS, ResourceBeanstalk, ResourceAppRunner,
+		ResourceEMR, ResourceLogGroup, ResourceRDSSnapshot, ResourceENI, ResourcePublicIPv4,
+		ResourceTargetGroup, ResourceAMI, ResourceDMS, ResourceMQ, ResourceMemoryDB,
+		ResourceRDSProxy, ResourceAlarm, ResourceCognito, ResourceAppSync,
+		ResourceTimestream, ResourceAOSS,
 	}
 	for _, rt := range expected {
 		if !types[rt] {
@@ -45,6 +54,35 @@ func TestBuildScanners_Returns13Scanners(t *testing.T) {
 	}
 }
 
+func TestBuildScanners_FiltersByResourceType(t *testing.T) {
+	cfg := awssdk.Config{Region: "us-east-1"}
+	include := map[ResourceType]bool{ResourceEC2: true, ResourceRDS: true, ResourceEBS: true}
+	scanners := buildScanners(cfg, "us-east-1", include)
+	if len(scanners) != len(include) {
+		t.Fatalf("expected %d scanners, got %d", len(include), len(scanners))
+	}
+	for _, s := range scanners {
+		if !include[s.Type()] {
+			t.Fatalf("unexpected scanner %s", s.Type())
+		}
+	}
+	if global := buildGlobalScanners(cfg, include); len(global) != 0 {
+		t.Fatalf("expected no global scanners, got %d", len(global))
+	}
+}
+
+func TestResourceTypes_CoversScannerTypes(t *testing.T) {
+	known := make(map[ResourceType]bool)
+	for _, rt := range ResourceTypes() {
+		known[rt] = true
+	}
+	for _, rt := range ScannerTypes() {
+		if !known[rt] {
+			t.Fatalf("scanner type %s missing from ResourceTypes", rt)
+		}
+	}
+}
+
 func TestMultiRegionScanner_EmptyRegions(t *testing.T) {
 	scanner := NewMultiRegionScanner(nil, nil, 4, ScanConfig{})
 	result, err := scanner.ScanAll(context.Background())
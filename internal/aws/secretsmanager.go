@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// SecretsManagerAPI is the minimal interface for Secrets Manager operations.
+type SecretsManagerAPI interface {
+	ListSecrets(ctx context.Context, input *secretsmanager.ListSecretsInput, opts ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+}
+
+// SecretsManagerScanner detects secrets that nothing has read recently.
+type SecretsManagerScanner struct {
+	client SecretsManagerAPI
+	region string
+}
+
+// NewSecretsManagerScanner creates a scanner for Secrets Manager secrets.
+func NewSecretsManagerScanner(client SecretsManagerAPI, region string) *SecretsManagerScanner {
+	return &SecretsManagerScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *SecretsManagerScanner) Type() ResourceType {
+	return ResourceSecret
+}
+
+// Scan flags secrets older than StaleDays whose LastAccessedDate is missing or before
+// the cutoff. Secrets Manager truncates LastAccessedDate to the day, so a secret read
+// today may still show yesterday's date.
+func (s *SecretsManagerScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	secrets, err := s.listSecrets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(secrets)}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, sec := range secrets {
+		name := deref(sec.Name)
+		arn := deref(sec.ARN)
+		tags := secretTagsToMap(sec.Tags)
+		if cfg.Exclude.ShouldExclude(name, tags) || cfg.Exclude.ShouldExclude(arn, tags) {
+			continue
+		}
+		// Secrets scheduled for deletion no longer bill once deleted.
+		if sec.DeletedDate != nil {
+			continue
+		}
+		// Secrets created within the stale window have not had time to be read.
+		if sec.CreatedDate != nil && sec.CreatedDate.After(cutoff) {
+			continue
+		}
+		if sec.LastAccessedDate != nil && sec.LastAccessedDate.After(cutoff) {
+			continue
+		}
+
+		meta := map[string]any{
+			"last_accessed_date": "",
+			"last_changed_date":  "",
+			"rotation_enabled":   awssdk.ToBool(sec.RotationEnabled),
+		}
+		setTagsMetadata(meta, tags)
+		if sec.LastAccessedDate != nil {
+			meta["last_accessed_date"] = sec.LastAccessedDate.UTC().Format(time.DateOnly)
+		}
+		if sec.LastChangedDate != nil {
+			meta["last_changed_date"] = sec.LastChangedDate.UTC().Format(time.RFC3339)
+		}
+		if sec.CreatedDate != nil {
+			meta["created_date"] = sec.CreatedDate.UTC().Format(time.RFC3339)
+		}
+		if owner := deref(sec.OwningService); owner != "" {
+			meta["owning_service"] = owner
+		}
+
+		msg := fmt.Sprintf("Never accessed (older than %d days)", cfg.StaleDays)
+		if sec.LastAccessedDate != nil {
+			msg = fmt.Sprintf("Not accessed since %s (over %d days)", sec.LastAccessedDate.UTC().Format(time.DateOnly), cfg.StaleDays)
+		}
+		msg += "; last access is tracked to the day only"
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingSecretUnused,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceSecret,
+			ResourceID:            name,
+			ResourceName:          arn,
+			Region:                s.region,
+			Message:               msg,
+			EstimatedMonthlyWaste: pricing.MonthlySecretCost(),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *SecretsManagerScanner) listSecrets(ctx context.Context) ([]smtypes.SecretListEntry, error) {
+	var secrets []smtypes.SecretListEntry
+	paginator := secretsmanager.NewListSecretsPaginator(s.client, &secretsmanager.ListSecretsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, page.SecretList...)
+	}
+	return secrets, nil
+}
+
+func secretTagsToMap(tags []smtypes.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		if t.Key != nil {
+			m[*t.Key] = deref(t.Value)
+		}
+	}
+	return m
+}
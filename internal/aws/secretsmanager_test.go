@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+type mockSecretsManagerClient struct {
+	secrets []smtypes.SecretListEntry
+	err     error
+}
+
+func (m *mockSecretsManagerClient) ListSecrets(_ context.Context, _ *secretsmanager.ListSecretsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &secretsmanager.ListSecretsOutput{SecretList: m.secrets}, nil
+}
+
+func secretEntry(name string, createdAgo, accessedAgo time.Duration) smtypes.SecretListEntry {
+	now := time.Now().UTC()
+	e := smtypes.SecretListEntry{
+		Name:            awssdk.String(name),
+		ARN:             awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:" + name),
+		CreatedDate:     awssdk.Time(now.Add(-createdAgo)),
+		LastChangedDate: awssdk.Time(now.Add(-createdAgo)),
+	}
+	if accessedAgo > 0 {
+		e.LastAccessedDate = awssdk.Time(now.Add(-accessedAgo))
+	}
+	return e
+}
+
+func TestSecretsManagerScanner_UnusedSecrets(t *testing.T) {
+	day := 24 * time.Hour
+	deleted := secretEntry("deleted", 200*day, 0)
+	deleted.DeletedDate = awssdk.Time(time.Now())
+	mock := &mockSecretsManagerClient{secrets: []smtypes.SecretListEntry{
+		secretEntry("stale", 200*day, 120*day),
+		secretEntry("never-read", 200*day, 0),
+		secretEntry("active", 200*day, 1*day),
+		secretEntry("new", 10*day, 0),
+		deleted,
+	}}
+	scanner := NewSecretsManagerScanner(mock, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	stale := byID["stale"]
+	if stale.ID != FindingSecretUnused || stale.EstimatedMonthlyWaste != 0.40 {
+		t.Fatalf("unexpected finding: %+v", stale)
+	}
+	if !strings.Contains(stale.Message, "to the day") {
+		t.Fatalf("expected day-granularity note in message, got %q", stale.Message)
+	}
+	if stale.Metadata["last_accessed_date"] == "" || stale.Metadata["last_changed_date"] == "" {
+		t.Fatalf("expected both timestamps in metadata: %v", stale.Metadata)
+	}
+	if never := byID["never-read"]; never.Metadata["last_accessed_date"] != "" {
+		t.Fatalf("expected empty last_accessed_date, got %v", never.Metadata["last_accessed_date"])
+	}
+}
+
+func TestSecretsManagerScanner_ExcludedByARN(t *testing.T) {
+	entry := secretEntry("stale", 200*24*time.Hour, 0)
+	scanner := NewSecretsManagerScanner(&mockSecretsManagerClient{secrets: []smtypes.SecretListEntry{entry}}, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{*entry.ARN: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestSecretsManagerScanner_APIError(t *testing.T) {
+	scanner := NewSecretsManagerScanner(&mockSecretsManagerClient{err: errors.New("denied")}, "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90}); err == nil {
+		t.Fatal("expected error")
+	}
+}
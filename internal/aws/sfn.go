@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+// StepFunctionsAPI is the minimal interface for Step Functions operations.
+type StepFunctionsAPI interface {
+	ListStateMachines(ctx context.Context, input *sfn.ListStateMachinesInput, opts ...func(*sfn.Options)) (*sfn.ListStateMachinesOutput, error)
+}
+
+// StepFunctionsScanner detects state machines with no executions.
+type StepFunctionsScanner struct {
+	client  StepFunctionsAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewStepFunctionsScanner creates a scanner for Step Functions state machines.
+func NewStepFunctionsScanner(client StepFunctionsAPI, metrics *MetricsFetcher, region string) *StepFunctionsScanner {
+	return &StepFunctionsScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *StepFunctionsScanner) Type() ResourceType {
+	return ResourceStepFunctions
+}
+
+// Scan examines state machines older than the idle window for zero started executions.
+func (s *StepFunctionsScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	machines, err := s.listStateMachines(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list state machines: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(machines)}
+
+	lookbackStart := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	var arns []string
+	machineMap := make(map[string]sfntypes.StateMachineListItem, len(machines))
+	for _, m := range machines {
+		arn := deref(m.StateMachineArn)
+		if cfg.Exclude.ShouldExclude(deref(m.Name), nil) || cfg.Exclude.ShouldExclude(arn, nil) {
+			continue
+		}
+		// State machines created within the idle window have not had time to run.
+		if m.CreationDate != nil && m.CreationDate.After(lookbackStart) {
+			continue
+		}
+		arns = append(arns, arn)
+		machineMap[arn] = m
+	}
+
+	if len(arns) == 0 {
+		return result, nil
+	}
+
+	started, err := s.metrics.FetchSum(ctx, "AWS/States", "ExecutionsStarted", "StateMachineArn", arns, cfg.IdleDays)
+	if err != nil {
+		slog.Warn("Failed to fetch Step Functions execution metrics", "region", s.region, "error", err)
+		return result, nil
+	}
+
+	for _, arn := range arns {
+		if started[arn] > 0 {
+			continue
+		}
+		m := machineMap[arn]
+		meta := map[string]any{
+			"type": string(m.Type),
+		}
+		if m.CreationDate != nil {
+			meta["creation_date"] = m.CreationDate.UTC().Format(time.RFC3339)
+		}
+		// Express workflows log through CloudWatch Logs; a dormant log group keeps billing storage.
+		if m.Type == sfntypes.StateMachineTypeExpress {
+			meta["express"] = true
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingSFNIdle,
+			Severity:              SeverityLow,
+			ResourceType:          ResourceStepFunctions,
+			ResourceID:            deref(m.Name),
+			ResourceName:          arn,
+			Region:                s.region,
+			Message:               fmt.Sprintf("Zero executions started over %d days (%s workflow)", cfg.IdleDays, m.Type),
+			EstimatedMonthlyWaste: 0,
+			Hygiene:               true, // state machines bill per transition or execution, not while idle
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *StepFunctionsScanner) listStateMachines(ctx context.Context) ([]sfntypes.StateMachineListItem, error) {
+	var machines []sfntypes.StateMachineListItem
+	paginator := sfn.NewListStateMachinesPaginator(s.client, &sfn.ListStateMachinesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		machines = append(machines, page.StateMachines...)
+	}
+	return machines, nil
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+type mockStepFunctionsClient struct {
+	machines []sfntypes.StateMachineListItem
+}
+
+func (m *mockStepFunctionsClient) ListStateMachines(_ context.Context, _ *sfn.ListStateMachinesInput, _ ...func(*sfn.Options)) (*sfn.ListStateMachinesOutput, error) {
+	return &sfn.ListStateMachinesOutput{StateMachines: m.machines}, nil
+}
+
+func stateMachine(name string, smType sfntypes.StateMachineType, age time.Duration) sfntypes.StateMachineListItem {
+	return sfntypes.StateMachineListItem{
+		Name:            awssdk.String(name),
+		StateMachineArn: awssdk.String("arn:aws:states:us-east-1:123456789012:stateMachine:" + name),
+		Type:            smType,
+		CreationDate:    awssdk.Time(time.Now().Add(-age)),
+	}
+}
+
+func TestStepFunctionsScanner_IdleStateMachines(t *testing.T) {
+	old := 90 * 24 * time.Hour
+	mock := &mockStepFunctionsClient{machines: []sfntypes.StateMachineListItem{
+		stateMachine("etl", sfntypes.StateMachineTypeStandard, old),
+		stateMachine("ingest", sfntypes.StateMachineTypeExpress, old),
+		stateMachine("active", sfntypes.StateMachineTypeStandard, old),
+		stateMachine("new", sfntypes.StateMachineTypeStandard, time.Hour),
+	}}
+	metrics := newMockMetricsFetcher(map[string]float64{
+		"arn:aws:states:us-east-1:123456789012:stateMachine:active": 12,
+	})
+	scanner := NewStepFunctionsScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	etl := byID["etl"]
+	if etl.ID != FindingSFNIdle || !etl.Hygiene || etl.EstimatedMonthlyWaste != 0 {
+		t.Fatalf("unexpected finding: %+v", etl)
+	}
+	if etl.Metadata["type"] != "STANDARD" || etl.Metadata["creation_date"] == nil {
+		t.Fatalf("unexpected metadata: %v", etl.Metadata)
+	}
+	if _, ok := etl.Metadata["express"]; ok {
+		t.Fatal("standard workflow should not be marked express")
+	}
+	if byID["ingest"].Metadata["express"] != true {
+		t.Fatalf("expected express workflow marked in metadata, got %v", byID["ingest"].Metadata)
+	}
+}
+
+func TestStepFunctionsScanner_ExcludedByARN(t *testing.T) {
+	m := stateMachine("etl", sfntypes.StateMachineTypeStandard, 90*24*time.Hour)
+	mock := &mockStepFunctionsClient{machines: []sfntypes.StateMachineListItem{m}}
+	scanner := NewStepFunctionsScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 30,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{*m.StateMachineArn: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded state machine to be skipped, got %d findings", len(result.Findings))
+	}
+}
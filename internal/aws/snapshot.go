@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -17,6 +18,16 @@ type SnapshotAPI interface {
 	DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
 }
 
+// snapshotIncrementalFraction is the share of a snapshot's written blocks assumed to
+// be unique to it when it follows an earlier snapshot of the same volume. EBS bills
+// only the blocks that changed since the previous snapshot, and no API reports that
+// figure per snapshot.
+const snapshotIncrementalFraction = 0.1
+
+// copiedSnapshotVolumeID is the placeholder VolumeId of snapshots created by
+// CopySnapshot; such snapshots share no blocks with any lineage.
+const copiedSnapshotVolumeID = "vol-ffffffff"
+
 // SnapshotScanner detects stale snapshots with no AMI reference.
 type SnapshotScanner struct {
 	client SNAPSHOTAPI
@@ -55,6 +66,8 @@ func (s *SnapshotScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult
 		amiSnaps = make(map[string]bool)
 	}
 
+	firstInLineage := lineageBaseSnapshots(snapshots)
+
 	now := time.Now().UTC()
 	for _, snap := range snapshots {
 		snapID := deref(snap.SnapshotId)
@@ -77,7 +90,13 @@ func (s *SnapshotScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult
 		}
 
 		sizeGiB := int(derefInt32(snap.VolumeSize))
-		cost := pricing.MonthlySnapshotCost(sizeGiB, s.region)
+		fullGiB, billedGiB, basis := snapshotBilledSize(snap, firstInLineage[snapID])
+		var cost float64
+		if snap.StorageTier == ec2types.StorageTierArchive {
+			cost = pricing.MonthlyArchivedSnapshotCost(billedGiB, s.region)
+		} else {
+			cost = pricing.MonthlySnapshotCost(billedGiB, s.region)
+		}
 
 		result.Findings = append(result.Findings, Finding{
 			ID:                    FindingStaleSnapshot,
@@ -88,11 +107,15 @@ func (s *SnapshotScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult
 			Region:                s.region,
 			Message:               fmt.Sprintf("Snapshot %d days old, %d GiB, no AMI reference", ageDays, sizeGiB),
 			EstimatedMonthlyWaste: cost,
-			Metadata: map[string]any{
-				"age_days":  ageDays,
-				"size_gib":  sizeGiB,
-				"volume_id": deref(snap.VolumeId),
-			},
+			Metadata: withTags(map[string]any{
+				"age_days":             ageDays,
+				"size_gib":             sizeGiB,
+				"full_size_gib":        fullGiB,
+				"estimated_billed_gib": billedGiB,
+				"size_basis":           basis,
+				"storage_tier":         string(snap.StorageTier),
+				"volume_id":            deref(snap.VolumeId),
+			}, ec2TagsToMap(snap.Tags)),
 		})
 	}
 
@@ -137,6 +160,52 @@ func (s *SnapshotScanner) amiReferencedSnapshots(ctx context.Context) (map[strin
 	return refs, nil
 }
 
+// lineageBaseSnapshots returns the IDs of the oldest standard-tier snapshot of each
+// source volume. That snapshot holds the volume's full data; later snapshots of the
+// same volume hold only the blocks changed since.
+func lineageBaseSnapshots(snapshots []ec2types.Snapshot) map[string]bool {
+	oldest := make(map[string]ec2types.Snapshot)
+	for _, snap := range snapshots {
+		volID := deref(snap.VolumeId)
+		if volID == "" || volID == copiedSnapshotVolumeID || snap.StartTime == nil {
+			continue
+		}
+		if snap.StorageTier == ec2types.StorageTierArchive {
+			continue
+		}
+		if cur, ok := oldest[volID]; !ok || snap.StartTime.Before(*cur.StartTime) {
+			oldest[volID] = snap
+		}
+	}
+
+	base := make(map[string]bool, len(oldest))
+	for _, snap := range oldest {
+		base[deref(snap.SnapshotId)] = true
+	}
+	return base
+}
+
+// snapshotBilledSize estimates the storage a snapshot is billed for. The full size
+// is the data written to the source volume (FullSnapshotSizeInBytes), falling back
+// to the volume size. Archived snapshots, copies, and the first snapshot of a volume
+// are billed on their full size; later snapshots are estimated as a fraction of it.
+func snapshotBilledSize(snap ec2types.Snapshot, lineageBase bool) (fullGiB, billedGiB int, basis string) {
+	fullGiB = int(derefInt32(snap.VolumeSize))
+	if b := derefInt64(snap.FullSnapshotSizeInBytes); b > 0 {
+		fullGiB = int(math.Ceil(float64(b) / float64(1<<30)))
+	}
+
+	volID := deref(snap.VolumeId)
+	switch {
+	case snap.StorageTier == ec2types.StorageTierArchive:
+		return fullGiB, fullGiB, "archive_full"
+	case lineageBase || volID == "" || volID == copiedSnapshotVolumeID:
+		return fullGiB, fullGiB, "full"
+	default:
+		return fullGiB, int(math.Ceil(float64(fullGiB) * snapshotIncrementalFraction)), "incremental_estimate"
+	}
+}
+
 func snapshotName(snap ec2types.Snapshot) string {
 	for _, tag := range snap.Tags {
 		if deref(tag.Key) == "Name" {
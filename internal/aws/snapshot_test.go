@@ -8,6 +8,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 )
 
 type mockSnapshotClient struct {
@@ -63,6 +64,75 @@ func TestSnapshotScanner_StaleSnapshot(t *testing.T) {
 	}
 }
 
+func TestSnapshotScanner_IncrementalSizing(t *testing.T) {
+	older := time.Now().UTC().Add(-200 * 24 * time.Hour)
+	newer := time.Now().UTC().Add(-120 * 24 * time.Hour)
+	written := awssdk.Int64(40 << 30)
+	mock := &mockSnapshotClient{
+		snapshots: []ec2types.Snapshot{
+			{
+				SnapshotId:              awssdk.String("snap-incr"),
+				VolumeId:                awssdk.String("vol-a"),
+				VolumeSize:              awssdk.Int32(100),
+				FullSnapshotSizeInBytes: written,
+				StartTime:               &newer,
+				StorageTier:             ec2types.StorageTierStandard,
+			},
+			{
+				SnapshotId:              awssdk.String("snap-base"),
+				VolumeId:                awssdk.String("vol-a"),
+				VolumeSize:              awssdk.Int32(100),
+				FullSnapshotSizeInBytes: written,
+				StartTime:               &older,
+				StorageTier:             ec2types.StorageTierStandard,
+			},
+			{
+				SnapshotId:  awssdk.String("snap-arch"),
+				VolumeId:    awssdk.String("vol-a"),
+				VolumeSize:  awssdk.Int32(50),
+				StartTime:   &older,
+				StorageTier: ec2types.StorageTierArchive,
+			},
+		},
+	}
+
+	scanner := NewSnapshotScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	tests := []struct {
+		id     string
+		billed int
+		basis  string
+		cost   float64
+	}{
+		{"snap-base", 40, "full", pricing.MonthlySnapshotCost(40, "us-east-1")},
+		{"snap-incr", 4, "incremental_estimate", pricing.MonthlySnapshotCost(4, "us-east-1")},
+		{"snap-arch", 50, "archive_full", pricing.MonthlyArchivedSnapshotCost(50, "us-east-1")},
+	}
+	for _, tt := range tests {
+		f, ok := byID[tt.id]
+		if !ok {
+			t.Fatalf("expected finding for %s", tt.id)
+		}
+		if f.Metadata["estimated_billed_gib"] != tt.billed {
+			t.Fatalf("%s: expected estimated_billed_gib %d, got %v", tt.id, tt.billed, f.Metadata["estimated_billed_gib"])
+		}
+		if f.Metadata["size_basis"] != tt.basis {
+			t.Fatalf("%s: expected size_basis %q, got %v", tt.id, tt.basis, f.Metadata["size_basis"])
+		}
+		if f.EstimatedMonthlyWaste != tt.cost {
+			t.Fatalf("%s: expected $%.2f, got $%.2f", tt.id, tt.cost, f.EstimatedMonthlyWaste)
+		}
+	}
+	if full := byID["snap-incr"].Metadata["full_size_gib"]; full != 40 {
+		t.Fatalf("expected full_size_gib 40 from written bytes, got %v", full)
+	}
+}
+
 func TestSnapshotScanner_RecentSnapshotNotFlagged(t *testing.T) {
 	startTime := time.Now().UTC().Add(-30 * 24 * time.Hour) // 30 days ago
 	mock := &mockSnapshotClient{
@@ -158,6 +228,33 @@ func TestSnapshotScanner_ExcludedSnapshot(t *testing.T) {
 	}
 }
 
+func TestSnapshotScanner_ExcludedByTag(t *testing.T) {
+	startTime := time.Now().UTC().Add(-120 * 24 * time.Hour)
+	mock := &mockSnapshotClient{
+		snapshots: []ec2types.Snapshot{
+			{
+				SnapshotId: awssdk.String("snap-tagged001"),
+				VolumeSize: awssdk.Int32(50),
+				StartTime:  &startTime,
+				Tags:       []ec2types.Tag{{Key: awssdk.String("awsspectre:ignore"), Value: awssdk.String("true")}},
+			},
+		},
+	}
+
+	scanner := NewSnapshotScanner(mock, "us-east-1")
+	cfg := ScanConfig{
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{Tags: map[string]string{"awsspectre:ignore": ""}},
+	}
+	result, err := scanner.Scan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected tag-excluded snapshot to produce no findings, got %d", len(result.Findings))
+	}
+}
+
 func TestSnapshotScanner_Type(t *testing.T) {
 	scanner := &SnapshotScanner{}
 	if scanner.Type() != ResourceSnapshot {
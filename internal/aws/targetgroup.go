@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// TargetGroupAPI is the minimal interface for ELBv2 target group operations.
+type TargetGroupAPI interface {
+	DescribeTargetGroups(ctx context.Context, input *elasticloadbalancingv2.DescribeTargetGroupsInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetGroupsOutput, error)
+	DescribeTargetHealth(ctx context.Context, input *elasticloadbalancingv2.DescribeTargetHealthInput, opts ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTargetHealthOutput, error)
+}
+
+// OrphanedTargetGroupScanner detects target groups not attached to any load balancer.
+type OrphanedTargetGroupScanner struct {
+	client TargetGroupAPI
+	region string
+}
+
+// NewOrphanedTargetGroupScanner creates a scanner for orphaned target groups.
+func NewOrphanedTargetGroupScanner(client TargetGroupAPI, region string) *OrphanedTargetGroupScanner {
+	return &OrphanedTargetGroupScanner{client: client, region: region}
+}
+
+// Type returns the resource type.
+func (s *OrphanedTargetGroupScanner) Type() ResourceType {
+	return ResourceTargetGroup
+}
+
+// Scan examines all target groups in the region for ones with no load balancer.
+func (s *OrphanedTargetGroupScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	tgs, err := s.listTargetGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list target groups: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(tgs)}
+
+	for _, tg := range tgs {
+		tgARN := deref(tg.TargetGroupArn)
+		tgName := deref(tg.TargetGroupName)
+
+		if cfg.Exclude.ShouldExclude(tgARN, nil) || cfg.Exclude.ShouldExclude(tgName, nil) {
+			continue
+		}
+		if len(tg.LoadBalancerArns) > 0 {
+			continue
+		}
+
+		targetCount := 0
+		healthOut, err := s.client.DescribeTargetHealth(ctx, &elasticloadbalancingv2.DescribeTargetHealthInput{
+			TargetGroupArn: tg.TargetGroupArn,
+		})
+		if err != nil {
+			slog.Warn("Failed to describe target health", "target_group", tgName, "error", err)
+		} else {
+			targetCount = len(healthOut.TargetHealthDescriptions)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:           FindingOrphanedTargetGroup,
+			Severity:     SeverityLow,
+			ResourceType: ResourceTargetGroup,
+			ResourceID:   tgARN,
+			ResourceName: tgName,
+			Region:       s.region,
+			Message:      fmt.Sprintf("Target group %q is not attached to any load balancer (%d registered targets)", tgName, targetCount),
+			Hygiene:      true,
+			Metadata: map[string]any{
+				"target_type":        string(tg.TargetType),
+				"protocol":           string(tg.Protocol),
+				"vpc_id":             deref(tg.VpcId),
+				"registered_targets": targetCount,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+func (s *OrphanedTargetGroupScanner) listTargetGroups(ctx context.Context) ([]elbtypes.TargetGroup, error) {
+	var tgs []elbtypes.TargetGroup
+	var marker *string
+
+	for {
+		out, err := s.client.DescribeTargetGroups(ctx, &elasticloadbalancingv2.DescribeTargetGroupsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tgs = append(tgs, out.TargetGroups...)
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	return tgs, nil
+}
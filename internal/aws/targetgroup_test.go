@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+func TestOrphanedTargetGroupScanner_Orphaned(t *testing.T) {
+	mock := &mockELBClient{
+		targetGroups: []elbtypes.TargetGroup{
+			{
+				TargetGroupArn:  awssdk.String("arn:aws:elasticloadbalancing:us-east-1:123456:targetgroup/old-tg/abc"),
+				TargetGroupName: awssdk.String("old-tg"),
+				TargetType:      elbtypes.TargetTypeEnumInstance,
+				Protocol:        elbtypes.ProtocolEnumHttp,
+				VpcId:           awssdk.String("vpc-123"),
+			},
+			{
+				TargetGroupArn:   awssdk.String("arn:aws:elasticloadbalancing:us-east-1:123456:targetgroup/live-tg/def"),
+				TargetGroupName:  awssdk.String("live-tg"),
+				LoadBalancerArns: []string{"arn:aws:elasticloadbalancing:us-east-1:123456:loadbalancer/app/web/123"},
+			},
+		},
+		targetHealths: []elbtypes.TargetHealthDescription{
+			{Target: &elbtypes.TargetDescription{Id: awssdk.String("i-1")}},
+			{Target: &elbtypes.TargetDescription{Id: awssdk.String("i-2")}},
+		},
+	}
+
+	scanner := NewOrphanedTargetGroupScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 2 {
+		t.Fatalf("expected 2 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingOrphanedTargetGroup || f.ResourceName != "old-tg" {
+		t.Fatalf("unexpected finding: %s %s", f.ID, f.ResourceName)
+	}
+	if f.EstimatedMonthlyWaste != 0 || !f.Hygiene {
+		t.Fatalf("expected $0 hygiene finding, got $%.2f hygiene=%v", f.EstimatedMonthlyWaste, f.Hygiene)
+	}
+	if f.Metadata["registered_targets"] != 2 {
+		t.Fatalf("expected 2 registered targets, got %v", f.Metadata["registered_targets"])
+	}
+	if f.Metadata["target_type"] != "instance" {
+		t.Fatalf("expected target_type instance, got %v", f.Metadata["target_type"])
+	}
+}
+
+func TestOrphanedTargetGroupScanner_ExcludedByARN(t *testing.T) {
+	arn := "arn:aws:elasticloadbalancing:us-east-1:123456:targetgroup/old-tg/abc"
+	mock := &mockELBClient{
+		targetGroups: []elbtypes.TargetGroup{
+			{TargetGroupArn: awssdk.String(arn), TargetGroupName: awssdk.String("old-tg")},
+		},
+	}
+
+	scanner := NewOrphanedTargetGroupScanner(mock, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		Exclude: ExcludeConfig{ResourceIDs: map[string]bool{arn: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const (
+	tgwNamespace     = "AWS/TransitGateway"
+	tgwAttachmentDim = "TransitGatewayAttachment"
+	tgwGatewayDim    = "TransitGateway"
+)
+
+// TransitGatewayAPI is the minimal interface for Transit Gateway operations.
+type TransitGatewayAPI interface {
+	DescribeTransitGatewayAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayAttachmentsOutput, error)
+	DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+}
+
+// TransitGatewayScanner detects idle Transit Gateway attachments.
+type TransitGatewayScanner struct {
+	client  TransitGatewayAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewTransitGatewayScanner creates a scanner for Transit Gateway attachments.
+func NewTransitGatewayScanner(client TransitGatewayAPI, metrics *MetricsFetcher, region string) *TransitGatewayScanner {
+	return &TransitGatewayScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *TransitGatewayScanner) Type() ResourceType {
+	return ResourceTransitGateway
+}
+
+// tgwPeering holds the local/peer view of a peering attachment from this region.
+type tgwPeering struct {
+	attachment ec2types.TransitGatewayPeeringAttachment
+	localTGW   string
+	peerTGW    string
+	peerRegion string
+}
+
+// Scan examines available attachments for zero bytes over the idle window. Peering
+// attachments are listed separately so each pair is reported from one region only.
+func (s *TransitGatewayScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	peeringAtts, err := s.listPeeringAttachments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Transit Gateway peering attachments: %w", err)
+	}
+	otherAtts, err := s.listAttachments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Transit Gateway attachments: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(peeringAtts) + len(otherAtts)}
+	if result.ResourcesScanned == 0 {
+		return result, nil
+	}
+
+	// Group attachment IDs by local TGW: per-attachment metrics carry both dimensions.
+	byTGW := make(map[string][]string)
+	peerings := make(map[string]tgwPeering, len(peeringAtts))
+	for _, att := range peeringAtts {
+		id := deref(att.TransitGatewayAttachmentId)
+		if cfg.Exclude.ShouldExclude(id, ec2TagsToMap(att.Tags)) {
+			continue
+		}
+
+		p, ok := s.resolvePeering(att)
+		if !ok {
+			continue
+		}
+		// Both sides of a peering see the same attachment; only the
+		// alphabetically-first region reports it.
+		if canonicalPeeringRegion(s.region, p.peerRegion) != s.region {
+			continue
+		}
+
+		byTGW[p.localTGW] = append(byTGW[p.localTGW], id)
+		peerings[id] = p
+	}
+
+	attachments := make(map[string]ec2types.TransitGatewayAttachment, len(otherAtts))
+	for _, att := range otherAtts {
+		id := deref(att.TransitGatewayAttachmentId)
+		tgwID := deref(att.TransitGatewayId)
+		if tgwID == "" || cfg.Exclude.ShouldExclude(id, ec2TagsToMap(att.Tags)) {
+			continue
+		}
+		byTGW[tgwID] = append(byTGW[tgwID], id)
+		attachments[id] = att
+	}
+
+	if len(byTGW) == 0 {
+		return result, nil
+	}
+
+	traffic := make(map[string]float64, len(peerings)+len(attachments))
+	for _, tgwID := range sortedKeys(byTGW) {
+		ids := byTGW[tgwID]
+		staticDims := []cwtypes.Dimension{{Name: awssdk.String(tgwGatewayDim), Value: awssdk.String(tgwID)}}
+		for _, metric := range []string{"BytesIn", "BytesOut"} {
+			sums, err := s.metrics.FetchSumWithStaticDim(ctx, tgwNamespace, metric, tgwAttachmentDim, ids, cfg.IdleDays, staticDims)
+			if err != nil {
+				slog.Warn("Failed to fetch Transit Gateway metrics", "region", s.region, "tgw", tgwID, "error", err)
+				return result, nil
+			}
+			for id, v := range sums {
+				traffic[id] += v
+			}
+		}
+	}
+
+	for _, tgwID := range sortedKeys(byTGW) {
+		for _, id := range byTGW[tgwID] {
+			if traffic[id] > 0 {
+				continue
+			}
+
+			if p, ok := peerings[id]; ok {
+				result.Findings = append(result.Findings, Finding{
+					ID:                    FindingIdleTGWPeering,
+					Severity:              SeverityHigh,
+					ResourceType:          ResourceTransitGateway,
+					ResourceID:            id,
+					ResourceName:          tgwAttachmentName(p.attachment.Tags),
+					Region:                s.region,
+					Message:               fmt.Sprintf("Peering attachment to %s carried zero bytes over %d days", p.peerRegion, cfg.IdleDays),
+					EstimatedMonthlyWaste: pricing.MonthlyTGWPeeringCost(s.region),
+					Metadata: withTags(map[string]any{
+						"transit_gateway_id":      p.localTGW,
+						"peer_region":             p.peerRegion,
+						"peer_transit_gateway_id": p.peerTGW,
+						"state":                   string(p.attachment.State),
+					}, ec2TagsToMap(p.attachment.Tags)),
+				})
+				continue
+			}
+
+			att := attachments[id]
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingTGWIdleAttachment,
+				Severity:              SeverityHigh,
+				ResourceType:          ResourceTGWAttachment,
+				ResourceID:            id,
+				ResourceName:          tgwAttachmentName(att.Tags),
+				Region:                s.region,
+				Message:               fmt.Sprintf("%s attachment %s carried zero bytes over %d days", att.ResourceType, deref(att.ResourceId), cfg.IdleDays),
+				EstimatedMonthlyWaste: pricing.MonthlyTGWAttachmentCost(s.region),
+				Metadata: withTags(map[string]any{
+					"transit_gateway_id": tgwID,
+					"attachment_type":    string(att.ResourceType),
+					"resource_id":        deref(att.ResourceId),
+					"resource_owner_id":  deref(att.ResourceOwnerId),
+					"state":              string(att.State),
+				}, ec2TagsToMap(att.Tags)),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// resolvePeering works out which side of the attachment is local to the scanned region.
+func (s *TransitGatewayScanner) resolvePeering(att ec2types.TransitGatewayPeeringAttachment) (tgwPeering, bool) {
+	if att.RequesterTgwInfo == nil || att.AccepterTgwInfo == nil {
+		return tgwPeering{}, false
+	}
+	requester, accepter := att.RequesterTgwInfo, att.AccepterTgwInfo
+
+	p := tgwPeering{attachment: att}
+	switch s.region {
+	case deref(requester.Region):
+		p.localTGW = deref(requester.TransitGatewayId)
+		p.peerTGW = deref(accepter.TransitGatewayId)
+		p.peerRegion = deref(accepter.Region)
+	case deref(accepter.Region):
+		p.localTGW = deref(accepter.TransitGatewayId)
+		p.peerTGW = deref(requester.TransitGatewayId)
+		p.peerRegion = deref(requester.Region)
+	default:
+		return tgwPeering{}, false
+	}
+	return p, p.localTGW != ""
+}
+
+func (s *TransitGatewayScanner) listPeeringAttachments(ctx context.Context) ([]ec2types.TransitGatewayPeeringAttachment, error) {
+	var attachments []ec2types.TransitGatewayPeeringAttachment
+	paginator := ec2.NewDescribeTransitGatewayPeeringAttachmentsPaginator(s.client, &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("state"), Values: []string{"available"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, page.TransitGatewayPeeringAttachments...)
+	}
+	return attachments, nil
+}
+
+// listAttachments returns available non-peering attachments (VPC, VPN, Direct Connect
+// gateway, Connect). Peering attachments come from listPeeringAttachments instead.
+func (s *TransitGatewayScanner) listAttachments(ctx context.Context) ([]ec2types.TransitGatewayAttachment, error) {
+	var attachments []ec2types.TransitGatewayAttachment
+	paginator := ec2.NewDescribeTransitGatewayAttachmentsPaginator(s.client, &ec2.DescribeTransitGatewayAttachmentsInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("state"), Values: []string{"available"}},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, att := range page.TransitGatewayAttachments {
+			if att.ResourceType == ec2types.TransitGatewayAttachmentResourceTypePeering {
+				continue
+			}
+			attachments = append(attachments, att)
+		}
+	}
+	return attachments, nil
+}
+
+// canonicalPeeringRegion returns the region that owns reporting for a peering pair.
+func canonicalPeeringRegion(a, b string) string {
+	if b != "" && b < a {
+		return b
+	}
+	return a
+}
+
+func tgwAttachmentName(tags []ec2types.Tag) string {
+	for _, tag := range tags {
+		if deref(tag.Key) == "Name" {
+			return deref(tag.Value)
+		}
+	}
+	return ""
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockTransitGatewayClient struct {
+	peerings    []ec2types.TransitGatewayPeeringAttachment
+	attachments []ec2types.TransitGatewayAttachment
+}
+
+func (m *mockTransitGatewayClient) DescribeTransitGatewayAttachments(_ context.Context, _ *ec2.DescribeTransitGatewayAttachmentsInput, _ ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+	return &ec2.DescribeTransitGatewayAttachmentsOutput{TransitGatewayAttachments: m.attachments}, nil
+}
+
+func (m *mockTransitGatewayClient) DescribeTransitGatewayPeeringAttachments(_ context.Context, _ *ec2.DescribeTransitGatewayPeeringAttachmentsInput, _ ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return &ec2.DescribeTransitGatewayPeeringAttachmentsOutput{TransitGatewayPeeringAttachments: m.peerings}, nil
+}
+
+func tgwPeeringAttachment(id, requesterTGW, requesterRegion, accepterTGW, accepterRegion string) ec2types.TransitGatewayPeeringAttachment {
+	return ec2types.TransitGatewayPeeringAttachment{
+		TransitGatewayAttachmentId: awssdk.String(id),
+		State:                      ec2types.TransitGatewayAttachmentStateAvailable,
+		RequesterTgwInfo: &ec2types.PeeringTgwInfo{
+			TransitGatewayId: awssdk.String(requesterTGW),
+			Region:           awssdk.String(requesterRegion),
+		},
+		AccepterTgwInfo: &ec2types.PeeringTgwInfo{
+			TransitGatewayId: awssdk.String(accepterTGW),
+			Region:           awssdk.String(accepterRegion),
+		},
+	}
+}
+
+func zeroTrafficMetrics() *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, _ *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			return &cloudwatch.GetMetricDataOutput{
+				MetricDataResults: []cwtypes.MetricDataResult{
+					{Id: awssdk.String("m0"), Values: []float64{0}},
+				},
+			}, nil
+		},
+	})
+}
+
+func TestTransitGatewayScanner_IdlePeering(t *testing.T) {
+	mock := &mockTransitGatewayClient{
+		peerings: []ec2types.TransitGatewayPeeringAttachment{
+			tgwPeeringAttachment("tgw-attach-idle001", "tgw-local", "eu-west-1", "tgw-peer", "us-east-1"),
+		},
+	}
+	scanner := NewTransitGatewayScanner(mock, zeroTrafficMetrics(), "eu-west-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 1 {
+		t.Fatalf("expected 1 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+
+	f := result.Findings[0]
+	if f.ID != FindingIdleTGWPeering {
+		t.Fatalf("expected IDLE_TGW_PEERING, got %s", f.ID)
+	}
+	if f.ResourceID != "tgw-attach-idle001" {
+		t.Fatalf("expected tgw-attach-idle001, got %s", f.ResourceID)
+	}
+	if f.EstimatedMonthlyWaste == 0 {
+		t.Fatal("expected non-zero waste estimate")
+	}
+	if f.Metadata["peer_region"] != "us-east-1" {
+		t.Fatalf("expected peer_region us-east-1, got %v", f.Metadata["peer_region"])
+	}
+	if f.Metadata["peer_transit_gateway_id"] != "tgw-peer" {
+		t.Fatalf("expected peer_transit_gateway_id tgw-peer, got %v", f.Metadata["peer_transit_gateway_id"])
+	}
+}
+
+func TestTransitGatewayScanner_PeeringReportedOnlyInCanonicalRegion(t *testing.T) {
+	mock := &mockTransitGatewayClient{
+		peerings: []ec2types.TransitGatewayPeeringAttachment{
+			tgwPeeringAttachment("tgw-attach-idle001", "tgw-local", "eu-west-1", "tgw-peer", "us-east-1"),
+		},
+	}
+	// us-east-1 sorts after eu-west-1, so the accepter side must stay silent.
+	scanner := NewTransitGatewayScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings outside canonical region, got %d", len(result.Findings))
+	}
+}
+
+func TestTransitGatewayScanner_ActivePeering(t *testing.T) {
+	mock := &mockTransitGatewayClient{
+		peerings: []ec2types.TransitGatewayPeeringAttachment{
+			tgwPeeringAttachment("tgw-attach-active001", "tgw-local", "eu-west-1", "tgw-peer", "us-east-1"),
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"tgw-attach-active001": 4096})
+	scanner := NewTransitGatewayScanner(mock, metrics, "eu-west-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected no findings for active peering, got %d", len(result.Findings))
+	}
+}
+
+func tgwAttachment(id, tgwID string, resourceType ec2types.TransitGatewayAttachmentResourceType, resourceID string) ec2types.TransitGatewayAttachment {
+	return ec2types.TransitGatewayAttachment{
+		TransitGatewayAttachmentId: awssdk.String(id),
+		TransitGatewayId:           awssdk.String(tgwID),
+		ResourceType:               resourceType,
+		ResourceId:                 awssdk.String(resourceID),
+		State:                      ec2types.TransitGatewayAttachmentStateAvailable,
+	}
+}
+
+func TestTransitGatewayScanner_IdleVPCAttachment(t *testing.T) {
+	mock := &mockTransitGatewayClient{
+		attachments: []ec2types.TransitGatewayAttachment{
+			tgwAttachment("tgw-attach-vpc001", "tgw-hub", ec2types.TransitGatewayAttachmentResourceTypeVpc, "vpc-0abc"),
+			tgwAttachment("tgw-attach-vpn001", "tgw-hub", ec2types.TransitGatewayAttachmentResourceTypeVpn, "vpn-0def"),
+			// Peering attachments are reported through the peering path only.
+			tgwAttachment("tgw-attach-peer001", "tgw-hub", ec2types.TransitGatewayAttachmentResourceTypePeering, "tgw-peer"),
+		},
+	}
+	metrics := newMockMetricsFetcher(map[string]float64{"tgw-attach-vpn001": 2048})
+	scanner := NewTransitGatewayScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 2 {
+		t.Fatalf("expected 2 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result.Findings))
+	}
+	f := result.Findings[0]
+	if f.ID != FindingTGWIdleAttachment || f.ResourceID != "tgw-attach-vpc001" || f.ResourceType != ResourceTGWAttachment {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	if f.EstimatedMonthlyWaste != 36.50 {
+		t.Fatalf("expected $36.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["attachment_type"] != "vpc" || f.Metadata["resource_id"] != "vpc-0abc" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+}
+
+func TestTransitGatewayScanner_AttachmentExcludedByID(t *testing.T) {
+	mock := &mockTransitGatewayClient{
+		attachments: []ec2types.TransitGatewayAttachment{
+			tgwAttachment("tgw-attach-vpc001", "tgw-hub", ec2types.TransitGatewayAttachmentResourceTypeVpc, "vpc-0abc"),
+		},
+	}
+	scanner := NewTransitGatewayScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"tgw-attach-vpc001": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected excluded attachment to be skipped, got %d findings", len(result.Findings))
+	}
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	tstypes "github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+const timestreamNamespace = "AWS/Timestream"
+
+// TimestreamAPI is the minimal interface for Timestream for LiveAnalytics operations.
+type TimestreamAPI interface {
+	ListDatabases(ctx context.Context, input *timestreamwrite.ListDatabasesInput, opts ...func(*timestreamwrite.Options)) (*timestreamwrite.ListDatabasesOutput, error)
+	ListTables(ctx context.Context, input *timestreamwrite.ListTablesInput, opts ...func(*timestreamwrite.Options)) (*timestreamwrite.ListTablesOutput, error)
+}
+
+// TimestreamScanner detects Timestream tables with no writes or queries.
+type TimestreamScanner struct {
+	client  TimestreamAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewTimestreamScanner creates a scanner for Timestream tables.
+func NewTimestreamScanner(client TimestreamAPI, metrics *MetricsFetcher, region string) *TimestreamScanner {
+	return &TimestreamScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *TimestreamScanner) Type() ResourceType {
+	return ResourceTimestream
+}
+
+// Scan examines active tables older than the idle window for zero WriteRecords
+// and Query requests. Waste is the storage the table keeps billing: the memory
+// store, which costs far more per GiB than magnetic storage, plus the magnetic store.
+func (s *TimestreamScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	tablesByDB, err := s.listTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list Timestream tables: %w", err)
+	}
+
+	result := &ScanResult{}
+	cutoff := time.Now().UTC().Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+
+	for db, tables := range tablesByDB {
+		result.ResourcesScanned += len(tables)
+
+		candidates := make(map[string]tstypes.Table)
+		var names []string
+		for _, t := range tables {
+			name := deref(t.TableName)
+			if cfg.Exclude.ShouldExclude(db+"/"+name, nil) || cfg.Exclude.ShouldExclude(deref(t.Arn), nil) {
+				continue
+			}
+			if t.TableStatus != tstypes.TableStatusActive {
+				continue
+			}
+			if t.CreationTime != nil && t.CreationTime.After(cutoff) {
+				continue
+			}
+			candidates[name] = t
+			names = append(names, name)
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		requests, err := s.fetchRequests(ctx, db, names, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch Timestream request metrics", "database", db, "error", err)
+			continue
+		}
+		dbDim := []cwtypes.Dimension{{Name: awssdk.String("DatabaseName"), Value: awssdk.String(db)}}
+		memoryBytes, err := s.metrics.FetchAverageWithStaticDim(ctx, timestreamNamespace, "MemoryCumulativeBytesMetered", "TableName", names, cfg.IdleDays, dbDim)
+		if err != nil {
+			slog.Warn("Failed to fetch Timestream memory store size", "database", db, "error", err)
+		}
+		magneticBytes, err := s.metrics.FetchAverageWithStaticDim(ctx, timestreamNamespace, "MagneticCumulativeBytesMetered", "TableName", names, cfg.IdleDays, dbDim)
+		if err != nil {
+			slog.Warn("Failed to fetch Timestream magnetic store size", "database", db, "error", err)
+		}
+
+		for _, name := range names {
+			if requests[name] > 0 {
+				continue
+			}
+			t := candidates[name]
+			memoryGiB := memoryBytes[name] / (1024 * 1024 * 1024)
+			magneticGiB := magneticBytes[name] / (1024 * 1024 * 1024)
+			memoryCost := memoryGiB * pricing.TimestreamMemoryStoreCostPerGB(s.region)
+			cost := memoryCost + magneticGiB*pricing.TimestreamMagneticStoreCostPerGB(s.region)
+
+			var memoryRetention, magneticRetention int64
+			if r := t.RetentionProperties; r != nil {
+				memoryRetention = derefInt64(r.MemoryStoreRetentionPeriodInHours)
+				magneticRetention = derefInt64(r.MagneticStoreRetentionPeriodInDays)
+			}
+
+			result.Findings = append(result.Findings, Finding{
+				ID:                    FindingTimestreamIdle,
+				Severity:              SeverityMedium,
+				ResourceType:          ResourceTimestream,
+				ResourceID:            db + "/" + name,
+				ResourceName:          name,
+				Region:                s.region,
+				Message:               fmt.Sprintf("Zero writes and queries over %d days, %.1f GiB still in the memory store", cfg.IdleDays, memoryGiB),
+				EstimatedMonthlyWaste: cost,
+				Hygiene:               cost == 0, // an empty table bills nothing
+				Metadata: map[string]any{
+					"database":                      db,
+					"memory_store_retention_hours":  memoryRetention,
+					"magnetic_store_retention_days": magneticRetention,
+					"memory_store_gib":              math.Round(memoryGiB*100) / 100,
+					"magnetic_store_gib":            math.Round(magneticGiB*100) / 100,
+					"estimated_memory_store_cost":   math.Round(memoryCost*100) / 100,
+				},
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// fetchRequests returns the WriteRecords and Query requests per table. The latency
+// sum is used only as an activity signal: it is nonzero whenever any request succeeded.
+func (s *TimestreamScanner) fetchRequests(ctx context.Context, db string, names []string, days int) (map[string]float64, error) {
+	totals := make(map[string]float64, len(names))
+	for _, op := range []string{"WriteRecords", "Query"} {
+		dims := []cwtypes.Dimension{
+			{Name: awssdk.String("DatabaseName"), Value: awssdk.String(db)},
+			{Name: awssdk.String("Operation"), Value: awssdk.String(op)},
+		}
+		sums, err := s.metrics.FetchSumWithStaticDim(ctx, timestreamNamespace, "SuccessfulRequestLatency", "TableName", names, days, dims)
+		if err != nil {
+			return nil, err
+		}
+		for name, v := range sums {
+			totals[name] += v
+		}
+	}
+	return totals, nil
+}
+
+// listTables returns every table grouped by database name.
+func (s *TimestreamScanner) listTables(ctx context.Context) (map[string][]tstypes.Table, error) {
+	var dbs []string
+	dbPaginator := timestreamwrite.NewListDatabasesPaginator(s.client, &timestreamwrite.ListDatabasesInput{})
+	for dbPaginator.HasMorePages() {
+		page, err := dbPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range page.Databases {
+			dbs = append(dbs, deref(db.DatabaseName))
+		}
+	}
+
+	tables := make(map[string][]tstypes.Table, len(dbs))
+	for _, db := range dbs {
+		paginator := timestreamwrite.NewListTablesPaginator(s.client, &timestreamwrite.ListTablesInput{
+			DatabaseName: awssdk.String(db),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("database %s: %w", db, err)
+			}
+			tables[db] = append(tables[db], page.Tables...)
+		}
+	}
+	return tables, nil
+}
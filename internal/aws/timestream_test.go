@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	tstypes "github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockTimestreamClient struct {
+	tables []tstypes.Table
+}
+
+func (m *mockTimestreamClient) ListDatabases(_ context.Context, _ *timestreamwrite.ListDatabasesInput, _ ...func(*timestreamwrite.Options)) (*timestreamwrite.ListDatabasesOutput, error) {
+	seen := make(map[string]bool)
+	var dbs []tstypes.Database
+	for _, t := range m.tables {
+		if !seen[deref(t.DatabaseName)] {
+			seen[deref(t.DatabaseName)] = true
+			dbs = append(dbs, tstypes.Database{DatabaseName: t.DatabaseName})
+		}
+	}
+	return &timestreamwrite.ListDatabasesOutput{Databases: dbs}, nil
+}
+
+func (m *mockTimestreamClient) ListTables(_ context.Context, input *timestreamwrite.ListTablesInput, _ ...func(*timestreamwrite.Options)) (*timestreamwrite.ListTablesOutput, error) {
+	var tables []tstypes.Table
+	for _, t := range m.tables {
+		if deref(t.DatabaseName) == deref(input.DatabaseName) {
+			tables = append(tables, t)
+		}
+	}
+	return &timestreamwrite.ListTablesOutput{Tables: tables}, nil
+}
+
+func timestreamTable(db, name string, age time.Duration, memoryHours int64) tstypes.Table {
+	return tstypes.Table{
+		DatabaseName: awssdk.String(db),
+		TableName:    awssdk.String(name),
+		Arn:          awssdk.String("arn:aws:timestream:us-east-1:123456789012:database/" + db + "/table/" + name),
+		TableStatus:  tstypes.TableStatusActive,
+		CreationTime: awssdk.Time(time.Now().Add(-age)),
+		RetentionProperties: &tstypes.RetentionProperties{
+			MemoryStoreRetentionPeriodInHours:  awssdk.Int64(memoryHours),
+			MagneticStoreRetentionPeriodInDays: awssdk.Int64(365),
+		},
+	}
+}
+
+func TestTimestreamScanner_IdleTables(t *testing.T) {
+	day := 24 * time.Hour
+	gib := float64(1024 * 1024 * 1024)
+	mock := &mockTimestreamClient{
+		tables: []tstypes.Table{
+			timestreamTable("iot", "idle", 60*day, 720),
+			timestreamTable("iot", "empty", 60*day, 24),
+			timestreamTable("iot", "busy", 60*day, 24),
+			timestreamTable("metrics", "new", 2*day, 24),
+		},
+	}
+	metrics := newMetricsByName(map[string]float64{
+		"SuccessfulRequestLatency/busy":       40,
+		"MemoryCumulativeBytesMetered/idle":   10 * gib,
+		"MagneticCumulativeBytesMetered/idle": 100 * gib,
+	})
+
+	scanner := NewTimestreamScanner(mock, metrics, "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if len(byID) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(byID), result.Findings)
+	}
+
+	idle := byID["iot/idle"]
+	if idle.ID != FindingTimestreamIdle || idle.Hygiene {
+		t.Fatalf("expected billable TIMESTREAM_IDLE, got %s hygiene=%t", idle.ID, idle.Hygiene)
+	}
+	// 10 GiB memory store at $26.28 + 100 GiB magnetic at $0.03 = $265.80
+	if idle.EstimatedMonthlyWaste < 265.79 || idle.EstimatedMonthlyWaste > 265.81 {
+		t.Fatalf("expected ~$265.80, got $%.2f", idle.EstimatedMonthlyWaste)
+	}
+	if idle.Metadata["memory_store_retention_hours"] != int64(720) || idle.Metadata["estimated_memory_store_cost"] != 262.8 {
+		t.Fatalf("unexpected metadata: %v", idle.Metadata)
+	}
+
+	empty := byID["iot/empty"]
+	if empty.EstimatedMonthlyWaste != 0 || !empty.Hygiene {
+		t.Fatalf("expected $0 hygiene finding for empty table, got $%.2f hygiene=%t", empty.EstimatedMonthlyWaste, empty.Hygiene)
+	}
+}
+
+func TestTimestreamScanner_Excluded(t *testing.T) {
+	mock := &mockTimestreamClient{
+		tables: []tstypes.Table{timestreamTable("iot", "keep", 60*24*time.Hour, 24)},
+	}
+
+	scanner := NewTimestreamScanner(mock, newMetricsByName(nil), "us-east-1")
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 7,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"iot/keep": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"regexp"
 	"time"
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -20,63 +21,243 @@ const (
 type ResourceType string
 
 const (
-	ResourceEC2           ResourceType = "ec2"
-	ResourceEBS           ResourceType = "ebs"
-	ResourceEIP           ResourceType = "eip"
-	ResourceALB           ResourceType = "alb"
-	ResourceNLB           ResourceType = "nlb"
-	ResourceNATGateway    ResourceType = "nat_gateway"
-	ResourceRDS           ResourceType = "rds"
-	ResourceSnapshot      ResourceType = "snapshot"
-	ResourceSecurityGroup ResourceType = "security_group"
-	ResourceLambda        ResourceType = "lambda"
-	ResourceKinesis       ResourceType = "kinesis"
-	ResourceFirehose      ResourceType = "firehose"
-	ResourceSQS           ResourceType = "sqs"
-	ResourceSNS           ResourceType = "sns"
-	ResourceCloudFront    ResourceType = "cloudfront" // WO-189: global CloudFront hygiene scanner.
+	ResourceEC2               ResourceType = "ec2"
+	ResourceEBS               ResourceType = "ebs"
+	ResourceEIP               ResourceType = "eip"
+	ResourceALB               ResourceType = "alb"
+	ResourceNLB               ResourceType = "nlb"
+	ResourceGWLB              ResourceType = "gwlb"
+	ResourceNATGateway        ResourceType = "nat_gateway"
+	ResourceRDS               ResourceType = "rds"
+	ResourceSnapshot          ResourceType = "snapshot"
+	ResourceSecurityGroup     ResourceType = "security_group"
+	ResourceLambda            ResourceType = "lambda"
+	ResourceKinesis           ResourceType = "kinesis"
+	ResourceFirehose          ResourceType = "firehose"
+	ResourceSQS               ResourceType = "sqs"
+	ResourceSNS               ResourceType = "sns"
+	ResourceCloudFront        ResourceType = "cloudfront" // WO-189: global CloudFront hygiene scanner.
+	ResourceTransitGateway    ResourceType = "transit_gateway"
+	ResourceTGWAttachment     ResourceType = "tgw_attachment"
+	ResourceDynamoDB          ResourceType = "dynamodb"
+	ResourceRedshift          ResourceType = "redshift"
+	ResourceS3                ResourceType = "s3"
+	ResourceEFS               ResourceType = "efs"
+	ResourceEKS               ResourceType = "eks"
+	ResourceECS               ResourceType = "ecs"
+	ResourceDocumentDB        ResourceType = "documentdb"
+	ResourceNeptune           ResourceType = "neptune"
+	ResourceMSK               ResourceType = "msk"
+	ResourceAPIGateway        ResourceType = "apigateway"
+	ResourceRoute53           ResourceType = "route53"
+	ResourceStepFunctions     ResourceType = "stepfunctions"
+	ResourceECR               ResourceType = "ecr"
+	ResourceGlue              ResourceType = "glue"
+	ResourceSageMaker         ResourceType = "sagemaker"
+	ResourceGlobalAccelerator ResourceType = "globalaccelerator"
+	ResourceVPN               ResourceType = "vpn"
+	ResourceWorkSpaces        ResourceType = "workspaces"
+	ResourceFSx               ResourceType = "fsx"
+	ResourceSecret            ResourceType = "secret"
+	ResourceKMS               ResourceType = "kms"
+	ResourceBeanstalk         ResourceType = "beanstalk"
+	ResourceAppRunner         ResourceType = "apprunner"
+	ResourceEMR               ResourceType = "emr"
+	ResourceLogGroup          ResourceType = "log_group"
+	ResourceRDSSnapshot       ResourceType = "rds_snapshot"
+	ResourceENI               ResourceType = "eni"
+	ResourcePublicIPv4        ResourceType = "public_ipv4"
+	ResourceTargetGroup       ResourceType = "target_group"
+	ResourceAMI               ResourceType = "ami"
+	ResourceDMS               ResourceType = "dms"
+	ResourceMQ                ResourceType = "mq"
+	ResourceMemoryDB          ResourceType = "memorydb"
+	ResourceRDSProxy          ResourceType = "rds_proxy"
+	ResourceAlarm             ResourceType = "alarm"
+	ResourceDashboard         ResourceType = "dashboard"
+	ResourceCognito           ResourceType = "cognito"
+	ResourceAppSync           ResourceType = "appsync"
+	ResourceTimestream        ResourceType = "timestream"
+	ResourceAOSS              ResourceType = "aoss"
 )
 
+// ResourceTypes returns every resource type findings can carry. It is a superset of
+// ScannerTypes: the alb scanner also reports nlb and gwlb findings, and the
+// transit_gateway scanner also reports tgw_attachment findings.
+func ResourceTypes() []ResourceType {
+	return []ResourceType{
+		ResourceEC2,
+		ResourceEBS,
+		ResourceEIP,
+		ResourceALB,
+		ResourceNLB,
+		ResourceGWLB,
+		ResourceNATGateway,
+		ResourceRDS,
+		ResourceSnapshot,
+		ResourceSecurityGroup,
+		ResourceLambda,
+		ResourceKinesis,
+		ResourceFirehose,
+		ResourceSQS,
+		ResourceSNS,
+		ResourceCloudFront,
+		ResourceTransitGateway,
+		ResourceTGWAttachment,
+		ResourceDynamoDB,
+		ResourceRedshift,
+		ResourceS3,
+		ResourceEFS,
+		ResourceEKS,
+		ResourceECS,
+		ResourceDocumentDB,
+		ResourceNeptune,
+		ResourceMSK,
+		ResourceAPIGateway,
+		ResourceRoute53,
+		ResourceStepFunctions,
+		ResourceECR,
+		ResourceGlue,
+		ResourceSageMaker,
+		ResourceGlobalAccelerator,
+		ResourceVPN,
+		ResourceWorkSpaces,
+		ResourceFSx,
+		ResourceSecret,
+		ResourceKMS,
+		ResourceBeanstalk,
+		ResourceAppRunner,
+		ResourceEMR,
+		ResourceLogGroup,
+		ResourceRDSSnapshot,
+		ResourceENI,
+		ResourcePublicIPv4,
+		ResourceTargetGroup,
+		ResourceAMI,
+		ResourceDMS,
+		ResourceMQ,
+		ResourceMemoryDB,
+		ResourceRDSProxy,
+		ResourceAlarm,
+		ResourceDashboard,
+		ResourceCognito,
+		ResourceAppSync,
+		ResourceTimestream,
+		ResourceAOSS,
+	}
+}
+
 // FindingID identifies the type of waste detected.
 type FindingID string
 
 const (
-	FindingIdleEC2                FindingID = "IDLE_EC2"
-	FindingStoppedEC2             FindingID = "STOPPED_EC2"
-	FindingDetachedEBS            FindingID = "DETACHED_EBS"
-	FindingUnusedEIP              FindingID = "UNUSED_EIP"
-	FindingIdleALB                FindingID = "IDLE_ALB"
-	FindingIdleNLB                FindingID = "IDLE_NLB"
-	FindingIdleNATGateway         FindingID = "IDLE_NAT_GATEWAY"
-	FindingLowTrafficNATGateway   FindingID = "LOW_TRAFFIC_NAT_GATEWAY"
-	FindingIdleRDS                FindingID = "IDLE_RDS"
-	FindingStaleSnapshot          FindingID = "STALE_SNAPSHOT"
-	FindingUnusedSecurityGroup    FindingID = "UNUSED_SECURITY_GROUP"
-	FindingIdleLambda             FindingID = "IDLE_LAMBDA"
-	FindingKinesisStreamIdle      FindingID = "KINESIS_STREAM_IDLE"
-	FindingKinesisOverProvisioned FindingID = "KINESIS_OVER_PROVISIONED"
-	FindingKinesisFirehoseIdle    FindingID = "KINESIS_FIREHOSE_IDLE"
-	FindingSQSIdle                FindingID = "SQS_IDLE"
-	FindingSQSDLQOrphaned         FindingID = "SQS_DLQ_ORPHANED"
-	FindingSQSNoConsumer          FindingID = "SQS_NO_CONSUMER"
-	FindingSNSNoSubscribers       FindingID = "SNS_NO_SUBSCRIBERS"
-	FindingSNSIdle                FindingID = "SNS_IDLE"
-	FindingCloudFrontDisabled     FindingID = "CLOUDFRONT_DISABLED" // WO-189: disabled distribution hygiene signal.
-	FindingCloudFrontIdle         FindingID = "CLOUDFRONT_IDLE"     // WO-189: zero-request distribution hygiene signal.
+	FindingIdleEC2                   FindingID = "IDLE_EC2"
+	FindingStoppedEC2                FindingID = "STOPPED_EC2"
+	FindingRightsizeEC2              FindingID = "RIGHTSIZE_EC2"
+	FindingOldGenerationEC2          FindingID = "OLD_GENERATION_EC2"
+	FindingEC2SpotCandidate          FindingID = "EC2_SPOT_CANDIDATE"
+	FindingDetachedEBS               FindingID = "DETACHED_EBS"
+	FindingEBSGp2ToGp3               FindingID = "EBS_GP2_TO_GP3"
+	FindingEBSOverProvisionedIOPS    FindingID = "EBS_OVER_PROVISIONED_IOPS"
+	FindingRDSStorageOverProvisioned FindingID = "RDS_STORAGE_OVER_PROVISIONED"
+	FindingRDSIdleReadReplica        FindingID = "RDS_IDLE_READ_REPLICA"
+	FindingLambdaOverProvisioned     FindingID = "LAMBDA_OVER_PROVISIONED"
+	FindingLambdaIdleConcurrency     FindingID = "LAMBDA_IDLE_PROVISIONED_CONCURRENCY"
+	FindingUnusedEIP                 FindingID = "UNUSED_EIP"
+	FindingIdleALB                   FindingID = "IDLE_ALB"
+	FindingIdleNLB                   FindingID = "IDLE_NLB"
+	FindingIdleGWLB                  FindingID = "IDLE_GWLB"
+	FindingIdleNATGateway            FindingID = "IDLE_NAT_GATEWAY"
+	FindingLowTrafficNATGateway      FindingID = "LOW_TRAFFIC_NAT_GATEWAY"
+	FindingNATGatewayConsolidation   FindingID = "NAT_GATEWAY_CONSOLIDATION"
+	FindingIdleRDS                   FindingID = "IDLE_RDS"
+	FindingStaleSnapshot             FindingID = "STALE_SNAPSHOT"
+	FindingUnusedSecurityGroup       FindingID = "UNUSED_SECURITY_GROUP"
+	FindingIdleLambda                FindingID = "IDLE_LAMBDA"
+	FindingKinesisStreamIdle         FindingID = "KINESIS_STREAM_IDLE"
+	FindingKinesisOverProvisioned    FindingID = "KINESIS_OVER_PROVISIONED"
+	FindingKinesisFirehoseIdle       FindingID = "KINESIS_FIREHOSE_IDLE"
+	FindingSQSIdle                   FindingID = "SQS_IDLE"
+	FindingSQSDLQOrphaned            FindingID = "SQS_DLQ_ORPHANED"
+	FindingSQSNoConsumer             FindingID = "SQS_NO_CONSUMER"
+	FindingSNSNoSubscribers          FindingID = "SNS_NO_SUBSCRIBERS"
+	FindingSNSIdle                   FindingID = "SNS_IDLE"
+	FindingCloudFrontDisabled        FindingID = "CLOUDFRONT_DISABLED" // WO-189: disabled distribution hygiene signal.
+	FindingCloudFrontIdle            FindingID = "CLOUDFRONT_IDLE"     // WO-189: zero-request distribution hygiene signal.
+	FindingRDSUnnecessaryMonitoring  FindingID = "RDS_UNNECESSARY_MONITORING"
+	FindingIdleTGWPeering            FindingID = "IDLE_TGW_PEERING"
+	FindingTGWIdleAttachment         FindingID = "TGW_IDLE_ATTACHMENT"
+	FindingDynamoDBIdle              FindingID = "DYNAMODB_IDLE"
+	FindingDynamoDBOverProvisioned   FindingID = "DYNAMODB_OVER_PROVISIONED"
+	FindingRedshiftIdle              FindingID = "REDSHIFT_IDLE"
+	FindingRedshiftPauseable         FindingID = "REDSHIFT_PAUSEABLE"
+	FindingS3EmptyBucket             FindingID = "S3_EMPTY_BUCKET"
+	FindingS3NoLifecycle             FindingID = "S3_NO_LIFECYCLE"
+	FindingS3IncompleteMultipart     FindingID = "S3_INCOMPLETE_MULTIPART"
+	FindingS3StorageClassOpportunity FindingID = "S3_STORAGE_CLASS_OPPORTUNITY"
+	FindingEFSIdle                   FindingID = "EFS_IDLE"
+	FindingEFSNoLifecycle            FindingID = "EFS_NO_LIFECYCLE"
+	FindingEKSEmptyCluster           FindingID = "EKS_EMPTY_CLUSTER"
+	FindingECSIdleService            FindingID = "ECS_IDLE_SERVICE"
+	FindingECSZeroTaskCluster        FindingID = "ECS_ZERO_TASK_CLUSTER"
+	FindingDocDBIdle                 FindingID = "DOCDB_IDLE"
+	FindingNeptuneIdle               FindingID = "NEPTUNE_IDLE"
+	FindingMSKIdle                   FindingID = "MSK_IDLE"
+	FindingAPIGWIdleStage            FindingID = "APIGW_IDLE_STAGE"
+	FindingAPIGWUnusedCache          FindingID = "APIGW_UNUSED_CACHE"
+	FindingRoute53UnusedHealthCheck  FindingID = "ROUTE53_UNUSED_HEALTHCHECK"
+	FindingRoute53EmptyZone          FindingID = "ROUTE53_EMPTY_ZONE"
+	FindingSFNIdle                   FindingID = "SFN_IDLE"
+	FindingECRStaleImages            FindingID = "ECR_STALE_IMAGES"
+	FindingGlueIdleDevEndpoint       FindingID = "GLUE_IDLE_DEV_ENDPOINT"
+	FindingGlueUnusedJob             FindingID = "GLUE_UNUSED_JOB"
+	FindingSageMakerIdleNotebook     FindingID = "SAGEMAKER_IDLE_NOTEBOOK"
+	FindingSageMakerIdleEndpoint     FindingID = "SAGEMAKER_IDLE_ENDPOINT"
+	FindingGAIdle                    FindingID = "GA_IDLE"
+	FindingVPNIdle                   FindingID = "VPN_IDLE"
+	FindingWorkSpacesIdle            FindingID = "WORKSPACES_IDLE"
+	FindingWorkSpacesUnused          FindingID = "WORKSPACES_UNUSED"
+	FindingFSxIdle                   FindingID = "FSX_IDLE"
+	FindingSecretUnused              FindingID = "SECRET_UNUSED"
+	FindingKMSUnusedKey              FindingID = "KMS_UNUSED_KEY"
+	FindingBeanstalkIdleEnv          FindingID = "BEANSTALK_IDLE_ENV"
+	FindingAppRunnerIdle             FindingID = "APPRUNNER_IDLE"
+	FindingEMRIdleCluster            FindingID = "EMR_IDLE_CLUSTER"
+	FindingLogsNoRetention           FindingID = "LOGS_NO_RETENTION"
+	FindingLogsEmptyGroup            FindingID = "LOGS_EMPTY_GROUP"
+	FindingRDSStaleSnapshot          FindingID = "RDS_STALE_SNAPSHOT"
+	FindingUnusedENI                 FindingID = "UNUSED_ENI"
+	FindingPublicIPv4Unneeded        FindingID = "PUBLIC_IPV4_UNNEEDED"
+	FindingOrphanedTargetGroup       FindingID = "ORPHANED_TARGET_GROUP"
+	FindingStaleAMI                  FindingID = "STALE_AMI"
+	FindingDMSIdle                   FindingID = "DMS_IDLE"
+	FindingMQIdle                    FindingID = "MQ_IDLE"
+	FindingMemoryDBIdle              FindingID = "MEMORYDB_IDLE"
+	FindingRDSProxyIdle              FindingID = "RDS_PROXY_IDLE"
+	FindingOrphanedAlarm             FindingID = "ORPHANED_ALARM"
+	FindingUnusedDashboard           FindingID = "UNUSED_DASHBOARD"
+	FindingCognitoUnusedPool         FindingID = "COGNITO_UNUSED_POOL"
+	FindingAppSyncIdle               FindingID = "APPSYNC_IDLE"
+	FindingAppSyncUnusedCache        FindingID = "APPSYNC_UNUSED_CACHE"
+	FindingTimestreamIdle            FindingID = "TIMESTREAM_IDLE"
+	FindingAOSSIdle                  FindingID = "AOSS_IDLE"
 )
 
 // Finding represents a single waste detection result.
 type Finding struct {
-	ID                    FindingID      `json:"id"`
-	Severity              Severity       `json:"severity"`
-	ResourceType          ResourceType   `json:"resource_type"`
-	ResourceID            string         `json:"resource_id"`
-	ResourceName          string         `json:"resource_name,omitempty"`
-	Region                string         `json:"region"`
-	Message               string         `json:"message"`
-	EstimatedMonthlyWaste float64        `json:"estimated_monthly_waste"`
-	Hygiene               bool           `json:"hygiene,omitempty"` // WO-194: zero-waste hygiene findings bypass cost filtering structurally.
-	Metadata              map[string]any `json:"metadata,omitempty"`
+	ID                    FindingID    `json:"id"`
+	Severity              Severity     `json:"severity"`
+	ResourceType          ResourceType `json:"resource_type"`
+	ResourceID            string       `json:"resource_id"`
+	ResourceName          string       `json:"resource_name,omitempty"`
+	Region                string       `json:"region"`
+	Message               string       `json:"message"`
+	EstimatedMonthlyWaste float64      `json:"estimated_monthly_waste"`
+	// EstimatedMonthlyWasteLow/High bracket the point estimate when cost ranges are enabled.
+	EstimatedMonthlyWasteLow  float64        `json:"estimated_monthly_waste_low,omitempty"`
+	EstimatedMonthlyWasteHigh float64        `json:"estimated_monthly_waste_high,omitempty"`
+	Hygiene                   bool           `json:"hygiene,omitempty"` // WO-194: zero-waste hygiene findings bypass cost filtering structurally.
+	Metadata                  map[string]any `json:"metadata,omitempty"`
 }
 
 // ScanResult holds all findings from scanning a set of resources.
@@ -96,13 +277,43 @@ type ScanConfig struct {
 	HighMemoryThreshold  float64
 	StoppedThresholdDays int
 	NATGWLowTrafficGB    float64
+	CostRanges           bool
+	CommitmentAware      bool
+	SpotEligibleTags     map[string]string
 	Exclude              ExcludeConfig
+	// ResourceTypes limits the scan to scanners of these types; nil runs every scanner.
+	ResourceTypes map[ResourceType]bool
+	// TypeThresholds overrides the global idle thresholds for individual resource types.
+	TypeThresholds map[ResourceType]Thresholds
+}
+
+// Thresholds holds per-resource-type idle thresholds. Zero fields use the global value.
+type Thresholds struct {
+	IdleCPU    float64
+	HighMemory float64
+}
+
+// IdleCPUFor returns the idle CPU threshold for rt, falling back to IdleCPUThreshold.
+func (c ScanConfig) IdleCPUFor(rt ResourceType) float64 {
+	if t := c.TypeThresholds[rt].IdleCPU; t > 0 {
+		return t
+	}
+	return c.IdleCPUThreshold
+}
+
+// HighMemoryFor returns the high memory threshold for rt, falling back to HighMemoryThreshold.
+func (c ScanConfig) HighMemoryFor(rt ResourceType) float64 {
+	if t := c.TypeThresholds[rt].HighMemory; t > 0 {
+		return t
+	}
+	return c.HighMemoryThreshold
 }
 
 // ExcludeConfig holds resource exclusion rules.
 type ExcludeConfig struct {
-	ResourceIDs map[string]bool
-	Tags        map[string]string
+	ResourceIDs        map[string]bool
+	ResourceIDPatterns []*regexp.Regexp
+	Tags               map[string]string
 }
 
 // ShouldExclude returns true if a resource should be skipped based on its ID or tags.
@@ -111,10 +322,18 @@ func (e ExcludeConfig) ShouldExclude(resourceID string, tags map[string]string)
 	if e.ResourceIDs[resourceID] {
 		return true
 	}
-	if tags == nil || len(e.Tags) == 0 {
-		return false
+	for _, re := range e.ResourceIDPatterns {
+		if re.MatchString(resourceID) {
+			return true
+		}
 	}
-	for k, v := range e.Tags {
+	return matchesAnyTag(tags, e.Tags)
+}
+
+// matchesAnyTag reports whether tags contain any of the wanted tags. A wanted tag with
+// an empty value matches the key with any value.
+func matchesAnyTag(tags, want map[string]string) bool {
+	for k, v := range want {
 		tagVal, exists := tags[k]
 		if !exists {
 			continue
@@ -126,6 +345,20 @@ func (e ExcludeConfig) ShouldExclude(resourceID string, tags map[string]string)
 	return false
 }
 
+// setTagsMetadata records resource tags under the "tags" metadata key, which the
+// work-item builder uses to group findings belonging to the same application.
+func setTagsMetadata(meta map[string]any, tags map[string]string) {
+	if len(tags) > 0 {
+		meta["tags"] = tags
+	}
+}
+
+// withTags is setTagsMetadata for metadata built inline in a Finding literal.
+func withTags(meta map[string]any, tags map[string]string) map[string]any {
+	setTagsMetadata(meta, tags)
+	return meta
+}
+
 func ec2TagsToMap(tags []ec2types.Tag) map[string]string {
 	if len(tags) == 0 {
 		return nil
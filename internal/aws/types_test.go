@@ -2,6 +2,7 @@ package aws
 
 import (
 	"encoding/json"
+	"regexp"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -131,6 +132,25 @@ func TestExcludeConfig_ShouldExclude_ResourceID(t *testing.T) {
 	}
 }
 
+func TestExcludeConfig_ShouldExclude_ResourceIDPattern(t *testing.T) {
+	e := ExcludeConfig{ResourceIDPatterns: []*regexp.Regexp{
+		regexp.MustCompile(`^i-dev-`),
+		regexp.MustCompile(`^vol-0abc[0-9a-f]+$`),
+	}}
+	if !e.ShouldExclude("i-dev-0123", nil) {
+		t.Fatal("expected prefix pattern match to exclude")
+	}
+	if !e.ShouldExclude("vol-0abc99", nil) {
+		t.Fatal("expected anchored pattern match to exclude")
+	}
+	if e.ShouldExclude("i-prod-0123", nil) {
+		t.Fatal("expected non-matching ID to not exclude")
+	}
+	if e.ShouldExclude("vol-0abc99-snap", nil) {
+		t.Fatal("expected anchored pattern to not match a longer ID")
+	}
+}
+
 func TestExcludeConfig_ShouldExclude_TagKeyValue(t *testing.T) {
 	e := ExcludeConfig{Tags: map[string]string{"Environment": "production"}}
 	if !e.ShouldExclude("i-123", map[string]string{"Environment": "production"}) {
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// VPNAPI is the minimal interface for Site-to-Site VPN operations.
+type VPNAPI interface {
+	DescribeVpnConnections(ctx context.Context, input *ec2.DescribeVpnConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error)
+}
+
+// VPNScanner detects Site-to-Site VPN connections carrying no traffic.
+type VPNScanner struct {
+	client  VPNAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewVPNScanner creates a scanner for Site-to-Site VPN connections.
+func NewVPNScanner(client VPNAPI, metrics *MetricsFetcher, region string) *VPNScanner {
+	return &VPNScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *VPNScanner) Type() ResourceType {
+	return ResourceVPN
+}
+
+// Scan examines available VPN connections for zero tunnel traffic over the idle window.
+// Tunnel metrics are per connection, so traffic over either tunnel keeps it active.
+func (s *VPNScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	out, err := s.client.DescribeVpnConnections(ctx, &ec2.DescribeVpnConnectionsInput{
+		Filters: []ec2types.Filter{
+			{Name: awssdk.String("state"), Values: []string{"available"}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe VPN connections: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(out.VpnConnections)}
+
+	var ids []string
+	conns := make(map[string]ec2types.VpnConnection, len(out.VpnConnections))
+	for _, c := range out.VpnConnections {
+		id := deref(c.VpnConnectionId)
+		if cfg.Exclude.ShouldExclude(id, ec2TagsToMap(c.Tags)) {
+			continue
+		}
+		ids = append(ids, id)
+		conns[id] = c
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	traffic := make(map[string]float64, len(ids))
+	for _, metric := range []string{"TunnelDataIn", "TunnelDataOut"} {
+		sums, err := s.metrics.FetchSum(ctx, "AWS/VPN", metric, "VpnId", ids, cfg.IdleDays)
+		if err != nil {
+			slog.Warn("Failed to fetch VPN tunnel metrics", "region", s.region, "error", err)
+			return result, nil
+		}
+		for id, v := range sums {
+			traffic[id] += v
+		}
+	}
+
+	for _, id := range ids {
+		if traffic[id] > 0 {
+			continue
+		}
+		c := conns[id]
+
+		tunnelsUp := 0
+		tunnelStates := make(map[string]string, len(c.VgwTelemetry))
+		for _, t := range c.VgwTelemetry {
+			tunnelStates[deref(t.OutsideIpAddress)] = string(t.Status)
+			if t.Status == ec2types.TelemetryStatusUp {
+				tunnelsUp++
+			}
+		}
+
+		var msg string
+		switch {
+		case tunnelsUp == 0:
+			msg = fmt.Sprintf("All tunnels down and zero tunnel data over %d days", cfg.IdleDays)
+		case tunnelsUp < len(c.VgwTelemetry):
+			msg = fmt.Sprintf("Zero tunnel data over %d days (%d of %d tunnels up)", cfg.IdleDays, tunnelsUp, len(c.VgwTelemetry))
+		default:
+			msg = fmt.Sprintf("Zero tunnel data over %d days", cfg.IdleDays)
+		}
+
+		meta := map[string]any{
+			"customer_gateway_id": deref(c.CustomerGatewayId),
+			"tunnels_up":          tunnelsUp,
+			"tunnel_count":        len(c.VgwTelemetry),
+			"tunnel_states":       tunnelStates,
+		}
+		setTagsMetadata(meta, ec2TagsToMap(c.Tags))
+		if c.VpnGatewayId != nil {
+			meta["vpn_gateway_id"] = deref(c.VpnGatewayId)
+		}
+		if c.TransitGatewayId != nil {
+			meta["transit_gateway_id"] = deref(c.TransitGatewayId)
+		}
+
+		result.Findings = append(result.Findings, Finding{
+			ID:                    FindingVPNIdle,
+			Severity:              SeverityHigh,
+			ResourceType:          ResourceVPN,
+			ResourceID:            id,
+			ResourceName:          vpnConnectionName(c.Tags),
+			Region:                s.region,
+			Message:               msg,
+			EstimatedMonthlyWaste: pricing.MonthlyVPNConnectionCost(s.region),
+			Metadata:              meta,
+		})
+	}
+
+	return result, nil
+}
+
+func vpnConnectionName(tags []ec2types.Tag) string {
+	for _, tag := range tags {
+		if deref(tag.Key) == "Name" {
+			return deref(tag.Value)
+		}
+	}
+	return ""
+}
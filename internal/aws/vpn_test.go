@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockVPNClient struct {
+	connections []ec2types.VpnConnection
+	err         error
+}
+
+func (m *mockVPNClient) DescribeVpnConnections(_ context.Context, _ *ec2.DescribeVpnConnectionsInput, _ ...func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ec2.DescribeVpnConnectionsOutput{VpnConnections: m.connections}, nil
+}
+
+func vpnConnection(id string, tunnels ...ec2types.TelemetryStatus) ec2types.VpnConnection {
+	c := ec2types.VpnConnection{
+		VpnConnectionId:   awssdk.String(id),
+		CustomerGatewayId: awssdk.String("cgw-" + id),
+		VpnGatewayId:      awssdk.String("vgw-1"),
+		State:             ec2types.VpnStateAvailable,
+	}
+	for i, status := range tunnels {
+		c.VgwTelemetry = append(c.VgwTelemetry, ec2types.VgwTelemetry{
+			OutsideIpAddress: awssdk.String([]string{"203.0.113.1", "203.0.113.2"}[i]),
+			Status:           status,
+		})
+	}
+	return c
+}
+
+func TestVPNScanner_IdleConnections(t *testing.T) {
+	mock := &mockVPNClient{connections: []ec2types.VpnConnection{
+		vpnConnection("vpn-idle", ec2types.TelemetryStatusUp, ec2types.TelemetryStatusUp),
+		vpnConnection("vpn-half", ec2types.TelemetryStatusUp, ec2types.TelemetryStatusDown),
+		vpnConnection("vpn-down", ec2types.TelemetryStatusDown, ec2types.TelemetryStatusDown),
+		vpnConnection("vpn-active", ec2types.TelemetryStatusUp, ec2types.TelemetryStatusDown),
+	}}
+	metrics := newMockMetricsFetcher(map[string]float64{"vpn-active": 1024})
+	scanner := NewVPNScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 4 {
+		t.Fatalf("expected 4 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 3 {
+		t.Fatalf("expected 3 findings, got %d", len(result.Findings))
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	if _, ok := byID["vpn-active"]; ok {
+		t.Fatal("connection with tunnel traffic should not be flagged")
+	}
+
+	f := byID["vpn-idle"]
+	if f.ID != FindingVPNIdle || f.Severity != SeverityHigh {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+	// $0.05/hr * 730 = $36.50
+	if f.EstimatedMonthlyWaste != 36.50 {
+		t.Fatalf("expected $36.50, got $%.2f", f.EstimatedMonthlyWaste)
+	}
+	if f.Metadata["customer_gateway_id"] != "cgw-vpn-idle" || f.Metadata["vpn_gateway_id"] != "vgw-1" {
+		t.Fatalf("unexpected metadata: %v", f.Metadata)
+	}
+
+	half := byID["vpn-half"]
+	if half.Metadata["tunnels_up"] != 1 || half.Metadata["tunnel_count"] != 2 {
+		t.Fatalf("unexpected tunnel metadata: %v", half.Metadata)
+	}
+	states := half.Metadata["tunnel_states"].(map[string]string)
+	if states["203.0.113.1"] != "UP" || states["203.0.113.2"] != "DOWN" {
+		t.Fatalf("unexpected tunnel states: %v", states)
+	}
+	if half.Message != "Zero tunnel data over 14 days (1 of 2 tunnels up)" {
+		t.Fatalf("unexpected message: %s", half.Message)
+	}
+
+	if down := byID["vpn-down"]; down.Message != "All tunnels down and zero tunnel data over 14 days" {
+		t.Fatalf("unexpected message: %s", down.Message)
+	}
+}
+
+func TestVPNScanner_Excluded(t *testing.T) {
+	mock := &mockVPNClient{connections: []ec2types.VpnConnection{
+		vpnConnection("vpn-idle", ec2types.TelemetryStatusUp, ec2types.TelemetryStatusUp),
+	}}
+	scanner := NewVPNScanner(mock, zeroTrafficMetrics(), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays: 14,
+		Exclude:  ExcludeConfig{ResourceIDs: map[string]bool{"vpn-idle": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestVPNScanner_APIError(t *testing.T) {
+	scanner := NewVPNScanner(&mockVPNClient{err: errors.New("denied")}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14}); err == nil {
+		t.Fatal("expected error")
+	}
+}
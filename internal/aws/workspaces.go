@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	wstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+// WorkSpacesAPI is the minimal interface for WorkSpaces operations.
+type WorkSpacesAPI interface {
+	DescribeWorkspaces(ctx context.Context, input *workspaces.DescribeWorkspacesInput, opts ...func(*workspaces.Options)) (*workspaces.DescribeWorkspacesOutput, error)
+}
+
+// WorkSpacesScanner detects WorkSpaces that users no longer connect to.
+type WorkSpacesScanner struct {
+	client  WorkSpacesAPI
+	metrics *MetricsFetcher
+	region  string
+}
+
+// NewWorkSpacesScanner creates a scanner for WorkSpaces.
+func NewWorkSpacesScanner(client WorkSpacesAPI, metrics *MetricsFetcher, region string) *WorkSpacesScanner {
+	return &WorkSpacesScanner{client: client, metrics: metrics, region: region}
+}
+
+// Type returns the resource type.
+func (s *WorkSpacesScanner) Type() ResourceType {
+	return ResourceWorkSpaces
+}
+
+// Scan looks up the last hour with a connection attempt or connected user for each
+// WorkSpace. WorkSpaces with no connection within StaleDays are unused in any running
+// mode; AlwaysOn WorkSpaces with no connection within IdleDays should switch to AutoStop.
+func (s *WorkSpacesScanner) Scan(ctx context.Context, cfg ScanConfig) (*ScanResult, error) {
+	all, err := s.listWorkspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list workspaces: %w", err)
+	}
+
+	result := &ScanResult{ResourcesScanned: len(all)}
+
+	var ids []string
+	wsMap := make(map[string]wstypes.Workspace, len(all))
+	for _, ws := range all {
+		id := deref(ws.WorkspaceId)
+		if cfg.Exclude.ShouldExclude(id, nil) {
+			continue
+		}
+		// Only settled WorkSpaces bill predictably; pending, errored, or terminating ones are skipped.
+		if ws.State != wstypes.WorkspaceStateAvailable && ws.State != wstypes.WorkspaceStateStopped {
+			continue
+		}
+		ids = append(ids, id)
+		wsMap[id] = ws
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	lookbackDays := cfg.IdleDays
+	if cfg.StaleDays > lookbackDays {
+		lookbackDays = cfg.StaleDays
+	}
+
+	lastConnected := make(map[string]time.Time, len(ids))
+	for _, metric := range []string{"ConnectionAttempt", "UserConnected"} {
+		series, err := s.metrics.FetchSeries(ctx, "AWS/WorkSpaces", metric, "WorkspaceId", ids, lookbackDays, "Maximum")
+		if err != nil {
+			slog.Warn("Failed to fetch WorkSpaces connection metrics", "region", s.region, "error", err)
+			return result, nil
+		}
+		for id, points := range series {
+			for _, p := range points {
+				if p.Value > 0 && p.Timestamp.After(lastConnected[id]) {
+					lastConnected[id] = p.Timestamp
+				}
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	idleCutoff := now.Add(-time.Duration(cfg.IdleDays) * 24 * time.Hour)
+	staleCutoff := now.Add(-time.Duration(cfg.StaleDays) * 24 * time.Hour)
+
+	for _, id := range ids {
+		ws := wsMap[id]
+		last := lastConnected[id]
+
+		var runningMode wstypes.RunningMode
+		var computeType wstypes.Compute
+		if ws.WorkspaceProperties != nil {
+			runningMode = ws.WorkspaceProperties.RunningMode
+			computeType = ws.WorkspaceProperties.ComputeTypeName
+		}
+
+		meta := map[string]any{
+			"running_mode": string(runningMode),
+			"bundle_id":    deref(ws.BundleId),
+			"compute_type": string(computeType),
+			"state":        string(ws.State),
+			"user_name":    deref(ws.UserName),
+		}
+		if !last.IsZero() {
+			meta["last_connected"] = last.Format(time.RFC3339)
+		}
+
+		finding := Finding{
+			ResourceType: ResourceWorkSpaces,
+			ResourceID:   id,
+			ResourceName: deref(ws.ComputerName),
+			Region:       s.region,
+			Metadata:     meta,
+		}
+
+		switch {
+		case cfg.StaleDays > 0 && !last.After(staleCutoff):
+			finding.ID = FindingWorkSpacesUnused
+			finding.Severity = SeverityHigh
+			finding.Message = fmt.Sprintf("No user connections over %d days (%s, %s)", cfg.StaleDays, runningMode, computeType)
+			finding.EstimatedMonthlyWaste = pricing.MonthlyWorkSpacesCost(string(computeType), string(runningMode), s.region)
+		case runningMode == wstypes.RunningModeAlwaysOn && !last.After(idleCutoff):
+			alwaysOn := pricing.MonthlyWorkSpacesCost(string(computeType), string(wstypes.RunningModeAlwaysOn), s.region)
+			autoStop := pricing.MonthlyWorkSpacesCost(string(computeType), string(wstypes.RunningModeAutoStop), s.region)
+			finding.ID = FindingWorkSpacesIdle
+			finding.Severity = SeverityMedium
+			finding.Message = fmt.Sprintf("AlwaysOn with no user connections over %d days; switch to AutoStop", cfg.IdleDays)
+			finding.EstimatedMonthlyWaste = max(alwaysOn-autoStop, 0)
+		default:
+			continue
+		}
+
+		result.Findings = append(result.Findings, finding)
+	}
+
+	return result, nil
+}
+
+func (s *WorkSpacesScanner) listWorkspaces(ctx context.Context) ([]wstypes.Workspace, error) {
+	var all []wstypes.Workspace
+	paginator := workspaces.NewDescribeWorkspacesPaginator(s.client, &workspaces.DescribeWorkspacesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Workspaces...)
+	}
+	return all, nil
+}
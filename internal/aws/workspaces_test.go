@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	wstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+)
+
+type mockWorkSpacesClient struct {
+	workspaces []wstypes.Workspace
+	err        error
+}
+
+func (m *mockWorkSpacesClient) DescribeWorkspaces(_ context.Context, _ *workspaces.DescribeWorkspacesInput, _ ...func(*workspaces.Options)) (*workspaces.DescribeWorkspacesOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &workspaces.DescribeWorkspacesOutput{Workspaces: m.workspaces}, nil
+}
+
+func workspace(id string, mode wstypes.RunningMode, state wstypes.WorkspaceState) wstypes.Workspace {
+	return wstypes.Workspace{
+		WorkspaceId:  awssdk.String(id),
+		BundleId:     awssdk.String("wsb-123"),
+		ComputerName: awssdk.String("WS-" + id),
+		UserName:     awssdk.String("alice"),
+		State:        state,
+		WorkspaceProperties: &wstypes.WorkspaceProperties{
+			RunningMode:     mode,
+			ComputeTypeName: wstypes.ComputeStandard,
+		},
+	}
+}
+
+// lastConnectionMetrics returns a single connection datapoint per WorkSpace at the given age.
+func lastConnectionMetrics(ages map[string]time.Duration) *MetricsFetcher {
+	return NewMetricsFetcher(&mockCloudWatchClient{
+		getMetricDataFn: func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			var results []cwtypes.MetricDataResult
+			for i, q := range input.MetricDataQueries {
+				age, ok := ages[*q.MetricStat.Metric.Dimensions[0].Value]
+				if !ok {
+					continue
+				}
+				results = append(results, cwtypes.MetricDataResult{
+					Id:         awssdk.String(fmt.Sprintf("m%d", i)),
+					Values:     []float64{1},
+					Timestamps: []time.Time{time.Now().UTC().Add(-age)},
+				})
+			}
+			return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+		},
+	})
+}
+
+func TestWorkSpacesScanner_IdleAndUnused(t *testing.T) {
+	day := 24 * time.Hour
+	mock := &mockWorkSpacesClient{workspaces: []wstypes.Workspace{
+		workspace("ws-idle", wstypes.RunningModeAlwaysOn, wstypes.WorkspaceStateAvailable),
+		workspace("ws-unused", wstypes.RunningModeAutoStop, wstypes.WorkspaceStateStopped),
+		workspace("ws-autostop-recent", wstypes.RunningModeAutoStop, wstypes.WorkspaceStateStopped),
+		workspace("ws-active", wstypes.RunningModeAlwaysOn, wstypes.WorkspaceStateAvailable),
+		workspace("ws-pending", wstypes.RunningModeAlwaysOn, wstypes.WorkspaceStatePending),
+	}}
+	metrics := lastConnectionMetrics(map[string]time.Duration{
+		"ws-idle":            30 * day,
+		"ws-autostop-recent": 30 * day,
+		"ws-active":          2 * day,
+	})
+	scanner := NewWorkSpacesScanner(mock, metrics, "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourcesScanned != 5 {
+		t.Fatalf("expected 5 scanned, got %d", result.ResourcesScanned)
+	}
+	if len(result.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %+v", len(result.Findings), result.Findings)
+	}
+
+	byID := findingsByResourceID(result.Findings)
+	idle := byID["ws-idle"]
+	if idle.ID != FindingWorkSpacesIdle || idle.Severity != SeverityMedium {
+		t.Fatalf("unexpected idle finding: %+v", idle)
+	}
+	// STANDARD AlwaysOn $35.00 - AutoStop fee $9.75 = $25.25
+	if idle.EstimatedMonthlyWaste != 25.25 {
+		t.Fatalf("expected $25.25, got $%.2f", idle.EstimatedMonthlyWaste)
+	}
+	if idle.Metadata["running_mode"] != "ALWAYS_ON" || idle.Metadata["bundle_id"] != "wsb-123" {
+		t.Fatalf("unexpected metadata: %v", idle.Metadata)
+	}
+	if _, ok := idle.Metadata["last_connected"]; !ok {
+		t.Fatal("expected last_connected in metadata")
+	}
+
+	unused := byID["ws-unused"]
+	if unused.ID != FindingWorkSpacesUnused || unused.Severity != SeverityHigh {
+		t.Fatalf("unexpected unused finding: %+v", unused)
+	}
+	if unused.EstimatedMonthlyWaste != 9.75 {
+		t.Fatalf("expected AutoStop fee $9.75, got $%.2f", unused.EstimatedMonthlyWaste)
+	}
+}
+
+func TestWorkSpacesScanner_UnusedAlwaysOnTakesPrecedence(t *testing.T) {
+	mock := &mockWorkSpacesClient{workspaces: []wstypes.Workspace{
+		workspace("ws-1", wstypes.RunningModeAlwaysOn, wstypes.WorkspaceStateAvailable),
+	}}
+	scanner := NewWorkSpacesScanner(mock, lastConnectionMetrics(nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14, StaleDays: 90})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].ID != FindingWorkSpacesUnused {
+		t.Fatalf("expected a single WORKSPACES_UNUSED finding, got %+v", result.Findings)
+	}
+	if result.Findings[0].EstimatedMonthlyWaste != 35.00 {
+		t.Fatalf("expected full AlwaysOn cost $35.00, got $%.2f", result.Findings[0].EstimatedMonthlyWaste)
+	}
+}
+
+func TestWorkSpacesScanner_Excluded(t *testing.T) {
+	mock := &mockWorkSpacesClient{workspaces: []wstypes.Workspace{
+		workspace("ws-1", wstypes.RunningModeAlwaysOn, wstypes.WorkspaceStateAvailable),
+	}}
+	scanner := NewWorkSpacesScanner(mock, lastConnectionMetrics(nil), "us-east-1")
+
+	result, err := scanner.Scan(context.Background(), ScanConfig{
+		IdleDays:  14,
+		StaleDays: 90,
+		Exclude:   ExcludeConfig{ResourceIDs: map[string]bool{"ws-1": true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Findings) != 0 {
+		t.Fatalf("expected 0 findings, got %d", len(result.Findings))
+	}
+}
+
+func TestWorkSpacesScanner_APIError(t *testing.T) {
+	scanner := NewWorkSpacesScanner(&mockWorkSpacesClient{err: errors.New("denied")}, zeroTrafficMetrics(), "us-east-1")
+	if _, err := scanner.Scan(context.Background(), ScanConfig{IdleDays: 14}); err == nil {
+		t.Fatal("expected error")
+	}
+}
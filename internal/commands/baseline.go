@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var baselineFlags struct {
+	outputFile string
+	fromReport string
+}
+
+// baselineScanFlags are the scan flags that select and analyze resources. The baseline
+// command shares them with scan, so it finds what scan would with the same flags;
+// report, delivery, gate, and --baseline flags do not apply.
+var baselineScanFlags = []string{
+	"regions", "all-regions", "include-opt-in", "exclude-regions",
+	"idle-days", "stale-days", "min-monthly-cost",
+	"idle-cpu-threshold", "high-memory-threshold", "stopped-threshold-days", "nat-gw-low-traffic-gb",
+	"exclude-tags", "resource-types", "pricing", "pricing-overrides",
+	"commitment-aware", "spot-eligible-tags", "no-progress", "timeout",
+}
+
+var baselineCmd = &cobra.Command{
+	Use:   "baseline",
+	Short: "Scan and write a baseline file of the current findings",
+	Long: `Scan like scan does, with the same region, threshold, and filter flags, and write
+the fingerprint (region/resource_type/resource_id/finding_id) of every finding,
+one per line. With --from-report, read the findings from a report written with
+--format json (or spectrehub) instead of scanning.
+Pass the file to scan --baseline to suppress those findings in later scans;
+new findings are still reported. Delete lines to stop accepting a finding.`,
+	Args: cobra.NoArgs,
+	RunE: runBaseline,
+}
+
+func init() {
+	baselineCmd.Flags().StringVarP(&baselineFlags.outputFile, "output", "o", "", "Output file path (default: stdout)")
+	baselineCmd.Flags().StringVar(&baselineFlags.fromReport, "from-report", "", "Read findings from this JSON report instead of scanning")
+}
+
+// addBaselineScanFlags adds the shared scan flags to the baseline command. It is
+// called from scan's init, once the scan flags are defined.
+func addBaselineScanFlags(scanFlagSet *pflag.FlagSet) {
+	for _, name := range baselineScanFlags {
+		baselineCmd.Flags().AddFlag(scanFlagSet.Lookup(name))
+	}
+}
+
+func runBaseline(cmd *cobra.Command, _ []string) error {
+	findings, err := baselineFindings(cmd)
+	if err != nil {
+		return err
+	}
+	if baselineFlags.outputFile == "" {
+		return analyzer.WriteBaseline(cmd.OutOrStdout(), findings)
+	}
+	var buf bytes.Buffer
+	if err := analyzer.WriteBaseline(&buf, findings); err != nil {
+		return err
+	}
+	if err := os.WriteFile(baselineFlags.outputFile, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write baseline: %w", err)
+	}
+	return nil
+}
+
+// baselineFindings returns the findings to write: those in --from-report, or those
+// of a fresh scan.
+func baselineFindings(cmd *cobra.Command) ([]awstype.Finding, error) {
+	if baselineFlags.fromReport != "" {
+		data, err := loadReport(baselineFlags.fromReport)
+		if err != nil {
+			return nil, err
+		}
+		return data.Findings, nil
+	}
+
+	if err := applyConfigDefaults(scanCmd.LocalFlags()); err != nil {
+		return nil, err
+	}
+	// An existing baseline (e.g. from AWSSPECTRE_BASELINE) would drop the findings
+	// it already accepts from the new one.
+	scanFlags.baseline = ""
+
+	ctx := cmd.Context()
+	if scanFlags.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, scanFlags.timeout)
+		defer cancel()
+	}
+	run, err := scanAndAnalyze(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return run.data.Findings, nil
+}
+
+// loadBaseline reads a scan --baseline file. An empty path means no baseline.
+func loadBaseline(path string) (map[string]bool, error) {
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open baseline: %w", err)
+	}
+	defer f.Close()
+	baseline, err := analyzer.ParseBaseline(f)
+	if err != nil {
+		return nil, fmt.Errorf("parse baseline %s: %w", path, err)
+	}
+	return baseline, nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestBaselineCommand(t *testing.T) {
+	reportPath := writeReport(t, "scan.json", `
+		{"id": "IDLE_EC2", "severity": "high", "resource_type": "ec2", "resource_id": "i-accepted", "region": "us-east-1", "estimated_monthly_waste": 40},
+		{"id": "UNUSED_EIP", "severity": "medium", "resource_type": "eip", "resource_id": "eipalloc-1", "region": "eu-west-1", "estimated_monthly_waste": 3.6}`)
+	baselinePath := filepath.Join(t.TempDir(), "baseline.txt")
+
+	rootCmd.SetArgs([]string{"baseline", "--from-report", reportPath, "-o", baselinePath})
+	t.Cleanup(func() {
+		rootCmd.SetArgs(nil)
+		baselineFlags.outputFile = ""
+		baselineFlags.fromReport = ""
+	})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("baseline: %v", err)
+	}
+
+	baseline, err := loadBaseline(baselinePath)
+	if err != nil {
+		t.Fatalf("load baseline: %v", err)
+	}
+	for _, fp := range []string{"us-east-1/ec2/i-accepted/IDLE_EC2", "eu-west-1/eip/eipalloc-1/UNUSED_EIP"} {
+		if !baseline[fp] {
+			t.Fatalf("expected %s in baseline, got %v", fp, baseline)
+		}
+	}
+	if len(baseline) != 2 {
+		t.Fatalf("expected 2 fingerprints, got %v", baseline)
+	}
+}
+
+func TestLoadBaseline_Missing(t *testing.T) {
+	if baseline, err := loadBaseline(""); err != nil || baseline != nil {
+		t.Fatalf("expected no baseline for an empty path, got %v, %v", baseline, err)
+	}
+	if _, err := loadBaseline(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatal("expected an error for a missing baseline file")
+	}
+}
+
+func TestBaselineSharesScanFlags(t *testing.T) {
+	for _, name := range baselineScanFlags {
+		if got, want := baselineCmd.Flags().Lookup(name), scanCmd.Flags().Lookup(name); got == nil || got != want {
+			t.Fatalf("baseline --%s is not the scan flag", name)
+		}
+	}
+	if baselineCmd.Flags().Lookup("baseline") != nil {
+		t.Fatal("baseline should not accept --baseline")
+	}
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/report"
+)
+
+// configFetchTimeout bounds fetching a remote --config.
+const configFetchTimeout = 30 * time.Second
+
+// maxConfigSize is the largest remote config accepted.
+const maxConfigSize = 1 << 20
+
+// fetchConfig retrieves a remote --config from https:// with a GET or from s3://
+// with GetObject, using the --profile credentials and default region.
+func fetchConfig(ctx context.Context, uri string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, configFetchTimeout)
+	defer cancel()
+
+	if strings.HasPrefix(uri, "s3://") {
+		client, err := aws.NewClient(ctx, profile, "")
+		if err != nil {
+			return nil, err
+		}
+		region := client.Config().Region
+		if region == "" {
+			region = "us-east-1"
+		}
+		return fetchS3Object(ctx, client.NewS3Client(region), uri)
+	}
+	return fetchHTTPS(ctx, http.DefaultClient, uri)
+}
+
+// s3GetAPI is the minimal interface for reading a config object from S3.
+type s3GetAPI interface {
+	GetObject(ctx context.Context, input *s3.GetObjectInput, opts ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+}
+
+func fetchS3Object(ctx context.Context, client s3GetAPI, uri string) ([]byte, error) {
+	bucket, key, err := report.ParseS3URI(uri)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, fmt.Errorf("invalid S3 URI %q: missing object key", uri)
+	}
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{Bucket: awssdk.String(bucket), Key: awssdk.String(key)})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = out.Body.Close() }()
+	return readConfigBody(uri, out.Body)
+}
+
+func fetchHTTPS(ctx context.Context, client *http.Client, uri string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("GET %s: %s", uri, resp.Status)
+	}
+	return readConfigBody(uri, resp.Body)
+}
+
+// readConfigBody reads a remote config, failing rather than truncating one larger
+// than maxConfigSize.
+func readConfigBody(uri string, r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxConfigSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxConfigSize {
+		return nil, fmt.Errorf("config %s exceeds %d bytes", uri, maxConfigSize)
+	}
+	return data, nil
+}
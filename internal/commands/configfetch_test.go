@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+type mockS3Get struct {
+	bucket, key string
+	body        string
+	err         error
+}
+
+func (m *mockS3Get) GetObject(_ context.Context, input *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	m.bucket, m.key = *input.Bucket, *input.Key
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(m.body))}, nil
+}
+
+func TestFetchS3Object(t *testing.T) {
+	client := &mockS3Get{body: "min_monthly_cost: 5\n"}
+	data, err := fetchS3Object(context.Background(), client, "s3://team-configs/awsspectre/prod.yaml")
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if client.bucket != "team-configs" || client.key != "awsspectre/prod.yaml" {
+		t.Fatalf("unexpected object s3://%s/%s", client.bucket, client.key)
+	}
+	if string(data) != "min_monthly_cost: 5\n" {
+		t.Fatalf("unexpected body %q", data)
+	}
+
+	if _, err := fetchS3Object(context.Background(), client, "s3://team-configs"); err == nil {
+		t.Fatal("expected an error for a URI without a key")
+	}
+	if _, err := fetchS3Object(context.Background(), &mockS3Get{err: errors.New("NoSuchKey")}, "s3://team-configs/a.yaml"); err == nil {
+		t.Fatal("expected GetObject error")
+	}
+}
+
+func TestFetchHTTPS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/awsspectre.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, "min_monthly_cost: 5\n")
+	}))
+	defer srv.Close()
+
+	data, err := fetchHTTPS(context.Background(), srv.Client(), srv.URL+"/awsspectre.yaml")
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if string(data) != "min_monthly_cost: 5\n" {
+		t.Fatalf("unexpected body %q", data)
+	}
+
+	_, err = fetchHTTPS(context.Background(), srv.Client(), srv.URL+"/missing.yaml")
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+}
+
+func TestFetchS3Object_TooLarge(t *testing.T) {
+	client := &mockS3Get{body: strings.Repeat("#", maxConfigSize)}
+	if _, err := fetchS3Object(context.Background(), client, "s3://team-configs/a.yaml"); err != nil {
+		t.Fatalf("expected a config of exactly maxConfigSize to load, got %v", err)
+	}
+
+	client.body += "#"
+	_, err := fetchS3Object(context.Background(), client, "s3://team-configs/a.yaml")
+	if err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("expected a size limit error, got %v", err)
+	}
+}
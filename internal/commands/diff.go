@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var diffFlags struct {
+	format string
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <old.json> <new.json>",
+	Short: "Compare two JSON scan reports",
+	Long: `Compare the findings of two reports written with --format json (or spectrehub)
+and print the findings that were added, removed, or changed in cost or severity,
+plus the net change in total monthly waste. Findings are matched by region,
+resource type, resource ID, and finding ID.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffFlags.format, "format", "text", "Output format: text or json")
+}
+
+// diffReport is the --format json output of the diff command.
+type diffReport struct {
+	Old diffSource `json:"old"`
+	New diffSource `json:"new"`
+	analyzer.FindingsDiff
+}
+
+type diffSource struct {
+	File      string    `json:"file"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	if diffFlags.format != "text" && diffFlags.format != "json" {
+		return fmt.Errorf("unsupported format: %s (use text or json)", diffFlags.format)
+	}
+	oldData, err := loadReport(args[0])
+	if err != nil {
+		return err
+	}
+	newData, err := loadReport(args[1])
+	if err != nil {
+		return err
+	}
+
+	out := diffReport{
+		Old:          diffSource{File: args[0], Timestamp: oldData.Timestamp},
+		New:          diffSource{File: args[1], Timestamp: newData.Timestamp},
+		FindingsDiff: analyzer.DiffFindings(oldData.Findings, newData.Findings),
+	}
+	if diffFlags.format == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return fmt.Errorf("encode diff: %w", err)
+		}
+		return nil
+	}
+	return writeDiffText(cmd.OutOrStdout(), out)
+}
+
+// loadReport reads a JSON or SpectreHub report written by awsspectre scan.
+func loadReport(path string) (report.Data, error) {
+	var data report.Data
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return data, fmt.Errorf("read report: %w", err)
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return data, fmt.Errorf("parse report %s: %w", path, err)
+	}
+	if data.Tool != "awsspectre" {
+		return data, fmt.Errorf("%s is not an awsspectre JSON report (write one with --format json)", path)
+	}
+	return data, nil
+}
+
+func writeDiffText(out io.Writer, d diffReport) error {
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "awsspectre — Scan Diff")
+	fmt.Fprintln(tw, strings.Repeat("=", 40))
+	fmt.Fprintf(tw, "Old: %s (%s)\n", d.Old.File, d.Old.Timestamp.Format(time.RFC3339))
+	fmt.Fprintf(tw, "New: %s (%s)\n", d.New.File, d.New.Timestamp.Format(time.RFC3339))
+
+	writeDiffSection(tw, "Added", "+", d.Added)
+	writeDiffSection(tw, "Removed", "-", d.Removed)
+	if len(d.Changed) > 0 {
+		fmt.Fprintf(tw, "\nChanged (%d):\n", len(d.Changed))
+		for _, c := range d.Changed {
+			severity := string(c.New.Severity)
+			if c.Old.Severity != c.New.Severity {
+				severity = string(c.Old.Severity) + " → " + severity
+			}
+			fmt.Fprintf(tw, "  ~\t%s\t%s\t%s\t%s\t$%.2f → $%.2f (%s)\n",
+				severity, c.New.ID, diffResourceName(c.New), c.New.Region,
+				c.Old.EstimatedMonthlyWaste, c.New.EstimatedMonthlyWaste, signedDollars(c.WasteDelta))
+		}
+	}
+
+	fmt.Fprintf(tw, "\nUnchanged: %d\n", d.Unchanged)
+	fmt.Fprintf(tw, "Total monthly waste: $%.2f → $%.2f (net %s)\n", d.OldWaste, d.NewWaste, signedDollars(d.NetChange))
+	return tw.Flush()
+}
+
+func writeDiffSection(tw io.Writer, title, marker string, findings []awstype.Finding) {
+	if len(findings) == 0 {
+		return
+	}
+	fmt.Fprintf(tw, "\n%s (%d):\n", title, len(findings))
+	for _, f := range findings {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t$%.2f\t%s\n",
+			marker, f.Severity, f.ID, diffResourceName(f), f.Region, f.EstimatedMonthlyWaste, f.Message)
+	}
+}
+
+func diffResourceName(f awstype.Finding) string {
+	if f.ResourceName != "" {
+		return f.ResourceName + " (" + f.ResourceID + ")"
+	}
+	return f.ResourceID
+}
+
+func signedDollars(v float64) string {
+	if v < 0 {
+		return fmt.Sprintf("-$%.2f", -v)
+	}
+	return fmt.Sprintf("+$%.2f", v)
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeReport(t *testing.T, name, findings string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	content := `{"$schema": "spectre/v1", "tool": "awsspectre", "timestamp": "2026-10-01T00:00:00Z", "findings": [` + findings + `]}`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+	return path
+}
+
+func runDiffCommand(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs(append([]string{"diff"}, args...))
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetArgs(nil)
+		diffFlags.format = "text"
+	})
+	err := rootCmd.Execute()
+	return out.String(), err
+}
+
+func TestDiffCommand(t *testing.T) {
+	oldPath := writeReport(t, "old.json", `
+		{"id": "IDLE_EC2", "severity": "high", "resource_type": "ec2", "resource_id": "i-gone", "region": "us-east-1", "estimated_monthly_waste": 40},
+		{"id": "IDLE_EC2", "severity": "high", "resource_type": "ec2", "resource_id": "i-grew", "region": "us-east-1", "estimated_monthly_waste": 10}`)
+	newPath := writeReport(t, "new.json", `
+		{"id": "IDLE_EC2", "severity": "high", "resource_type": "ec2", "resource_id": "i-grew", "region": "us-east-1", "estimated_monthly_waste": 25},
+		{"id": "DETACHED_EBS", "severity": "medium", "resource_type": "ebs", "resource_id": "vol-new", "region": "us-east-1", "estimated_monthly_waste": 5}`)
+
+	out, err := runDiffCommand(t, oldPath, newPath)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	for _, want := range []string{"Added (1):", "vol-new", "Removed (1):", "i-gone", "Changed (1):", "$10.00 → $25.00 (+$15.00)", "$50.00 → $30.00 (net -$20.00)"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	out, err = runDiffCommand(t, "--format", "json", oldPath, newPath)
+	if err != nil {
+		t.Fatalf("diff --format json: %v", err)
+	}
+	var got struct {
+		Added     []map[string]any `json:"added"`
+		Removed   []map[string]any `json:"removed"`
+		Changed   []map[string]any `json:"changed"`
+		NetChange float64          `json:"net_change"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(got.Added) != 1 || len(got.Removed) != 1 || len(got.Changed) != 1 || got.NetChange != -20 {
+		t.Fatalf("unexpected JSON diff: %s", out)
+	}
+}
+
+func TestDiffCommand_RejectsNonReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "other.json")
+	if err := os.WriteFile(path, []byte(`{"hello": "world"}`), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if _, err := runDiffCommand(t, path, path); err == nil {
+		t.Fatal("expected an error for a file that is not an awsspectre report")
+	}
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// ErrGateFailed is returned by scan when --fail-over or --fail-on-severity trips.
+// The report has been written by then; the error only sets the exit code.
+var ErrGateFailed = errors.New("waste gate failed")
+
+// exitGateFailed is the process exit code for ErrGateFailed, distinct from the
+// exit code 1 of scan errors so CI can tell them apart.
+const exitGateFailed = 2
+
+// severityRank orders severities from least to most severe.
+var severityRank = map[awstype.Severity]int{
+	awstype.SeverityLow:    1,
+	awstype.SeverityMedium: 2,
+	awstype.SeverityHigh:   3,
+}
+
+// ExitCode maps a command error to a process exit code.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, ErrGateFailed):
+		return exitGateFailed
+	default:
+		return 1
+	}
+}
+
+// validateFailOnSeverity checks a --fail-on-severity value before scanning.
+func validateFailOnSeverity(severity string) error {
+	if severity == "" {
+		return nil
+	}
+	if _, ok := severityRank[awstype.Severity(severity)]; !ok {
+		return fmt.Errorf("invalid --fail-on-severity %q (use low, medium, or high)", severity)
+	}
+	return nil
+}
+
+// checkGates returns ErrGateFailed when total waste exceeds failOver (if positive)
+// or any finding is at or above failOnSeverity (if set).
+func checkGates(summary analyzer.Summary, failOver float64, failOnSeverity string) error {
+	if failOver > 0 && summary.TotalMonthlyWaste > failOver {
+		return fmt.Errorf("%w: estimated monthly waste $%.2f exceeds --fail-over $%.2f", ErrGateFailed, summary.TotalMonthlyWaste, failOver)
+	}
+	if failOnSeverity == "" {
+		return nil
+	}
+	threshold := severityRank[awstype.Severity(failOnSeverity)]
+	count := 0
+	for severity, n := range summary.BySeverity {
+		if severityRank[awstype.Severity(severity)] >= threshold {
+			count += n
+		}
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d findings at or above %s severity", ErrGateFailed, count, failOnSeverity)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+)
+
+func TestCheckGates(t *testing.T) {
+	summary := analyzer.Summary{
+		TotalMonthlyWaste: 120,
+		BySeverity:        map[string]int{"medium": 2, "low": 1},
+	}
+	tests := []struct {
+		name           string
+		failOver       float64
+		failOnSeverity string
+		wantFail       bool
+	}{
+		{"no gates", 0, "", false},
+		{"under threshold", 200, "", false},
+		{"at threshold", 120, "", false},
+		{"over threshold", 100, "", true},
+		{"no findings at high", 0, "high", false},
+		{"findings at medium", 0, "medium", true},
+		{"findings above low", 0, "low", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGates(summary, tt.failOver, tt.failOnSeverity)
+			if got := errors.Is(err, ErrGateFailed); got != tt.wantFail {
+				t.Fatalf("expected gate failure %v, got %v", tt.wantFail, err)
+			}
+		})
+	}
+}
+
+func TestValidateFailOnSeverity(t *testing.T) {
+	for _, s := range []string{"", "low", "medium", "high"} {
+		if err := validateFailOnSeverity(s); err != nil {
+			t.Fatalf("expected %q to be valid, got %v", s, err)
+		}
+	}
+	if err := validateFailOnSeverity("critical"); err == nil {
+		t.Fatal("expected an error for an unknown severity")
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	if code := ExitCode(nil); code != 0 {
+		t.Fatalf("expected 0, got %d", code)
+	}
+	if code := ExitCode(errors.New("boom")); code != 1 {
+		t.Fatalf("expected 1, got %d", code)
+	}
+	if code := ExitCode(fmt.Errorf("wrapped: %w", ErrGateFailed)); code != 2 {
+		t.Fatalf("expected 2, got %d", code)
+	}
+}
@@ -94,19 +94,72 @@ format: text
 # Scan timeout
 timeout: 10m
 
+# Price source: embedded, or live to query the AWS Price List API
+# (EC2 and RDS instance prices, cached on disk; embedded prices are the fallback)
+# pricing: embedded
+
+# JSON file of negotiated prices in the embedded data's shape
+# (resource type -> type -> region -> price); replaces matching embedded and live prices
+# pricing_overrides: prices.json
+
+# Also show waste in another currency (ISO 4217 code); fx_rate is units per US dollar
+# and defaults to an embedded rate for common currencies
+# currency: EUR
+# fx_rate: 0.92
+
+# Report idle EC2 and RDS instances paid for by active Reserved Instances at $0 waste
+# commitment_aware: false
+
+# Compare on-demand and Spot cost for running instances with these tags
+# spot_eligible_tags:
+#   - "spot-eligible=true"
+
+# Run only these scanners (see --resource-types); empty runs all of them
+# resource_types:
+#   - ec2
+#   - rds
+
+# Break down estimated waste by the value of this cost-allocation tag
+# group_by_tag: team
+
+# CI gating: exit with code 2 when total waste exceeds fail_over (USD)
+# or any finding is at or above fail_on_severity (low, medium, high)
+# fail_over: 500
+# fail_on_severity: high
+
 # Idle detection thresholds
 # idle_cpu_threshold: 5.0
 # high_memory_threshold: 50.0
 # stopped_threshold_days: 30
 # nat_gw_low_traffic_gb: 1.0
 
+# Per-resource-type overrides of min_monthly_cost
+# min_monthly_cost_by_type:
+#   eip: 0
+#   ec2: 10
+
+# Replace the severity of a finding ID (low, medium, or high)
+# severity_overrides:
+#   UNUSED_SECURITY_GROUP: medium
+
+# Per-resource-type overrides of idle_cpu_threshold and high_memory_threshold
+# thresholds:
+#   ec2:
+#     idle_cpu: 3
+#   rds:
+#     idle_cpu: 10
+
 # Resources to exclude from scanning
 # exclude:
 #   resource_ids:
 #     - i-0abc123
+#   resource_id_patterns:   # regular expressions; anchor with ^ and $
+#     - "^i-dev-"
 #   tags:
 #     - "Environment=production"
 #     - "awsspectre:ignore"
+#   regions:
+#     - "us-gov-*"
 `
 
 // WO-199: generated IAM policy must cover CloudFront distribution inventory.
@@ -121,26 +174,118 @@ const sampleIAMPolicy = `{
         "ec2:DescribeVolumes",
         "ec2:DescribeAddresses",
         "ec2:DescribeNatGateways",
+        "ec2:DescribeSubnets",
         "ec2:DescribeSecurityGroups",
         "ec2:DescribeNetworkInterfaces",
         "ec2:DescribeSnapshots",
         "ec2:DescribeImages",
         "ec2:DescribeRegions",
+        "ec2:DescribeTransitGatewayPeeringAttachments",
+        "ec2:DescribeTransitGatewayAttachments",
+        "ec2:DescribeVpnConnections",
+        "ec2:DescribeReservedInstances",
+        "ec2:DescribeSpotPriceHistory",
         "elasticloadbalancing:DescribeLoadBalancers",
         "elasticloadbalancing:DescribeTargetGroups",
         "elasticloadbalancing:DescribeTargetHealth",
+        "elasticloadbalancing:DescribeTags",
         "rds:DescribeDBInstances",
         "rds:DescribeDBSnapshots",
+        "rds:DescribeDBClusterSnapshots",
+        "rds:DescribeDBClusters",
+        "rds:DescribeDBProxies",
+        "rds:DescribeDBProxyTargets",
+        "rds:DescribeDBProxyEndpoints",
+        "rds:DescribeReservedDBInstances",
+        "rds:ListTagsForResource",
         "lambda:ListFunctions",
+        "lambda:ListProvisionedConcurrencyConfigs",
         "kinesis:ListStreams",
         "kinesis:DescribeStreamSummary",
         "firehose:ListDeliveryStreams",
+        "firehose:DescribeDeliveryStream",
         "sqs:ListQueues",
         "sqs:GetQueueAttributes",
         "sns:ListTopics",
         "sns:ListSubscriptionsByTopic",
         "cloudfront:ListDistributions",
+        "dynamodb:ListTables",
+        "dynamodb:DescribeTable",
+        "dynamodb:ListTagsOfResource",
+        "redshift:DescribeClusters",
+        "s3:ListAllMyBuckets",
+        "s3:GetLifecycleConfiguration",
+        "s3:GetBucketTagging",
+        "s3:ListBucketMultipartUploads",
+        "s3:ListMultipartUploadParts",
+        "elasticfilesystem:DescribeFileSystems",
+        "elasticfilesystem:DescribeLifecycleConfiguration",
+        "eks:ListClusters",
+        "eks:DescribeCluster",
+        "eks:ListFargateProfiles",
+        "ecs:ListClusters",
+        "ecs:DescribeClusters",
+        "ecs:ListServices",
+        "ecs:DescribeServices",
+        "ecs:DescribeTaskDefinition",
+        "kafka:ListClustersV2",
+        "kafka:ListTopics",
+        "apigateway:GET",
+        "route53:ListHostedZones",
+        "route53:ListHealthChecks",
+        "route53:ListResourceRecordSets",
+        "states:ListStateMachines",
+        "ecr:DescribeRepositories",
+        "ecr:DescribeImages",
+        "ecr:GetLifecyclePolicy",
+        "ecr:ListTagsForResource",
+        "glue:GetDevEndpoints",
+        "glue:GetJobs",
+        "glue:GetJobRuns",
+        "sagemaker:ListNotebookInstances",
+        "sagemaker:ListEndpoints",
+        "sagemaker:DescribeEndpoint",
+        "sagemaker:DescribeEndpointConfig",
+        "globalaccelerator:ListAccelerators",
+        "globalaccelerator:ListListeners",
+        "globalaccelerator:ListEndpointGroups",
+        "workspaces:DescribeWorkspaces",
+        "fsx:DescribeFileSystems",
+        "secretsmanager:ListSecrets",
+        "kms:ListKeys",
+        "kms:DescribeKey",
+        "kms:GetKeyRotationStatus",
+        "cloudtrail:LookupEvents",
+        "elasticbeanstalk:DescribeEnvironments",
+        "elasticbeanstalk:DescribeEnvironmentResources",
+        "apprunner:ListServices",
+        "apprunner:DescribeService",
+        "apprunner:DescribeAutoScalingConfiguration",
+        "elasticmapreduce:ListClusters",
+        "elasticmapreduce:ListInstanceGroups",
+        "elasticmapreduce:ListInstanceFleets",
+        "logs:DescribeLogGroups",
+        "logs:DescribeLogStreams",
+        "dms:DescribeReplicationInstances",
+        "dms:DescribeReplicationTasks",
+        "mq:ListBrokers",
+        "mq:DescribeBroker",
+        "memorydb:DescribeClusters",
+        "cognito-idp:ListUserPools",
+        "cognito-idp:DescribeUserPool",
+        "cognito-idp:ListUserPoolClients",
+        "appsync:ListGraphqlApis",
+        "appsync:GetApiCache",
+        "timestream:DescribeEndpoints",
+        "timestream:ListDatabases",
+        "timestream:ListTables",
+        "aoss:ListCollections",
+        "aoss:BatchGetCollection",
         "cloudwatch:GetMetricData",
+        "cloudwatch:DescribeAlarms",
+        "cloudwatch:ListDashboards",
+        "cloudwatch:GetDashboard",
+        "pricing:GetProducts",
         "sts:GetCallerIdentity"
       ],
       "Resource": "*"
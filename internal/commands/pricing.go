@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/pricing"
+	"github.com/spf13/cobra"
+)
+
+var pricingFlags struct {
+	show             string
+	region           string
+	refresh          bool
+	pricingOverrides string
+}
+
+var pricingCmd = &cobra.Command{
+	Use:   "pricing",
+	Short: "Inspect and refresh pricing data",
+	Long: `Print the embedded pricing data version and the resource types it covers.
+Use --show to list the prices a scan would use for one resource type in a region,
+including --pricing-overrides and cached live prices, and --refresh to refetch EC2
+and RDS prices from the AWS Price List API into the live pricing cache.`,
+	RunE: runPricing,
+}
+
+func init() {
+	pricingCmd.Flags().StringVar(&pricingFlags.show, "show", "", "Resource type to list prices for (e.g. ec2, rds, ebs)")
+	pricingCmd.Flags().StringVar(&pricingFlags.region, "region", "us-east-1", "Region to resolve prices for")
+	pricingCmd.Flags().BoolVar(&pricingFlags.refresh, "refresh", false, "Refetch EC2 and RDS prices for --region from the AWS Price List API into the live pricing cache")
+	pricingCmd.Flags().StringVar(&pricingFlags.pricingOverrides, "pricing-overrides", "", "JSON file of prices that replace embedded and live prices")
+}
+
+func runPricing(cmd *cobra.Command, _ []string) error {
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "Embedded pricing data: %s\n", pricing.DataVersion)
+
+	overrides := pricingFlags.pricingOverrides
+	if overrides == "" {
+		overrides = cfg.PricingOverrides
+	}
+	if overrides != "" {
+		if err := pricing.LoadOverrides(overrides); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Pricing overrides: %s\n", overrides)
+	}
+
+	cachePath, err := pricing.DefaultLiveCachePath()
+	if err != nil {
+		slog.Warn("No user cache directory, live prices will not be cached", "error", err)
+	}
+	if pricingFlags.refresh {
+		if err := refreshLivePrices(cmd.Context(), w, cachePath); err != nil {
+			return err
+		}
+	} else if cachePath != "" && pricingFlags.show != "" {
+		// Show cached live prices without calling the API; the pricer is never asked
+		// to fetch, so it needs no client.
+		pricing.UseLivePricer(pricing.NewLivePricer(nil, cachePath))
+	}
+	defer pricing.UseLivePricer(nil)
+
+	if pricingFlags.show == "" {
+		fmt.Fprintln(w, "Resource types:")
+		for _, resourceType := range pricing.ResourceTypes() {
+			fmt.Fprintf(w, "  %s\n", resourceType)
+		}
+		return nil
+	}
+
+	prices := pricing.Prices(pricingFlags.show, pricingFlags.region)
+	if len(prices) == 0 {
+		return fmt.Errorf("no prices for resource type %q (run 'awsspectre pricing' to list resource types)", pricingFlags.show)
+	}
+	return writePriceTable(w, prices)
+}
+
+// refreshLivePrices refetches every live-priced resource type for --region and saves the cache.
+func refreshLivePrices(ctx context.Context, w io.Writer, cachePath string) error {
+	prof := profile
+	if prof == "" {
+		prof = cfg.Profile
+	}
+	client, err := aws.NewClient(ctx, prof, "")
+	if err != nil {
+		return enhanceError("initialize AWS client", err)
+	}
+
+	p := pricing.NewLivePricer(client.NewPriceListClient(), cachePath)
+	for _, resourceType := range pricing.LiveResourceTypes() {
+		found, err := p.Refresh(resourceType, pricingFlags.region)
+		if err != nil {
+			return enhanceError("refresh "+resourceType+" prices", err)
+		}
+		fmt.Fprintf(w, "Refreshed %s: %d prices found in %s\n", resourceType, found, pricingFlags.region)
+	}
+	if err := p.Save(); err != nil {
+		return fmt.Errorf("save price list cache: %w", err)
+	}
+	if cachePath != "" {
+		fmt.Fprintf(w, "Live pricing cache: %s\n", cachePath)
+	}
+	pricing.UseLivePricer(p)
+	return nil
+}
+
+// writePriceTable renders prices as an aligned table. Prices are in the unit of the
+// underlying data: hourly for instance types, monthly for flat-rate resources.
+func writePriceTable(w io.Writer, prices []pricing.Price) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tREGION\tPRICE (USD)\tSOURCE")
+	for _, p := range prices {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", p.Type, p.Region, strconv.FormatFloat(p.Price, 'f', -1, 64), p.Source)
+	}
+	return tw.Flush()
+}
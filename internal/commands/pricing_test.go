@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ppiankov/awsspectre/internal/pricing"
+)
+
+func TestPricingCommand_Show(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs([]string{"pricing", "--show", "ec2", "--region", "eu-west-1"})
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetArgs(nil)
+		pricingFlags.show = ""
+		pricingFlags.region = "us-east-1"
+	})
+
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("pricing command: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"Embedded pricing data: " + pricing.DataVersion,
+		"TYPE",
+		"t3.large",
+		"eu-west-1",
+		"embedded",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
@@ -4,16 +4,16 @@ import (
 	"github.com/ppiankov/awsspectre/internal/config"
 	"github.com/ppiankov/awsspectre/internal/logging"
 	"github.com/spf13/cobra"
-	"log/slog"
 )
 
 var (
-	verbose bool
-	profile string
-	version string
-	commit  string
-	date    string
-	cfg     config.Config
+	verbose    bool
+	profile    string
+	configPath string
+	version    string
+	commit     string
+	date       string
+	cfg        config.Config
 )
 
 var rootCmd = &cobra.Command{
@@ -24,14 +24,24 @@ for nothing. It scans EC2 instances, EBS volumes, Elastic IPs, load balancers,
 NAT Gateways, RDS instances, snapshots, and security groups across all regions.
 
 Each finding includes an estimated monthly waste in USD.`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		logging.Init(verbose)
+		if configPath != "" {
+			loaded, err := config.LoadFrom(cmd.Context(), configPath, fetchConfig)
+			if err != nil {
+				return err
+			}
+			cfg = loaded
+			return nil
+		}
+		// A missing .awsspectre.yaml is not an error, but one that does not parse or
+		// validate is: scanning without it would silently drop its exclusions.
 		loaded, err := config.Load(".")
 		if err != nil {
-			slog.Warn("Failed to load config file", "error", err)
-		} else {
-			cfg = loaded
+			return err
 		}
+		cfg = loaded
+		return nil
 	},
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -48,7 +58,11 @@ func Execute(v, c, d string) error {
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Enable verbose logging")
 	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "AWS profile name")
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Config file path, s3://bucket/key, or https:// URL (default: .awsspectre.yaml in the current directory)")
 	rootCmd.AddCommand(scanCmd)
 	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(baselineCmd)
+	rootCmd.AddCommand(pricingCmd)
 	rootCmd.AddCommand(versionCmd)
 }
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ppiankov/awsspectre/internal/config"
+)
+
+// runInDir executes the root command with args in dir, which holds content as
+// .awsspectre.yaml when content is non-empty.
+func runInDir(t *testing.T, content string, args ...string) error {
+	t.Helper()
+	dir := t.TempDir()
+	if content != "" {
+		if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+	}
+	t.Chdir(dir)
+	savedCfg := cfg
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs(args)
+	t.Cleanup(func() {
+		cfg = savedCfg
+		rootCmd.SetOut(nil)
+		rootCmd.SetArgs(nil)
+	})
+	return rootCmd.Execute()
+}
+
+func TestRoot_MissingConfigIsNotAnError(t *testing.T) {
+	if err := runInDir(t, "", "version"); err != nil {
+		t.Fatalf("unexpected error without a config file: %v", err)
+	}
+	if len(cfg.Exclude.ResourceIDs) != 0 || cfg.IdleDays != 0 {
+		t.Fatalf("expected an empty config, got %+v", cfg)
+	}
+}
+
+func TestRoot_InvalidResourceIDPatternFails(t *testing.T) {
+	cfg = config.Config{}
+	content := `exclude:
+  resource_ids:
+    - i-keep-out
+  resource_id_patterns:
+    - "i-(dev"
+`
+	err := runInDir(t, content, "version")
+	if err == nil || !strings.Contains(err.Error(), "i-(dev") {
+		t.Fatalf("expected a config error naming the pattern, got %v", err)
+	}
+}
+
+func TestRoot_InvalidSeverityOverrideFails(t *testing.T) {
+	content := `severity_overrides:
+  UNUSED_SECURITY_GROUP: critical
+`
+	err := runInDir(t, content, "version")
+	if err == nil || !strings.Contains(err.Error(), "critical") {
+		t.Fatalf("expected a config error naming the severity, got %v", err)
+	}
+
+	// The same config passed with --config fails the same way.
+	path := filepath.Join(t.TempDir(), "shared.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Cleanup(func() { configPath = "" })
+	err = runInDir(t, "", "--config", path, "version")
+	if err == nil || !strings.Contains(err.Error(), "critical") {
+		t.Fatalf("expected --config error naming the severity, got %v", err)
+	}
+}
@@ -1,22 +1,31 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
+	"net/smtp"
 	"os"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/ppiankov/awsspectre/internal/analyzer"
 	"github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/config"
+	"github.com/ppiankov/awsspectre/internal/pricing"
 	"github.com/ppiankov/awsspectre/internal/report"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var scanFlags struct {
 	regions              []string
 	allRegions           bool
+	includeOptIn         bool
 	idleDays             int
 	staleDays            int
 	format               string
@@ -27,6 +36,26 @@ var scanFlags struct {
 	stoppedThresholdDays int
 	natGWLowTrafficGB    float64
 	excludeTags          []string
+	excludeRegions       []string
+	resourceTypes        []string
+	costRanges           bool
+	groupByTag           string
+	baseline             string
+	pricing              string
+	pricingOverrides     string
+	currency             string
+	fxRate               float64
+	commitmentAware      bool
+	spotEligibleTags     []string
+	slackWebhook         string
+	emitCloudWatch       bool
+	cloudWatchNamespace  string
+	uploadS3             string
+	emailTo              []string
+	emailFrom            string
+	smtpServer           string
+	failOver             float64
+	failOnSeverity       string
 	noProgress           bool
 	timeout              time.Duration
 }
@@ -35,16 +64,21 @@ var scanCmd = &cobra.Command{
 	Use:   "scan",
 	Short: "Scan AWS resources for waste",
 	Long: `Scan AWS resources across regions to find idle, orphaned, and oversized
-resources. Reports estimated monthly waste in USD for each finding.`,
+resources. Reports estimated monthly waste in USD for each finding.
+
+Every flag can also be set with an AWSSPECTRE_ environment variable named after
+it (e.g. AWSSPECTRE_IDLE_DAYS for --idle-days). Flags take precedence over the
+environment, and the environment over the config file.`,
 	RunE: runScan,
 }
 
 func init() {
 	scanCmd.Flags().StringSliceVar(&scanFlags.regions, "regions", nil, "Comma-separated region filter")
 	scanCmd.Flags().BoolVar(&scanFlags.allRegions, "all-regions", true, "Scan all enabled regions")
+	scanCmd.Flags().BoolVar(&scanFlags.includeOptIn, "include-opt-in", true, "Include enabled opt-in regions (e.g. ap-east-1, me-south-1) when scanning all regions")
 	scanCmd.Flags().IntVar(&scanFlags.idleDays, "idle-days", 7, "Lookback window for utilization metrics (days)")
-	scanCmd.Flags().IntVar(&scanFlags.staleDays, "stale-days", 90, "Age threshold for snapshots/volumes (days)")
-	scanCmd.Flags().StringVar(&scanFlags.format, "format", "text", "Output format: text, json, sarif, spectrehub")
+	scanCmd.Flags().IntVar(&scanFlags.staleDays, "stale-days", 90, "Age threshold for snapshots, AMIs, DMS instances, volumes, ECR images, WorkSpaces, secrets, KMS keys, and log groups (days)")
+	scanCmd.Flags().StringVar(&scanFlags.format, "format", "text", "Output format: text, json, sarif, spectrehub, workitems, csv, html, markdown, prometheus, junit")
 	scanCmd.Flags().StringVarP(&scanFlags.outputFile, "output", "o", "", "Output file path (default: stdout)")
 	scanCmd.Flags().Float64Var(&scanFlags.minMonthlyCost, "min-monthly-cost", 1.0, "Minimum monthly cost to report ($)")
 	scanCmd.Flags().Float64Var(&scanFlags.idleCPUThreshold, "idle-cpu-threshold", 0, "CPU % below which a resource is idle (default: 5)")
@@ -52,11 +86,38 @@ func init() {
 	scanCmd.Flags().IntVar(&scanFlags.stoppedThresholdDays, "stopped-threshold-days", 0, "Days stopped before flagging EC2 (default: 30)")
 	scanCmd.Flags().Float64Var(&scanFlags.natGWLowTrafficGB, "nat-gw-low-traffic-gb", 0, "NAT Gateway monthly GB below which to flag as low traffic (default: 1)")
 	scanCmd.Flags().StringSliceVar(&scanFlags.excludeTags, "exclude-tags", nil, "Exclude resources by tag (Key=Value or Key, comma-separated)")
+	scanCmd.Flags().StringSliceVar(&scanFlags.resourceTypes, "resource-types", nil, "Run only the scanners for these resource types (e.g. ec2,rds,ebs); default runs all")
+	scanCmd.Flags().StringSliceVar(&scanFlags.excludeRegions, "exclude-regions", nil, "Skip these regions or glob patterns (e.g. us-gov-*), comma-separated; regions named in --regions are still scanned")
+	scanCmd.Flags().BoolVar(&scanFlags.costRanges, "cost-ranges", false, "Report low/high waste ranges alongside point estimates")
+	scanCmd.Flags().StringVar(&scanFlags.groupByTag, "group-by-tag", "", "Break down estimated monthly waste by the value of this tag key (e.g. team)")
+	scanCmd.Flags().StringVar(&scanFlags.baseline, "baseline", "", "Suppress findings listed in this baseline file (write one with awsspectre baseline)")
+	scanCmd.Flags().StringVar(&scanFlags.pricing, "pricing", "embedded", "Price source: embedded, or live to query the AWS Price List API with an on-disk cache")
+	scanCmd.Flags().StringVar(&scanFlags.pricingOverrides, "pricing-overrides", "", "JSON file of prices (resource type, type, region) that replace embedded and live prices")
+	scanCmd.Flags().StringVar(&scanFlags.currency, "currency", "USD", "Currency to show waste in besides USD (ISO 4217 code, e.g. EUR, GBP)")
+	scanCmd.Flags().Float64Var(&scanFlags.fxRate, "fx-rate", 0, "Units of --currency per US dollar (default: embedded rate)")
+	scanCmd.Flags().BoolVar(&scanFlags.commitmentAware, "commitment-aware", false, "Report idle EC2 and RDS instances paid for by active Reserved Instances at $0 waste")
+	scanCmd.Flags().StringSliceVar(&scanFlags.spotEligibleTags, "spot-eligible-tags", nil, "Compare on-demand and Spot cost for running instances with these tags (Key=Value or Key, comma-separated)")
+	scanCmd.Flags().StringVar(&scanFlags.slackWebhook, "slack-webhook", "", "Slack incoming webhook URL to post a scan summary to, in addition to --format output")
+	scanCmd.Flags().BoolVar(&scanFlags.emitCloudWatch, "emit-cloudwatch-metric", false, "Publish total and per-resource-type estimated monthly waste as CloudWatch custom metrics (needs cloudwatch:PutMetricData)")
+	scanCmd.Flags().StringVar(&scanFlags.cloudWatchNamespace, "cloudwatch-namespace", report.DefaultCloudWatchNamespace, "CloudWatch namespace for --emit-cloudwatch-metric")
+	scanCmd.Flags().StringVar(&scanFlags.uploadS3, "upload-s3", "", "Also upload the report to s3://bucket/prefix under a key named after the scan timestamp")
+	scanCmd.Flags().StringSliceVar(&scanFlags.emailTo, "email-to", nil, "Email the HTML report, with the --format report attached, to these addresses (requires --smtp-server)")
+	scanCmd.Flags().StringVar(&scanFlags.emailFrom, "email-from", "awsspectre@localhost", "Sender address for --email-to")
+	scanCmd.Flags().StringVar(&scanFlags.smtpServer, "smtp-server", "", "SMTP server host:port for --email-to; credentials are read from AWSSPECTRE_SMTP_USERNAME and AWSSPECTRE_SMTP_PASSWORD")
+	scanCmd.Flags().Float64Var(&scanFlags.failOver, "fail-over", 0, "Exit with code 2 when total estimated monthly waste exceeds this many USD")
+	scanCmd.Flags().StringVar(&scanFlags.failOnSeverity, "fail-on-severity", "", "Exit with code 2 when any finding is at or above this severity (low, medium, high)")
 	scanCmd.Flags().BoolVar(&scanFlags.noProgress, "no-progress", false, "Disable progress output")
 	scanCmd.Flags().DurationVar(&scanFlags.timeout, "timeout", 10*time.Minute, "Scan timeout")
+
+	addBaselineScanFlags(scanCmd.Flags())
 }
 
 func runScan(cmd *cobra.Command, _ []string) error {
+	// Apply environment and config file defaults where flags were not explicitly set
+	if err := applyConfigDefaults(cmd.LocalFlags()); err != nil {
+		return err
+	}
+
 	ctx := cmd.Context()
 	if scanFlags.timeout > 0 {
 		var cancel context.CancelFunc
@@ -64,9 +125,89 @@ func runScan(cmd *cobra.Command, _ []string) error {
 		defer cancel()
 	}
 
-	// Apply config file defaults where flags were not explicitly set
-	applyConfigDefaults()
+	currency, rate, err := setupCurrency()
+	if err != nil {
+		return err
+	}
+	if err := validateFailOnSeverity(scanFlags.failOnSeverity); err != nil {
+		return err
+	}
+	if scanFlags.uploadS3 != "" {
+		if _, _, err := report.ParseS3URI(scanFlags.uploadS3); err != nil {
+			return err
+		}
+	}
+	if len(scanFlags.emailTo) > 0 && scanFlags.smtpServer == "" {
+		return fmt.Errorf("--email-to requires --smtp-server")
+	}
+
+	run, err := scanAndAnalyze(ctx)
+	if err != nil {
+		return err
+	}
+	client, regions, data := run.client, run.regions, run.data
+	data.Currency = currency
+	data.ExchangeRate = rate
+
+	// Select and run reporter. With --upload-s3 or --email-to the report is generated
+	// into a buffer so the same bytes can be written out, uploaded, and attached.
+	out, err := openOutput(scanFlags.outputFile)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = out
+	var buf bytes.Buffer
+	buffered := scanFlags.uploadS3 != "" || len(scanFlags.emailTo) > 0
+	if buffered {
+		w = &buf
+	}
+	reporter, err := selectReporter(scanFlags.format, w)
+	if err != nil {
+		return err
+	}
+	if err := reporter.Generate(data); err != nil {
+		return err
+	}
+	if buffered {
+		if _, err := out.Write(buf.Bytes()); err != nil {
+			return fmt.Errorf("write report: %w", err)
+		}
+	}
+	if scanFlags.uploadS3 != "" {
+		if err := uploadReport(ctx, client, regions, data, buf.Bytes()); err != nil {
+			return enhanceError("upload report to S3", err)
+		}
+	}
+	if scanFlags.slackWebhook != "" {
+		slack := &report.SlackReporter{WebhookURL: scanFlags.slackWebhook}
+		if err := slack.Generate(data); err != nil {
+			return err
+		}
+	}
+	if len(scanFlags.emailTo) > 0 {
+		if err := emailReport(data, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	if scanFlags.emitCloudWatch {
+		if err := publishCloudWatchMetrics(ctx, client, regions, data); err != nil {
+			return enhanceError("publish CloudWatch metrics", err)
+		}
+	}
+	return checkGates(data.Summary, scanFlags.failOver, scanFlags.failOnSeverity)
+}
+
+// scanRun is the outcome of scanAndAnalyze.
+type scanRun struct {
+	client  *aws.Client
+	regions []string
+	data    report.Data
+}
 
+// scanAndAnalyze scans the regions and resource types selected by the scan flags and
+// analyzes the findings into report data, without a report currency. Config and
+// environment defaults must already be applied.
+func scanAndAnalyze(ctx context.Context) (*scanRun, error) {
 	// Resolve profile from flag or config
 	prof := profile
 	if prof == "" {
@@ -76,13 +217,34 @@ func runScan(cmd *cobra.Command, _ []string) error {
 	// Initialize AWS client
 	client, err := aws.NewClient(ctx, prof, "")
 	if err != nil {
-		return enhanceError("initialize AWS client", err)
+		return nil, enhanceError("initialize AWS client", err)
+	}
+
+	livePricer, err := setupPricing(client)
+	if err != nil {
+		return nil, err
+	}
+	resourceTypes, err := parseResourceTypes(scanFlags.resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+	minCostByType, err := parseMinCostByType(cfg.MinMonthlyCostByType)
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := loadBaseline(scanFlags.baseline)
+	if err != nil {
+		return nil, err
+	}
+	typeThresholds, err := parseTypeThresholds(cfg.Thresholds)
+	if err != nil {
+		return nil, err
 	}
 
 	// Determine regions to scan
 	regions, err := resolveRegions(ctx, client)
 	if err != nil {
-		return enhanceError("resolve regions", err)
+		return nil, enhanceError("resolve regions", err)
 	}
 	slog.Info("Scanning regions", "count", len(regions), "regions", regions)
 
@@ -109,6 +271,10 @@ func runScan(cmd *cobra.Command, _ []string) error {
 	for _, id := range cfg.Exclude.ResourceIDs {
 		excludeIDs[id] = true
 	}
+	excludePatterns, err := cfg.Exclude.CompileResourceIDPatterns()
+	if err != nil {
+		return nil, err
+	}
 	excludeTags := cfg.Exclude.ParseTags()
 	for _, s := range scanFlags.excludeTags {
 		if excludeTags == nil {
@@ -129,23 +295,42 @@ func runScan(cmd *cobra.Command, _ []string) error {
 		HighMemoryThreshold:  memThresh,
 		StoppedThresholdDays: stoppedDays,
 		NATGWLowTrafficGB:    natGWTraffic,
+		CostRanges:           scanFlags.costRanges,
+		CommitmentAware:      scanFlags.commitmentAware,
+		SpotEligibleTags:     config.ParseTagList(scanFlags.spotEligibleTags),
 		Exclude: aws.ExcludeConfig{
-			ResourceIDs: excludeIDs,
-			Tags:        excludeTags,
+			ResourceIDs:        excludeIDs,
+			ResourceIDPatterns: excludePatterns,
+			Tags:               excludeTags,
 		},
+		ResourceTypes:  resourceTypes,
+		TypeThresholds: typeThresholds,
 	}
 
 	// Run multi-region scan
+	pricing.ResetCoverage()
 	scanner := aws.NewMultiRegionScanner(client, regions, 4, scanCfg)
 	result, err := scanner.ScanAll(ctx)
 	if err != nil {
-		return enhanceError("scan resources", err)
+		return nil, enhanceError("scan resources", err)
 	}
 
 	// Analyze results: filter by min cost, compute summary
 	analysis := analyzer.Analyze(result, analyzer.AnalyzerConfig{
-		MinMonthlyCost: scanFlags.minMonthlyCost,
+		MinMonthlyCost:       scanFlags.minMonthlyCost,
+		MinMonthlyCostByType: minCostByType,
+		CostRanges:           scanFlags.costRanges,
+		GroupByTag:           scanFlags.groupByTag,
+		SeverityOverrides:    severityOverrides(cfg.SeverityOverrides),
+		Baseline:             baseline,
 	})
+	analysis.Summary.PricingCoverage = pricing.Coverage()
+	logPricingGaps(analysis.Summary.PricingCoverage)
+	if livePricer != nil {
+		if err := livePricer.Save(); err != nil {
+			slog.Warn("Failed to save price list cache", "error", err)
+		}
+	}
 
 	// Build report data
 	data := report.Data{
@@ -167,26 +352,224 @@ func runScan(cmd *cobra.Command, _ []string) error {
 		Errors:   analysis.Errors,
 	}
 
-	// Select and run reporter
-	reporter, err := selectReporter(scanFlags.format, scanFlags.outputFile)
+	return &scanRun{client: client, regions: regions, data: data}, nil
+}
+
+// publishCloudWatchMetrics publishes waste metrics to the default region, or to the
+// first scanned region when no default is configured.
+func publishCloudWatchMetrics(ctx context.Context, client *aws.Client, regions []string, data report.Data) error {
+	region := client.Config().Region
+	if region == "" {
+		region = regions[0]
+	}
+	publisher := &report.CloudWatchPublisher{
+		Client:    client.NewCloudWatchClient(region),
+		Namespace: scanFlags.cloudWatchNamespace,
+	}
+	if err := publisher.Publish(ctx, data); err != nil {
+		return err
+	}
+	slog.Info("Published CloudWatch metrics", "namespace", scanFlags.cloudWatchNamespace, "region", region)
+	return nil
+}
+
+// uploadReport uploads the generated report to --upload-s3 using the default region,
+// or the first scanned region when no default is configured.
+func uploadReport(ctx context.Context, client *aws.Client, regions []string, data report.Data, body []byte) error {
+	bucket, prefix, err := report.ParseS3URI(scanFlags.uploadS3)
 	if err != nil {
 		return err
 	}
-	return reporter.Generate(data)
+	region := client.Config().Region
+	if region == "" {
+		region = regions[0]
+	}
+	uploader := &report.S3Uploader{Client: client.NewS3Client(region), Bucket: bucket, Prefix: prefix}
+	key, err := uploader.Upload(ctx, scanFlags.format, data, body)
+	if err != nil {
+		return err
+	}
+	slog.Info("Uploaded report to S3", "bucket", bucket, "key", key)
+	return nil
 }
 
+// emailReport mails the report to --email-to, authenticating with PLAIN auth when
+// AWSSPECTRE_SMTP_USERNAME is set.
+func emailReport(data report.Data, attachment []byte) error {
+	var auth smtp.Auth
+	if username := os.Getenv("AWSSPECTRE_SMTP_USERNAME"); username != "" {
+		host, _, err := net.SplitHostPort(scanFlags.smtpServer)
+		if err != nil {
+			return fmt.Errorf("invalid --smtp-server %q: %w", scanFlags.smtpServer, err)
+		}
+		auth = smtp.PlainAuth("", username, os.Getenv("AWSSPECTRE_SMTP_PASSWORD"), host)
+	}
+	email := &report.EmailReporter{
+		Server:     scanFlags.smtpServer,
+		Auth:       auth,
+		From:       scanFlags.emailFrom,
+		To:         scanFlags.emailTo,
+		Format:     scanFlags.format,
+		Attachment: attachment,
+	}
+	if err := email.Generate(data); err != nil {
+		return err
+	}
+	slog.Info("Emailed report", "recipients", len(scanFlags.emailTo))
+	return nil
+}
+
+// setupPricing loads --pricing-overrides and installs the live pricer when --pricing=live. Embedded prices remain
+// the fallback for anything the Price List API does not answer.
+func setupPricing(client *aws.Client) (*pricing.LivePricer, error) {
+	if scanFlags.pricingOverrides != "" {
+		if err := pricing.LoadOverrides(scanFlags.pricingOverrides); err != nil {
+			return nil, err
+		}
+	}
+	switch scanFlags.pricing {
+	case "embedded":
+		pricing.UseLivePricer(nil)
+		return nil, nil
+	case "live":
+		cachePath, err := pricing.DefaultLiveCachePath()
+		if err != nil {
+			slog.Warn("No user cache directory, live prices will not be cached", "error", err)
+		}
+		p := pricing.NewLivePricer(client.NewPriceListClient(), cachePath)
+		pricing.UseLivePricer(p)
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unsupported pricing source: %s (use embedded or live)", scanFlags.pricing)
+	}
+}
+
+// setupCurrency validates --currency and installs --fx-rate. It returns an empty
+// currency for USD, which reports render without conversion.
+func setupCurrency() (string, float64, error) {
+	currency := strings.ToUpper(scanFlags.currency)
+	if currency == "" || currency == "USD" {
+		return "", 0, nil
+	}
+	if scanFlags.fxRate < 0 {
+		return "", 0, fmt.Errorf("invalid --fx-rate %g: must be positive", scanFlags.fxRate)
+	}
+	if scanFlags.fxRate > 0 {
+		pricing.SetExchangeRate(currency, scanFlags.fxRate)
+	}
+	rate, ok := pricing.ExchangeRate(currency)
+	if !ok {
+		return "", 0, fmt.Errorf("no exchange rate for currency %s: set --fx-rate", currency)
+	}
+	return currency, rate, nil
+}
+
+// parseResourceTypes converts --resource-types into a scanner filter, rejecting
+// names no scanner has. An empty list returns nil, which runs every scanner.
+func parseResourceTypes(names []string) (map[aws.ResourceType]bool, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	scannerTypes := aws.ScannerTypes()
+	known := make(map[aws.ResourceType]bool, len(scannerTypes))
+	valid := make([]string, 0, len(scannerTypes))
+	for _, t := range scannerTypes {
+		known[t] = true
+		valid = append(valid, string(t))
+	}
+	include := make(map[aws.ResourceType]bool, len(names))
+	for _, name := range names {
+		t := aws.ResourceType(strings.TrimSpace(name))
+		if !known[t] {
+			return nil, fmt.Errorf("unknown resource type %q (use one of: %s)", name, strings.Join(valid, ", "))
+		}
+		include[t] = true
+	}
+	return include, nil
+}
+
+// parseMinCostByType converts the min_monthly_cost_by_type config map, rejecting
+// keys no finding can have and negative values.
+func parseMinCostByType(costs map[string]float64) (map[aws.ResourceType]float64, error) {
+	if len(costs) == 0 {
+		return nil, nil
+	}
+	known := make(map[aws.ResourceType]bool)
+	for _, t := range aws.ResourceTypes() {
+		known[t] = true
+	}
+	parsed := make(map[aws.ResourceType]float64, len(costs))
+	for name, cost := range costs {
+		rt := aws.ResourceType(name)
+		if !known[rt] {
+			return nil, fmt.Errorf("unknown resource type %q in min_monthly_cost_by_type", name)
+		}
+		if cost < 0 {
+			return nil, fmt.Errorf("negative min_monthly_cost_by_type for %s", name)
+		}
+		parsed[rt] = cost
+	}
+	return parsed, nil
+}
+
+// severityOverrides converts the severity_overrides config map, already validated by
+// config.Load, into analyzer form.
+func severityOverrides(overrides map[string]string) map[aws.FindingID]aws.Severity {
+	if len(overrides) == 0 {
+		return nil
+	}
+	out := make(map[aws.FindingID]aws.Severity, len(overrides))
+	for id, severity := range overrides {
+		out[aws.FindingID(id)] = aws.Severity(severity)
+	}
+	return out
+}
+
+// parseTypeThresholds converts the thresholds config map into per-type scanner
+// thresholds, rejecting keys no scanner has and negative values.
+func parseTypeThresholds(thresholds map[string]config.Thresholds) (map[aws.ResourceType]aws.Thresholds, error) {
+	if len(thresholds) == 0 {
+		return nil, nil
+	}
+	known := make(map[aws.ResourceType]bool)
+	for _, t := range aws.ScannerTypes() {
+		known[t] = true
+	}
+	parsed := make(map[aws.ResourceType]aws.Thresholds, len(thresholds))
+	for name, t := range thresholds {
+		rt := aws.ResourceType(name)
+		if !known[rt] {
+			return nil, fmt.Errorf("unknown resource type %q in thresholds", name)
+		}
+		if t.IdleCPU < 0 || t.HighMemory < 0 {
+			return nil, fmt.Errorf("negative threshold for %s", name)
+		}
+		parsed[rt] = aws.Thresholds{IdleCPU: t.IdleCPU, HighMemory: t.HighMemory}
+	}
+	return parsed, nil
+}
+
+// resolveRegions returns the regions to scan, minus --exclude-regions and
+// exclude.regions. Regions named in --regions are never excluded.
 func resolveRegions(ctx context.Context, client *aws.Client) ([]string, error) {
+	excluded := append(append([]string(nil), cfg.Exclude.Regions...), scanFlags.excludeRegions...)
 	if len(scanFlags.regions) > 0 {
-		return scanFlags.regions, nil
+		warnDisabledRegions(ctx, client, scanFlags.regions)
+		return pruneRegions(scanFlags.regions, scanFlags.regions, excluded)
 	}
 
 	// Check config file
 	if len(cfg.Regions) > 0 {
-		return cfg.Regions, nil
+		warnDisabledRegions(ctx, client, cfg.Regions)
+		return pruneRegions(cfg.Regions, nil, excluded)
 	}
 
 	if scanFlags.allRegions {
-		return client.ListEnabledRegions(ctx)
+		regions, err := client.ListEnabledRegions(ctx, scanFlags.includeOptIn)
+		if err != nil {
+			return nil, err
+		}
+		return pruneRegions(regions, nil, excluded)
 	}
 
 	// Fall back to default region from AWS config
@@ -194,10 +577,79 @@ func resolveRegions(ctx context.Context, client *aws.Client) ([]string, error) {
 	if region == "" {
 		return nil, fmt.Errorf("no region specified; use --regions, --all-regions, or set AWS_REGION")
 	}
-	return []string{region}, nil
+	return pruneRegions([]string{region}, nil, excluded)
 }
 
-func applyConfigDefaults() {
+// pruneRegions removes regions matching any excluded name or glob pattern, except
+// those listed in explicit. It is an error for nothing to remain.
+func pruneRegions(regions, explicit, excluded []string) ([]string, error) {
+	if len(excluded) == 0 {
+		return regions, nil
+	}
+	keep := make(map[string]bool, len(explicit))
+	for _, r := range explicit {
+		keep[r] = true
+	}
+
+	var pruned []string
+	for _, r := range regions {
+		match, err := matchesAnyRegion(r, excluded)
+		if err != nil {
+			return nil, err
+		}
+		if match && !keep[r] {
+			slog.Debug("Skipping excluded region", "region", r)
+			continue
+		}
+		pruned = append(pruned, r)
+	}
+	if len(pruned) == 0 {
+		return nil, fmt.Errorf("all regions are excluded by --exclude-regions or exclude.regions")
+	}
+	return pruned, nil
+}
+
+func matchesAnyRegion(region string, patterns []string) (bool, error) {
+	for _, p := range patterns {
+		match, err := path.Match(p, region)
+		if err != nil {
+			return false, fmt.Errorf("invalid excluded region pattern %q: %w", p, err)
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// logPricingGaps reports resource types whose estimates fell back to $0 for lack of pricing data.
+func logPricingGaps(report []pricing.TypeCoverage) {
+	for _, c := range report {
+		if len(c.Missing) == 0 {
+			slog.Debug("Pricing coverage", "resource_type", c.ResourceType, "priced", c.Priced, "total", c.Total)
+			continue
+		}
+		slog.Warn(fmt.Sprintf("Priced %.0f%% of %s types; missing: %s", c.Percent, c.ResourceType, strings.Join(c.Missing, ", ")),
+			"resource_type", c.ResourceType)
+	}
+}
+
+// warnDisabledRegions logs explicitly requested opt-in regions the account has not enabled.
+func warnDisabledRegions(ctx context.Context, client *aws.Client, requested []string) {
+	disabled, err := client.DisabledRegions(ctx, requested)
+	if err != nil {
+		slog.Debug("Could not check region opt-in status", "error", err)
+		return
+	}
+	for _, region := range disabled {
+		slog.Warn("Requested region is an opt-in region that is not enabled for this account; its scanners will fail", "region", region)
+	}
+}
+
+// applyConfigDefaults fills scan flags from the config file and then from the
+// AWSSPECTRE_* environment variables of flags, so precedence is flag > environment >
+// config > default.
+func applyConfigDefaults(flags *pflag.FlagSet) error {
 	if scanFlags.format == "text" && cfg.Format != "" {
 		scanFlags.format = cfg.Format
 	}
@@ -222,18 +674,81 @@ func applyConfigDefaults() {
 	if scanFlags.natGWLowTrafficGB == 0 && cfg.NATGWLowTrafficGB > 0 {
 		scanFlags.natGWLowTrafficGB = cfg.NATGWLowTrafficGB
 	}
+	if scanFlags.pricing == "embedded" && cfg.Pricing != "" {
+		scanFlags.pricing = cfg.Pricing
+	}
+	if scanFlags.currency == "USD" && cfg.Currency != "" {
+		scanFlags.currency = cfg.Currency
+	}
+	if scanFlags.fxRate == 0 && cfg.FXRate > 0 {
+		scanFlags.fxRate = cfg.FXRate
+	}
+	if len(scanFlags.resourceTypes) == 0 && len(cfg.ResourceTypes) > 0 {
+		scanFlags.resourceTypes = cfg.ResourceTypes
+	}
+	if scanFlags.groupByTag == "" && cfg.GroupByTag != "" {
+		scanFlags.groupByTag = cfg.GroupByTag
+	}
+	if scanFlags.failOver == 0 && cfg.FailOver > 0 {
+		scanFlags.failOver = cfg.FailOver
+	}
+	if scanFlags.failOnSeverity == "" && cfg.FailOnSeverity != "" {
+		scanFlags.failOnSeverity = cfg.FailOnSeverity
+	}
+	if scanFlags.pricingOverrides == "" && cfg.PricingOverrides != "" {
+		scanFlags.pricingOverrides = cfg.PricingOverrides
+	}
+	if !scanFlags.commitmentAware && cfg.CommitmentAware {
+		scanFlags.commitmentAware = true
+	}
+	if len(scanFlags.spotEligibleTags) == 0 && len(cfg.SpotEligibleTags) > 0 {
+		scanFlags.spotEligibleTags = cfg.SpotEligibleTags
+	}
+	return applyEnv(flags)
 }
 
-func selectReporter(format, outputFile string) (report.Reporter, error) {
-	w := os.Stdout
-	if outputFile != "" {
-		f, err := os.Create(outputFile)
-		if err != nil {
-			return nil, fmt.Errorf("create output file: %w", err)
+// envPrefix starts the environment variable bound to each scan flag: --idle-days
+// is read from AWSSPECTRE_IDLE_DAYS.
+const envPrefix = "AWSSPECTRE_"
+
+// envVarName returns the environment variable bound to a flag.
+func envVarName(flag string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flag, "-", "_"))
+}
+
+// applyEnv sets every flag not given on the command line from its non-empty
+// environment variable, parsed like the flag value.
+func applyEnv(flags *pflag.FlagSet) error {
+	var err error
+	flags.VisitAll(func(f *pflag.Flag) {
+		if err != nil || f.Changed {
+			return
+		}
+		name := envVarName(f.Name)
+		value := os.Getenv(name)
+		if value == "" {
+			return
 		}
-		w = f
+		if setErr := f.Value.Set(value); setErr != nil {
+			err = fmt.Errorf("invalid %s: %w", name, setErr)
+		}
+	})
+	return err
+}
+
+// openOutput returns the --output file, or stdout when none is set.
+func openOutput(outputFile string) (io.Writer, error) {
+	if outputFile == "" {
+		return os.Stdout, nil
 	}
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return nil, fmt.Errorf("create output file: %w", err)
+	}
+	return f, nil
+}
 
+func selectReporter(format string, w io.Writer) (report.Reporter, error) {
 	switch format {
 	case "json":
 		return &report.JSONReporter{Writer: w}, nil
@@ -243,7 +758,19 @@ func selectReporter(format, outputFile string) (report.Reporter, error) {
 		return &report.SARIFReporter{Writer: w}, nil
 	case "spectrehub":
 		return &report.SpectreHubReporter{Writer: w}, nil
+	case "workitems":
+		return &report.WorkItemsReporter{Writer: w}, nil
+	case "csv":
+		return &report.CSVReporter{Writer: w}, nil
+	case "html":
+		return &report.HTMLReporter{Writer: w}, nil
+	case "markdown":
+		return &report.MarkdownReporter{Writer: w}, nil
+	case "prometheus":
+		return &report.PrometheusReporter{Writer: w}, nil
+	case "junit":
+		return &report.JUnitReporter{Writer: w}, nil
 	default:
-		return nil, fmt.Errorf("unsupported format: %s (use text, json, sarif, or spectrehub)", format)
+		return nil, fmt.Errorf("unsupported format: %s (use text, json, sarif, spectrehub, workitems, csv, html, markdown, prometheus, or junit)", format)
 	}
 }
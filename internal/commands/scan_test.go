@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ppiankov/awsspectre/internal/aws"
+	"github.com/ppiankov/awsspectre/internal/config"
+	"github.com/spf13/pflag"
+)
+
+func TestPruneRegions_RemovesExcluded(t *testing.T) {
+	discovered := []string{"eu-west-1", "us-east-1", "us-gov-east-1", "us-gov-west-1"}
+	got, err := pruneRegions(discovered, nil, []string{"us-gov-*", "eu-west-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got, []string{"us-east-1"}) {
+		t.Fatalf("expected [us-east-1], got %v", got)
+	}
+}
+
+func TestPruneRegions_ExplicitRegionsWin(t *testing.T) {
+	explicit := []string{"us-gov-west-1", "us-east-1"}
+	got, err := pruneRegions(explicit, explicit, []string{"us-gov-*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got, explicit) {
+		t.Fatalf("expected explicit regions %v to be kept, got %v", explicit, got)
+	}
+}
+
+func TestPruneRegions_Errors(t *testing.T) {
+	if _, err := pruneRegions([]string{"us-gov-west-1"}, nil, []string{"us-gov-*"}); err == nil {
+		t.Fatal("expected an error when every region is excluded")
+	}
+	if _, err := pruneRegions([]string{"us-east-1"}, nil, []string{"us-[east"}); err == nil {
+		t.Fatal("expected an error for an invalid pattern")
+	}
+}
+
+func TestParseResourceTypes(t *testing.T) {
+	include, err := parseResourceTypes([]string{"ec2", "rds", "cloudfront"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(include) != 3 || !include[aws.ResourceEC2] || !include[aws.ResourceRDS] || !include[aws.ResourceCloudFront] {
+		t.Fatalf("expected ec2, rds, and cloudfront, got %v", include)
+	}
+	if include, err := parseResourceTypes(nil); err != nil || include != nil {
+		t.Fatalf("expected no filter for an empty list, got %v (%v)", include, err)
+	}
+	if _, err := parseResourceTypes([]string{"ec3"}); err == nil {
+		t.Fatal("expected an error for an unknown resource type")
+	}
+}
+
+func TestParseTypeThresholds(t *testing.T) {
+	parsed, err := parseTypeThresholds(map[string]config.Thresholds{"ec2": {IdleCPU: 3}, "rds": {IdleCPU: 10, HighMemory: 80}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed[aws.ResourceEC2].IdleCPU != 3 || parsed[aws.ResourceRDS].HighMemory != 80 {
+		t.Fatalf("unexpected thresholds %+v", parsed)
+	}
+	if _, err := parseTypeThresholds(map[string]config.Thresholds{"ec3": {IdleCPU: 3}}); err == nil {
+		t.Fatal("expected an error for an unknown resource type")
+	}
+	if _, err := parseTypeThresholds(map[string]config.Thresholds{"rds": {IdleCPU: -1}}); err == nil {
+		t.Fatal("expected an error for a negative threshold")
+	}
+}
+
+func TestParseMinCostByType(t *testing.T) {
+	parsed, err := parseMinCostByType(map[string]float64{"eip": 0, "nlb": 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cost, ok := parsed[aws.ResourceEIP]; !ok || cost != 0 {
+		t.Fatalf("expected eip threshold 0, got %v", parsed)
+	}
+	if parsed[aws.ResourceNLB] != 10 {
+		t.Fatalf("expected nlb threshold 10, got %v", parsed)
+	}
+	if _, err := parseMinCostByType(map[string]float64{"ec3": 1}); err == nil {
+		t.Fatal("expected an error for an unknown resource type")
+	}
+	if _, err := parseMinCostByType(map[string]float64{"ec2": -1}); err == nil {
+		t.Fatal("expected an error for a negative threshold")
+	}
+}
+
+// withScanDefaults restores scanFlags, cfg, and the command-line state of the scan
+// flags after a test that changes them.
+func withScanDefaults(t *testing.T) {
+	t.Helper()
+	savedFlags, savedCfg := scanFlags, cfg
+	t.Cleanup(func() {
+		scanFlags, cfg = savedFlags, savedCfg
+		scanCmd.LocalFlags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+	})
+}
+
+func TestApplyConfigDefaults_EnvOverridesConfig(t *testing.T) {
+	withScanDefaults(t)
+	cfg = config.Config{IdleDays: 14, Format: "json", MinMonthlyCost: 5}
+	t.Setenv("AWSSPECTRE_IDLE_DAYS", "30")
+	t.Setenv("AWSSPECTRE_MIN_MONTHLY_COST", "2.5")
+	t.Setenv("AWSSPECTRE_EXCLUDE_TAGS", "team=data,awsspectre:ignore")
+
+	if err := applyConfigDefaults(scanCmd.LocalFlags()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanFlags.idleDays != 30 {
+		t.Fatalf("expected env idle days 30 over config 14, got %d", scanFlags.idleDays)
+	}
+	if scanFlags.minMonthlyCost != 2.5 {
+		t.Fatalf("expected env min monthly cost 2.5 over config 5, got %v", scanFlags.minMonthlyCost)
+	}
+	if scanFlags.format != "json" {
+		t.Fatalf("expected config format json without an env override, got %s", scanFlags.format)
+	}
+	if !slices.Equal(scanFlags.excludeTags, []string{"team=data", "awsspectre:ignore"}) {
+		t.Fatalf("expected env exclude tags, got %v", scanFlags.excludeTags)
+	}
+}
+
+func TestApplyConfigDefaults_FlagOverridesEnv(t *testing.T) {
+	withScanDefaults(t)
+	cfg = config.Config{IdleDays: 14}
+	t.Setenv("AWSSPECTRE_IDLE_DAYS", "30")
+	t.Setenv("AWSSPECTRE_FORMAT", "sarif")
+	if err := scanCmd.LocalFlags().Set("idle-days", "3"); err != nil {
+		t.Fatalf("set flag: %v", err)
+	}
+
+	if err := applyConfigDefaults(scanCmd.LocalFlags()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanFlags.idleDays != 3 {
+		t.Fatalf("expected flag idle days 3 over env and config, got %d", scanFlags.idleDays)
+	}
+	if scanFlags.format != "sarif" {
+		t.Fatalf("expected env format sarif, got %s", scanFlags.format)
+	}
+}
+
+func TestApplyConfigDefaults_InvalidEnv(t *testing.T) {
+	withScanDefaults(t)
+	t.Setenv("AWSSPECTRE_IDLE_DAYS", "a week")
+
+	err := applyConfigDefaults(scanCmd.LocalFlags())
+	if err == nil || !strings.Contains(err.Error(), "AWSSPECTRE_IDLE_DAYS") {
+		t.Fatalf("expected an error naming AWSSPECTRE_IDLE_DAYS, got %v", err)
+	}
+}
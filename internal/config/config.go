@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,25 +22,78 @@ type Config struct {
 	HighMemoryThreshold  float64  `yaml:"high_memory_threshold"`
 	StoppedThresholdDays int      `yaml:"stopped_threshold_days"`
 	NATGWLowTrafficGB    float64  `yaml:"nat_gw_low_traffic_gb"`
+	Pricing              string   `yaml:"pricing"`
+	PricingOverrides     string   `yaml:"pricing_overrides"`
+	Currency             string   `yaml:"currency"`
+	FXRate               float64  `yaml:"fx_rate"`
+	FailOver             float64  `yaml:"fail_over"`
+	FailOnSeverity       string   `yaml:"fail_on_severity"`
+	GroupByTag           string   `yaml:"group_by_tag"`
+	CommitmentAware      bool     `yaml:"commitment_aware"`
+	SpotEligibleTags     []string `yaml:"spot_eligible_tags"`
+	ResourceTypes        []string `yaml:"resource_types"`
 	Format               string   `yaml:"format"`
 	Timeout              string   `yaml:"timeout"`
 	Exclude              Exclude  `yaml:"exclude"`
+	// Thresholds overrides idle thresholds per resource type, keyed by type (e.g. "rds").
+	Thresholds map[string]Thresholds `yaml:"thresholds"`
+	// SeverityOverrides replaces the severity of findings by finding ID (e.g. "UNUSED_SECURITY_GROUP").
+	SeverityOverrides map[string]string `yaml:"severity_overrides"`
+	// MinMonthlyCostByType overrides min_monthly_cost per resource type (e.g. "eip").
+	MinMonthlyCostByType map[string]float64 `yaml:"min_monthly_cost_by_type"`
+}
+
+// severities lists the valid severity_overrides values.
+var severities = map[string]bool{"high": true, "medium": true, "low": true}
+
+// Thresholds holds idle thresholds for one resource type. Zero fields use the
+// global idle_cpu_threshold and high_memory_threshold.
+type Thresholds struct {
+	IdleCPU    float64 `yaml:"idle_cpu"`
+	HighMemory float64 `yaml:"high_memory"`
 }
 
 // Exclude defines resources to skip during scanning.
 type Exclude struct {
 	ResourceIDs []string `yaml:"resource_ids"`
-	Tags        []string `yaml:"tags"`
+	// ResourceIDPatterns are regular expressions matched against resource IDs.
+	ResourceIDPatterns []string `yaml:"resource_id_patterns"`
+	Tags               []string `yaml:"tags"`
+	// Regions are region names or glob patterns (e.g. "us-gov-*") to leave out.
+	Regions []string `yaml:"regions"`
 }
 
 // ParseTags converts tag strings ("Key=Value" or "Key") into a map.
 // Key-only entries have an empty string value, meaning "match any value".
 func (e Exclude) ParseTags() map[string]string {
-	if len(e.Tags) == 0 {
+	return ParseTagList(e.Tags)
+}
+
+// CompileResourceIDPatterns compiles ResourceIDPatterns. Patterns match anywhere
+// in an ID unless anchored with ^ and $.
+func (e Exclude) CompileResourceIDPatterns() ([]*regexp.Regexp, error) {
+	if len(e.ResourceIDPatterns) == 0 {
+		return nil, nil
+	}
+	patterns := make([]*regexp.Regexp, 0, len(e.ResourceIDPatterns))
+	for _, p := range e.ResourceIDPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude.resource_id_patterns entry %q: %w", p, err)
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
+
+// ParseTagList converts tag strings ("Key=Value" or "Key") into a map.
+// Key-only entries have an empty string value, meaning "match any value".
+func ParseTagList(tags []string) map[string]string {
+	if len(tags) == 0 {
 		return nil
 	}
-	m := make(map[string]string, len(e.Tags))
-	for _, s := range e.Tags {
+	m := make(map[string]string, len(tags))
+	for _, s := range tags {
 		if k, v, ok := strings.Cut(s, "="); ok {
 			m[k] = v
 		} else {
@@ -75,12 +129,33 @@ func Load(dir string) (Config, error) {
 			return Config{}, fmt.Errorf("read config %s: %w", path, err)
 		}
 
-		var cfg Config
-		if err := yaml.Unmarshal(data, &cfg); err != nil {
-			return Config{}, fmt.Errorf("parse config %s: %w", path, err)
-		}
-		return cfg, nil
+		return parse(path, data)
 	}
 
 	return Config{}, nil
 }
+
+// parse decodes and validates the config read from path.
+func parse(path string, data []byte) (Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// validate checks values that YAML decoding alone cannot reject.
+func (c Config) validate() error {
+	if _, err := c.Exclude.CompileResourceIDPatterns(); err != nil {
+		return err
+	}
+	for id, severity := range c.SeverityOverrides {
+		if !severities[severity] {
+			return fmt.Errorf("invalid severity_overrides entry %s: %q (use low, medium, or high)", id, severity)
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -36,6 +37,8 @@ exclude:
     - i-0abc123
   tags:
     - "Environment=production"
+  regions:
+    - "us-gov-*"
 `
 	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
 		t.Fatalf("write file: %v", err)
@@ -69,6 +72,9 @@ exclude:
 	if len(cfg.Exclude.Tags) != 1 {
 		t.Fatalf("expected 1 excluded tag, got %d", len(cfg.Exclude.Tags))
 	}
+	if len(cfg.Exclude.Regions) != 1 || cfg.Exclude.Regions[0] != "us-gov-*" {
+		t.Fatalf("expected excluded region us-gov-*, got %v", cfg.Exclude.Regions)
+	}
 }
 
 func TestLoad_YMLExtension(t *testing.T) {
@@ -102,6 +108,109 @@ func TestLoad_InvalidYAML(t *testing.T) {
 	}
 }
 
+func TestLoad_ResourceIDPatterns(t *testing.T) {
+	dir := t.TempDir()
+	content := `exclude:
+  resource_id_patterns:
+    - "^i-dev-"
+    - "^vol-0abc"
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	patterns, err := cfg.Exclude.CompileResourceIDPatterns()
+	if err != nil {
+		t.Fatalf("compile patterns: %v", err)
+	}
+	if len(patterns) != 2 || !patterns[0].MatchString("i-dev-123") {
+		t.Fatalf("expected 2 compiled patterns matching i-dev-123, got %v", patterns)
+	}
+}
+
+func TestLoad_InvalidResourceIDPattern(t *testing.T) {
+	dir := t.TempDir()
+	content := `exclude:
+  resource_id_patterns:
+    - "i-(dev"
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	_, err := Load(dir)
+	if err == nil {
+		t.Fatal("expected error for invalid resource_id_patterns entry")
+	}
+	if !strings.Contains(err.Error(), "i-(dev") {
+		t.Fatalf("expected error to name the pattern, got %v", err)
+	}
+}
+
+func TestLoad_MinMonthlyCostByType(t *testing.T) {
+	dir := t.TempDir()
+	content := `min_monthly_cost: 5
+min_monthly_cost_by_type:
+  eip: 0
+  ec2: 10
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cost, ok := cfg.MinMonthlyCostByType["eip"]; !ok || cost != 0 {
+		t.Fatalf("expected explicit eip threshold 0, got %v", cfg.MinMonthlyCostByType)
+	}
+	if cfg.MinMonthlyCostByType["ec2"] != 10 {
+		t.Fatalf("expected ec2 threshold 10, got %v", cfg.MinMonthlyCostByType)
+	}
+}
+
+func TestLoad_SeverityOverrides(t *testing.T) {
+	dir := t.TempDir()
+	content := `severity_overrides:
+  UNUSED_SECURITY_GROUP: high
+  IDLE_LAMBDA: medium
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.SeverityOverrides["UNUSED_SECURITY_GROUP"] != "high" || cfg.SeverityOverrides["IDLE_LAMBDA"] != "medium" {
+		t.Fatalf("unexpected severity_overrides: %v", cfg.SeverityOverrides)
+	}
+}
+
+func TestLoad_InvalidSeverityOverride(t *testing.T) {
+	dir := t.TempDir()
+	content := `severity_overrides:
+  UNUSED_SECURITY_GROUP: critical
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	_, err := Load(dir)
+	if err == nil {
+		t.Fatal("expected error for invalid severity override")
+	}
+	if !strings.Contains(err.Error(), "critical") {
+		t.Fatalf("expected error to name the severity, got %v", err)
+	}
+}
+
 func TestLoad_YAMLPriority(t *testing.T) {
 	dir := t.TempDir()
 	yamlContent := `profile: from-yaml`
@@ -222,3 +331,62 @@ func TestConfig_TimeoutDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_PerTypeThresholds(t *testing.T) {
+	dir := t.TempDir()
+	content := `idle_cpu_threshold: 5
+thresholds:
+  ec2:
+    idle_cpu: 3
+  rds:
+    idle_cpu: 10
+    high_memory: 80
+`
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.IdleCPUThreshold != 5 {
+		t.Fatalf("expected global idle_cpu_threshold 5, got %f", cfg.IdleCPUThreshold)
+	}
+	if len(cfg.Thresholds) != 2 {
+		t.Fatalf("expected thresholds for 2 resource types, got %v", cfg.Thresholds)
+	}
+	if ec2 := cfg.Thresholds["ec2"]; ec2.IdleCPU != 3 || ec2.HighMemory != 0 {
+		t.Fatalf("expected ec2 idle_cpu 3 and no high_memory, got %+v", ec2)
+	}
+	if rds := cfg.Thresholds["rds"]; rds.IdleCPU != 10 || rds.HighMemory != 80 {
+		t.Fatalf("expected rds idle_cpu 10 and high_memory 80, got %+v", rds)
+	}
+}
+
+func TestLoad_PricingField(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".awsspectre.yaml"), []byte("pricing: live\npricing_overrides: prices.json\ncurrency: EUR\nfx_rate: 0.9\ngroup_by_tag: team\nresource_types: [ec2, rds]\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Pricing != "live" {
+		t.Fatalf("expected pricing live, got %q", cfg.Pricing)
+	}
+	if cfg.PricingOverrides != "prices.json" {
+		t.Fatalf("expected pricing overrides prices.json, got %q", cfg.PricingOverrides)
+	}
+	if cfg.Currency != "EUR" || cfg.FXRate != 0.9 {
+		t.Fatalf("expected currency EUR at 0.9, got %q at %g", cfg.Currency, cfg.FXRate)
+	}
+	if cfg.GroupByTag != "team" {
+		t.Fatalf("expected group_by_tag team, got %q", cfg.GroupByTag)
+	}
+	if len(cfg.ResourceTypes) != 2 || cfg.ResourceTypes[0] != "ec2" || cfg.ResourceTypes[1] != "rds" {
+		t.Fatalf("expected resource_types [ec2 rds], got %v", cfg.ResourceTypes)
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FetchFunc retrieves the contents of a remote config file by URI.
+type FetchFunc func(ctx context.Context, uri string) ([]byte, error)
+
+// IsRemote reports whether location is an s3:// or https:// URI rather than a local path.
+func IsRemote(location string) bool {
+	return strings.HasPrefix(location, "s3://") || strings.HasPrefix(location, "https://")
+}
+
+// LoadFrom reads the config at location: an s3:// or https:// URI retrieved with
+// fetch, or otherwise a local file path. Unlike Load, a missing file is an error,
+// since the location was given explicitly.
+func LoadFrom(ctx context.Context, location string, fetch FetchFunc) (Config, error) {
+	var data []byte
+	var err error
+	if IsRemote(location) {
+		data, err = fetch(ctx, location)
+		if err != nil {
+			return Config{}, fmt.Errorf("fetch config %s: %w", location, err)
+		}
+	} else {
+		data, err = os.ReadFile(location)
+		if err != nil {
+			return Config{}, fmt.Errorf("read config %s: %w", location, err)
+		}
+	}
+	return parse(location, data)
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const remoteYAML = `profile: shared
+regions:
+  - us-east-1
+min_monthly_cost: 5
+exclude:
+  tags:
+    - "awsspectre:ignore"
+thresholds:
+  rds:
+    idle_cpu: 10
+`
+
+func TestLoadFrom_RemoteSchemes(t *testing.T) {
+	dir := t.TempDir()
+	localPath := filepath.Join(dir, "shared.yaml")
+	if err := os.WriteFile(localPath, []byte(remoteYAML), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	local, err := LoadFrom(context.Background(), localPath, nil)
+	if err != nil {
+		t.Fatalf("load local: %v", err)
+	}
+
+	for _, uri := range []string{"s3://team-configs/awsspectre.yaml", "https://config.example.com/awsspectre.yaml"} {
+		var fetched string
+		fetch := func(_ context.Context, u string) ([]byte, error) {
+			fetched = u
+			return []byte(remoteYAML), nil
+		}
+		remote, err := LoadFrom(context.Background(), uri, fetch)
+		if err != nil {
+			t.Fatalf("load %s: %v", uri, err)
+		}
+		if fetched != uri {
+			t.Fatalf("expected fetch of %s, got %q", uri, fetched)
+		}
+		if !reflect.DeepEqual(remote, local) {
+			t.Fatalf("expected %s to parse like the local file:\nremote %+v\nlocal  %+v", uri, remote, local)
+		}
+	}
+}
+
+func TestLoadFrom_FetchError(t *testing.T) {
+	fetch := func(context.Context, string) ([]byte, error) {
+		return nil, errors.New("403 Forbidden")
+	}
+	_, err := LoadFrom(context.Background(), "https://config.example.com/awsspectre.yaml", fetch)
+	if err == nil {
+		t.Fatal("expected fetch error")
+	}
+	if !strings.Contains(err.Error(), "fetch config https://config.example.com/awsspectre.yaml") || !strings.Contains(err.Error(), "403 Forbidden") {
+		t.Fatalf("expected error naming the URI and cause, got %v", err)
+	}
+}
+
+func TestLoadFrom_InvalidRemoteYAML(t *testing.T) {
+	fetch := func(context.Context, string) ([]byte, error) {
+		return []byte("severity_overrides:\n  IDLE_EC2: urgent\n"), nil
+	}
+	if _, err := LoadFrom(context.Background(), "s3://team-configs/awsspectre.yaml", fetch); err == nil {
+		t.Fatal("expected remote config to be validated like a local one")
+	}
+}
+
+func TestLoadFrom_MissingLocalFile(t *testing.T) {
+	if _, err := LoadFrom(context.Background(), filepath.Join(t.TempDir(), "missing.yaml"), nil); err == nil {
+		t.Fatal("expected error for a missing explicit config file")
+	}
+}
@@ -0,0 +1,108 @@
+package pricing
+
+import (
+	"sort"
+	"time"
+)
+
+// Price sources reported by Prices.
+const (
+	SourceEmbedded = "embedded"
+	SourceOverride = "override"
+	SourceLive     = "live"
+)
+
+// Price is one loaded price as a scan would resolve it.
+type Price struct {
+	Type   string  `json:"type"`
+	Region string  `json:"region"`
+	Price  float64 `json:"price"`
+	Source string  `json:"source"`
+}
+
+// ResourceTypes returns the sorted resource types known to the embedded data or overrides.
+func ResourceTypes() []string {
+	seen := make(map[string]bool, len(pricingDB))
+	for resourceType := range pricingDB {
+		seen[resourceType] = true
+	}
+	for resourceType := range overrideDB {
+		seen[resourceType] = true
+	}
+	return sortedKeys(seen)
+}
+
+// Prices returns the price of every specific type of resourceType known to the
+// embedded data or overrides, resolved for region the way a scan would, sorted by
+// type. Region is us-east-1 for prices that fell back to it. Live prices are only
+// reported when already cached; Prices never calls the Price List API.
+func Prices(resourceType, region string) []Price {
+	seen := make(map[string]bool)
+	for specificType := range pricingDB[resourceType] {
+		seen[specificType] = true
+	}
+	for specificType := range overrideDB[resourceType] {
+		seen[specificType] = true
+	}
+
+	prices := make([]Price, 0, len(seen))
+	for _, specificType := range sortedKeys(seen) {
+		if p, ok := loadedPrice(resourceType, specificType, region); ok {
+			prices = append(prices, p)
+		} else if region != "us-east-1" {
+			if p, ok := loadedPrice(resourceType, specificType, "us-east-1"); ok {
+				prices = append(prices, p)
+			}
+		}
+	}
+	return prices
+}
+
+// loadedPrice mirrors regionalPrice but reads live prices from the cache only.
+func loadedPrice(resourceType, specificType, region string) (Price, bool) {
+	p := Price{Type: specificType, Region: region}
+	if price, ok := overrideDB[resourceType][specificType][region]; ok {
+		p.Price, p.Source = price, SourceOverride
+		return p, true
+	}
+	if lp := installedLivePricer(); lp != nil {
+		if price, ok := lp.cachedHourly(resourceType, specificType, region); ok {
+			p.Price, p.Source = price, SourceLive
+			return p, true
+		}
+	}
+	if price, ok := pricingDB[resourceType][specificType][region]; ok {
+		p.Price, p.Source = price, SourceEmbedded
+		return p, true
+	}
+	return p, false
+}
+
+// LiveResourceTypes returns the sorted resource types live pricing can refresh.
+func LiveResourceTypes() []string {
+	seen := make(map[string]bool, len(liveProducts))
+	for resourceType := range liveProducts {
+		seen[resourceType] = true
+	}
+	return sortedKeys(seen)
+}
+
+// cachedHourly returns a fresh cached live price without calling the API.
+func (p *LivePricer) cachedHourly(resourceType, specificType, region string) (float64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.entries[resourceType+"/"+specificType+"/"+region]
+	if !ok || time.Since(entry.FetchedAt) >= liveCacheTTL {
+		return 0, false
+	}
+	return entry.Hourly, entry.Found
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
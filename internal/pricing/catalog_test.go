@@ -0,0 +1,83 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPrices_ResolvesSourcesAndFallback(t *testing.T) {
+	t.Cleanup(func() { overrideDB = nil })
+	overrideDB = map[string]map[string]map[string]float64{
+		"ec2": {"t3.medium": {"eu-west-1": 0.04}, "x9.custom": {"eu-west-1": 1.5}},
+	}
+
+	byType := make(map[string]Price)
+	for _, p := range Prices("ec2", "eu-west-1") {
+		byType[p.Type] = p
+	}
+	if p := byType["t3.medium"]; p.Source != SourceOverride || p.Price != 0.04 {
+		t.Fatalf("expected override for t3.medium, got %+v", p)
+	}
+	if p := byType["x9.custom"]; p.Source != SourceOverride {
+		t.Fatalf("expected override-only type to be listed, got %+v", p)
+	}
+	if p := byType["t3.large"]; p.Source != SourceEmbedded || p.Region != "eu-west-1" || p.Price != pricingDB["ec2"]["t3.large"]["eu-west-1"] {
+		t.Fatalf("expected embedded eu-west-1 t3.large, got %+v", p)
+	}
+
+	fallback := Prices("ec2", "ap-south-2")
+	if len(fallback) == 0 || fallback[0].Region != "us-east-1" {
+		t.Fatalf("expected us-east-1 fallback prices, got %+v", fallback)
+	}
+	if len(Prices("no-such-type", "us-east-1")) != 0 {
+		t.Fatal("expected no prices for an unknown resource type")
+	}
+}
+
+func TestPrices_ReadsLiveCacheWithoutFetching(t *testing.T) {
+	t.Cleanup(func() { UseLivePricer(nil) })
+	client := &mockPriceList{err: errors.New("unexpected call")}
+	p := NewLivePricer(client, "")
+	p.entries["ec2/t3.large/us-east-1"] = liveEntry{Hourly: 0.09, Found: true, FetchedAt: time.Now().UTC()}
+	UseLivePricer(p)
+
+	for _, price := range Prices("ec2", "us-east-1") {
+		switch {
+		case price.Type == "t3.large" && (price.Source != SourceLive || price.Price != 0.09):
+			t.Fatalf("expected cached live t3.large, got %+v", price)
+		case price.Type != "t3.large" && price.Source != SourceEmbedded:
+			t.Fatalf("expected embedded %s, got %+v", price.Type, price)
+		}
+	}
+	if client.calls != 0 {
+		t.Fatalf("expected no Price List calls, got %d", client.calls)
+	}
+}
+
+func TestLivePricer_RefreshIgnoresCache(t *testing.T) {
+	client := &mockPriceList{hourly: map[string]string{"t3.large/eu-west-1": "0.0950000000"}}
+	p := NewLivePricer(client, "")
+	p.entries["ec2/t3.large/eu-west-1"] = liveEntry{Hourly: 0.01, Found: true, FetchedAt: time.Now().UTC()}
+
+	found, err := p.Refresh("ec2", "eu-west-1")
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if found != 1 {
+		t.Fatalf("expected 1 price found, got %d", found)
+	}
+	if client.calls != len(pricingDB["ec2"]) {
+		t.Fatalf("expected one call per embedded type (%d), got %d", len(pricingDB["ec2"]), client.calls)
+	}
+	if hourly, ok := p.cachedHourly("ec2", "t3.large", "eu-west-1"); !ok || hourly != 0.095 {
+		t.Fatalf("expected refreshed $0.095, got $%.4f (found=%v)", hourly, ok)
+	}
+
+	if _, err := p.Refresh("ebs", "eu-west-1"); err == nil {
+		t.Fatal("expected an error for a resource type live pricing does not cover")
+	}
+	if _, err := NewLivePricer(&mockPriceList{err: errors.New("AccessDeniedException")}, "").Refresh("rds", "us-east-1"); err == nil {
+		t.Fatal("expected the API error to be returned")
+	}
+}
@@ -0,0 +1,65 @@
+package pricing
+
+import (
+	"sort"
+	"sync"
+)
+
+// TypeCoverage reports how many distinct specific types of one resource type were priced.
+type TypeCoverage struct {
+	ResourceType string   `json:"resource_type"`
+	Priced       int      `json:"priced"`
+	Total        int      `json:"total"`
+	Percent      float64  `json:"percent"`
+	Missing      []string `json:"missing,omitempty"`
+}
+
+// coverage records every (resourceType, specificType) lookup and whether it succeeded.
+var coverage = struct {
+	mu     sync.Mutex
+	lookup map[string]map[string]bool
+}{lookup: make(map[string]map[string]bool)}
+
+func recordLookup(resourceType, specificType string, found bool) {
+	coverage.mu.Lock()
+	defer coverage.mu.Unlock()
+	types, ok := coverage.lookup[resourceType]
+	if !ok {
+		types = make(map[string]bool)
+		coverage.lookup[resourceType] = types
+	}
+	types[specificType] = types[specificType] || found
+}
+
+// ResetCoverage clears recorded lookups. Call before a scan.
+func ResetCoverage() {
+	coverage.mu.Lock()
+	defer coverage.mu.Unlock()
+	coverage.lookup = make(map[string]map[string]bool)
+}
+
+// Coverage returns per-resource-type pricing coverage for all lookups since the last reset,
+// sorted by resource type. Missing types are the ones estimated at $0 for lack of data.
+func Coverage() []TypeCoverage {
+	coverage.mu.Lock()
+	defer coverage.mu.Unlock()
+
+	report := make([]TypeCoverage, 0, len(coverage.lookup))
+	for resourceType, types := range coverage.lookup {
+		c := TypeCoverage{ResourceType: resourceType, Total: len(types)}
+		for specificType, found := range types {
+			if found {
+				c.Priced++
+			} else {
+				c.Missing = append(c.Missing, specificType)
+			}
+		}
+		sort.Strings(c.Missing)
+		if c.Total > 0 {
+			c.Percent = float64(c.Priced) / float64(c.Total) * 100
+		}
+		report = append(report, c)
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].ResourceType < report[j].ResourceType })
+	return report
+}
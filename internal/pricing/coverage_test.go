@@ -0,0 +1,72 @@
+package pricing
+
+import "testing"
+
+func TestCoverage_ReportsUnknownTypes(t *testing.T) {
+	ResetCoverage()
+	t.Cleanup(ResetCoverage)
+
+	MonthlyEC2Cost("t3.large", "us-east-1")
+	MonthlyEC2Cost("m7i.2xlarge", "us-east-1")
+	MonthlyEC2Cost("c7g.large", "us-east-1")
+	MonthlyEC2Cost("c7g.large", "eu-west-1")
+	MonthlyEBSCost("gp3", 100, "us-east-1")
+
+	report := Coverage()
+	if len(report) != 2 {
+		t.Fatalf("expected coverage for ebs and ec2, got %+v", report)
+	}
+
+	ebs, ec2 := report[0], report[1]
+	if ebs.ResourceType != "ebs" || ebs.Percent != 100 || len(ebs.Missing) != 0 {
+		t.Fatalf("expected full EBS coverage, got %+v", ebs)
+	}
+	if ec2.ResourceType != "ec2" || ec2.Priced != 1 || ec2.Total != 3 {
+		t.Fatalf("expected 1 of 3 EC2 types priced, got %+v", ec2)
+	}
+	if len(ec2.Missing) != 2 || ec2.Missing[0] != "c7g.large" || ec2.Missing[1] != "m7i.2xlarge" {
+		t.Fatalf("expected missing [c7g.large m7i.2xlarge], got %v", ec2.Missing)
+	}
+}
+
+func TestResetCoverage(t *testing.T) {
+	MonthlyEC2Cost("x99.mega", "us-east-1")
+	ResetCoverage()
+	if report := Coverage(); len(report) != 0 {
+		t.Fatalf("expected empty coverage after reset, got %+v", report)
+	}
+}
+
+func TestCoverage_RecordsUnknownResourceType(t *testing.T) {
+	ResetCoverage()
+	t.Cleanup(ResetCoverage)
+
+	if _, ok := lookupHourly("quantum_ledger", "qldb.large", "us-east-1"); ok {
+		t.Fatal("expected lookup for unknown resource type to fail")
+	}
+	if _, ok := lookupMonthly("hyperplane", "us-east-1"); ok {
+		t.Fatal("expected flat-rate lookup for unknown resource type to fail")
+	}
+
+	report := Coverage()
+	if len(report) != 2 {
+		t.Fatalf("expected coverage for 2 unknown resource types, got %+v", report)
+	}
+	for _, c := range report {
+		if c.Priced != 0 || c.Total != 1 || len(c.Missing) != 1 {
+			t.Fatalf("expected one missing type for %s, got %+v", c.ResourceType, c)
+		}
+	}
+}
+
+func TestCoverage_RecordsMonthlyLookups(t *testing.T) {
+	ResetCoverage()
+	t.Cleanup(ResetCoverage)
+
+	MonthlyEIPCost("us-east-1")
+
+	report := Coverage()
+	if len(report) != 1 || report[0].Priced != 1 || report[0].Total != 1 {
+		t.Fatalf("expected one priced flat-rate lookup, got %+v", report)
+	}
+}
@@ -0,0 +1,48 @@
+package pricing
+
+import "strings"
+
+// FXRatesDate is the date of the embedded exchange rates. Rates are for display
+// only; waste is always computed in USD.
+const FXRatesDate = "2026-10-01"
+
+// fxRates holds units of each currency per US dollar.
+var fxRates = map[string]float64{
+	"USD": 1,
+	"EUR": 0.92,
+	"GBP": 0.79,
+	"CHF": 0.88,
+	"SEK": 10.60,
+	"NOK": 10.90,
+	"DKK": 6.87,
+	"PLN": 3.98,
+	"CAD": 1.37,
+	"AUD": 1.52,
+	"NZD": 1.66,
+	"JPY": 149.50,
+	"SGD": 1.35,
+	"INR": 83.90,
+	"BRL": 5.60,
+}
+
+// SetExchangeRate replaces the rate (units of currency per US dollar) used for
+// currency, for example with a rate fetched from a treasury system. Call before reporting.
+func SetExchangeRate(currency string, rate float64) {
+	fxRates[strings.ToUpper(currency)] = rate
+}
+
+// ExchangeRate returns the units of currency per US dollar, or false for unknown currencies.
+func ExchangeRate(currency string) (float64, bool) {
+	rate, ok := fxRates[strings.ToUpper(currency)]
+	return rate, ok
+}
+
+// Convert converts a USD amount to currency. Amounts are returned unchanged for USD,
+// an empty currency, and currencies without a rate.
+func Convert(usd float64, currency string) float64 {
+	rate, ok := ExchangeRate(currency)
+	if !ok {
+		return usd
+	}
+	return usd * rate
+}
@@ -0,0 +1,30 @@
+package pricing
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	t.Cleanup(func() { delete(fxRates, "XTS") })
+	tests := []struct {
+		name     string
+		currency string
+		want     float64
+	}{
+		{"USD unchanged", "USD", 100},
+		{"empty currency unchanged", "", 100},
+		{"embedded rate", "EUR", 92},
+		{"case insensitive", "gbp", 79},
+		{"unknown currency unchanged", "XXX", 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Convert(100, tt.currency); got < tt.want-0.001 || got > tt.want+0.001 {
+				t.Fatalf("Convert(100, %q) = %.4f, want %.4f", tt.currency, got, tt.want)
+			}
+		})
+	}
+
+	SetExchangeRate("xts", 2.5)
+	if got := Convert(10, "XTS"); got != 25 {
+		t.Fatalf("expected configured rate to apply, got %.2f", got)
+	}
+}
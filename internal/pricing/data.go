@@ -2,5 +2,9 @@ package pricing
 
 import _ "embed"
 
+// DataVersion is the date the embedded pricing data was last reviewed against AWS
+// list prices. Bump it with every change to pricing.json.
+const DataVersion = "2026-10-17"
+
 //go:embed pricing.json
 var pricingData []byte
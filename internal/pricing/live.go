@@ -0,0 +1,291 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	pricelist "github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricelisttypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+)
+
+// liveCacheTTL is how long a cached Price List answer is reused before refetching.
+const liveCacheTTL = 7 * 24 * time.Hour
+
+// liveLookupTimeout bounds a single GetProducts call.
+const liveLookupTimeout = 15 * time.Second
+
+// PriceListAPI is the minimal interface for the AWS Price List Query API.
+type PriceListAPI interface {
+	GetProducts(ctx context.Context, input *pricelist.GetProductsInput, opts ...func(*pricelist.Options)) (*pricelist.GetProductsOutput, error)
+}
+
+// liveProduct describes how to find the on-demand product for one embedded table.
+type liveProduct struct {
+	serviceCode string
+	filters     map[string]string
+}
+
+// liveProducts lists the embedded tables that live pricing can refresh. The filters
+// select the same products the embedded rows were taken from: Linux shared-tenancy
+// EC2, and single-AZ MySQL RDS.
+var liveProducts = map[string]liveProduct{
+	"ec2": {
+		serviceCode: "AmazonEC2",
+		filters: map[string]string{
+			"operatingSystem": "Linux",
+			"tenancy":         "Shared",
+			"preInstalledSw":  "NA",
+			"capacitystatus":  "Used",
+			"licenseModel":    "No License required",
+		},
+	},
+	"rds": {
+		serviceCode: "AmazonRDS",
+		filters: map[string]string{
+			"databaseEngine":   "MySQL",
+			"deploymentOption": "Single-AZ",
+		},
+	},
+}
+
+// liveEntry is one cached Price List answer. Misses are cached too so unknown
+// types are not requested again on every run.
+type liveEntry struct {
+	Hourly    float64   `json:"hourly"`
+	Found     bool      `json:"found"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// LivePricer looks up on-demand instance prices from the AWS Price List Query API
+// and caches them on disk. Once installed with UseLivePricer, every Monthly*Cost
+// function consults it before the embedded data, which remains the fallback.
+type LivePricer struct {
+	client    PriceListAPI
+	cachePath string
+
+	mu       sync.Mutex
+	entries  map[string]liveEntry
+	dirty    bool
+	disabled bool
+}
+
+// live is the installed live pricer, or nil to use embedded data only.
+var live struct {
+	mu     sync.RWMutex
+	pricer *LivePricer
+}
+
+// UseLivePricer installs p as the first price source. A nil p restores embedded-only pricing.
+func UseLivePricer(p *LivePricer) {
+	live.mu.Lock()
+	defer live.mu.Unlock()
+	live.pricer = p
+}
+
+func installedLivePricer() *LivePricer {
+	live.mu.RLock()
+	defer live.mu.RUnlock()
+	return live.pricer
+}
+
+// DefaultLiveCachePath returns the on-disk cache location under the user cache directory.
+func DefaultLiveCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "awsspectre", "price-list.json"), nil
+}
+
+// NewLivePricer creates a live pricer that caches answers at cachePath.
+// An empty cachePath disables the disk cache. A missing or unreadable cache file
+// starts an empty cache.
+func NewLivePricer(client PriceListAPI, cachePath string) *LivePricer {
+	p := &LivePricer{client: client, cachePath: cachePath, entries: make(map[string]liveEntry)}
+	if cachePath == "" {
+		return p
+	}
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			slog.Debug("Failed to read price list cache", "path", cachePath, "error", err)
+		}
+		return p
+	}
+	if err := json.Unmarshal(data, &p.entries); err != nil {
+		slog.Debug("Failed to parse price list cache", "path", cachePath, "error", err)
+		p.entries = make(map[string]liveEntry)
+	}
+	return p
+}
+
+// Save writes the cache to disk if any lookups changed it.
+func (p *LivePricer) Save() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cachePath == "" || !p.dirty {
+		return nil
+	}
+	data, err := json.MarshalIndent(p.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p.cachePath), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.cachePath, data, 0o644); err != nil {
+		return err
+	}
+	p.dirty = false
+	return nil
+}
+
+// hourlyPrice returns the live on-demand hourly price for one specific type in one
+// region. It returns false for tables live pricing does not cover, for products the
+// Price List does not have, and after the API has failed once during this run.
+func (p *LivePricer) hourlyPrice(resourceType, specificType, region string) (float64, bool) {
+	product, ok := liveProducts[resourceType]
+	if !ok {
+		return 0, false
+	}
+	key := resourceType + "/" + specificType + "/" + region
+
+	p.mu.Lock()
+	entry, cached := p.entries[key]
+	disabled := p.disabled
+	p.mu.Unlock()
+
+	if cached && time.Since(entry.FetchedAt) < liveCacheTTL {
+		return entry.Hourly, entry.Found
+	}
+	if disabled {
+		return 0, false
+	}
+
+	// The lock is not held across the API call so concurrent region scans are not
+	// serialized; a duplicate fetch of the same key is harmless.
+	hourly, found, err := p.fetch(product, specificType, region)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		if !p.disabled {
+			slog.Warn("Live pricing unavailable, using embedded prices", "error", err)
+			p.disabled = true
+		}
+		return 0, false
+	}
+	p.entries[key] = liveEntry{Hourly: hourly, Found: found, FetchedAt: time.Now().UTC()}
+	p.dirty = true
+	return hourly, found
+}
+
+// Refresh refetches the price of every embedded specific type of resourceType in
+// region, ignoring cached answers, and returns how many the Price List has. Call Save
+// to persist the result.
+func (p *LivePricer) Refresh(resourceType, region string) (int, error) {
+	product, ok := liveProducts[resourceType]
+	if !ok {
+		return 0, fmt.Errorf("live pricing does not cover %s", resourceType)
+	}
+	types := make(map[string]bool, len(pricingDB[resourceType]))
+	for specificType := range pricingDB[resourceType] {
+		types[specificType] = true
+	}
+
+	found := 0
+	for _, specificType := range sortedKeys(types) {
+		hourly, ok, err := p.fetch(product, specificType, region)
+		if err != nil {
+			return found, err
+		}
+		p.mu.Lock()
+		p.entries[resourceType+"/"+specificType+"/"+region] = liveEntry{Hourly: hourly, Found: ok, FetchedAt: time.Now().UTC()}
+		p.dirty = true
+		p.mu.Unlock()
+		if ok {
+			found++
+		}
+	}
+	return found, nil
+}
+
+func (p *LivePricer) fetch(product liveProduct, specificType, region string) (float64, bool, error) {
+	filters := []pricelisttypes.Filter{
+		termMatch("instanceType", specificType),
+		termMatch("regionCode", region),
+	}
+	for field, value := range product.filters {
+		filters = append(filters, termMatch(field, value))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), liveLookupTimeout)
+	defer cancel()
+
+	paginator := pricelist.NewGetProductsPaginator(p.client, &pricelist.GetProductsInput{
+		ServiceCode:   awssdk.String(product.serviceCode),
+		Filters:       filters,
+		FormatVersion: awssdk.String("aws_v1"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, false, fmt.Errorf("get %s products: %w", product.serviceCode, err)
+		}
+		for _, doc := range page.PriceList {
+			if hourly, ok := onDemandHourly(doc); ok {
+				return hourly, true, nil
+			}
+		}
+	}
+	return 0, false, nil
+}
+
+func termMatch(field, value string) pricelisttypes.Filter {
+	return pricelisttypes.Filter{
+		Type:  pricelisttypes.FilterTypeTermMatch,
+		Field: awssdk.String(field),
+		Value: awssdk.String(value),
+	}
+}
+
+// priceListProduct is the part of a Price List product document needed to read
+// its on-demand hourly rate.
+type priceListProduct struct {
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// onDemandHourly returns the non-zero USD hourly rate from a Price List product document.
+func onDemandHourly(doc string) (float64, bool) {
+	var product priceListProduct
+	if err := json.Unmarshal([]byte(doc), &product); err != nil {
+		return 0, false
+	}
+	for _, term := range product.Terms.OnDemand {
+		for _, dim := range term.PriceDimensions {
+			if dim.Unit != "Hrs" {
+				continue
+			}
+			usd, err := strconv.ParseFloat(dim.PricePerUnit["USD"], 64)
+			if err == nil && usd > 0 {
+				return usd, true
+			}
+		}
+	}
+	return 0, false
+}
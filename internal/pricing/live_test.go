@@ -0,0 +1,88 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	pricelist "github.com/aws/aws-sdk-go-v2/service/pricing"
+)
+
+// mockPriceList answers GetProducts from hourly prices keyed by "<instanceType>/<regionCode>".
+type mockPriceList struct {
+	hourly map[string]string
+	err    error
+	calls  int
+}
+
+func (m *mockPriceList) GetProducts(_ context.Context, input *pricelist.GetProductsInput, _ ...func(*pricelist.Options)) (*pricelist.GetProductsOutput, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	var instanceType, region string
+	for _, f := range input.Filters {
+		switch *f.Field {
+		case "instanceType":
+			instanceType = *f.Value
+		case "regionCode":
+			region = *f.Value
+		}
+	}
+	price, ok := m.hourly[instanceType+"/"+region]
+	if !ok {
+		return &pricelist.GetProductsOutput{}, nil
+	}
+	doc := `{"terms":{"OnDemand":{"SKU.TERM":{"priceDimensions":{"SKU.TERM.DIM":{"unit":"Hrs","pricePerUnit":{"USD":"` + price + `"}}}}}}}`
+	return &pricelist.GetProductsOutput{PriceList: []string{doc}}, nil
+}
+
+func TestLivePricer_PricesAndCaches(t *testing.T) {
+	t.Cleanup(func() { UseLivePricer(nil) })
+	cachePath := filepath.Join(t.TempDir(), "price-list.json")
+	client := &mockPriceList{hourly: map[string]string{"m7i.2xlarge/us-east-1": "0.4032000000"}}
+
+	UseLivePricer(NewLivePricer(client, cachePath))
+	// $0.4032/hour * 730 = $294.34
+	if cost := MonthlyEC2Cost("m7i.2xlarge", "us-east-1"); cost < 294.33 || cost > 294.34 {
+		t.Fatalf("expected ~$294.34 from the Price List, got $%.2f", cost)
+	}
+	// A region the Price List has no product for falls back to the us-east-1 price.
+	if cost := MonthlyEC2Cost("m7i.2xlarge", "ap-south-2"); cost < 294.33 || cost > 294.34 {
+		t.Fatalf("expected us-east-1 fallback, got $%.2f", cost)
+	}
+	calls := client.calls
+	MonthlyEC2Cost("m7i.2xlarge", "us-east-1")
+	if client.calls != calls {
+		t.Fatalf("expected a cached answer, got %d new calls", client.calls-calls)
+	}
+
+	p := installedLivePricer()
+	if err := p.Save(); err != nil {
+		t.Fatalf("save cache: %v", err)
+	}
+	reloaded := NewLivePricer(&mockPriceList{err: errors.New("unexpected call")}, cachePath)
+	if hourly, ok := reloaded.hourlyPrice("ec2", "m7i.2xlarge", "us-east-1"); !ok || hourly != 0.4032 {
+		t.Fatalf("expected cached $0.4032 after reload, got $%.4f (found=%v)", hourly, ok)
+	}
+}
+
+func TestLivePricer_FallsBackToEmbedded(t *testing.T) {
+	t.Cleanup(func() { UseLivePricer(nil) })
+	client := &mockPriceList{err: errors.New("AccessDeniedException")}
+
+	UseLivePricer(NewLivePricer(client, ""))
+	embedded := pricingDB["ec2"]["t3.large"]["us-east-1"] * hoursPerMonth
+	if cost := MonthlyEC2Cost("t3.large", "us-east-1"); cost != embedded {
+		t.Fatalf("expected embedded $%.2f, got $%.2f", embedded, cost)
+	}
+	MonthlyRDSCost("db.t3.micro", "us-east-1", false)
+	if client.calls != 1 {
+		t.Fatalf("expected live lookups to stop after the first failure, got %d calls", client.calls)
+	}
+	// Tables live pricing does not cover never reach the API.
+	if cost := MonthlyEIPCost("us-east-1"); cost == 0 {
+		t.Fatal("expected embedded EIP price")
+	}
+}
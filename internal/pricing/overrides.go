@@ -0,0 +1,37 @@
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// overrideDB holds user-supplied prices in the same shape as pricingDB. Entries
+// replace the matching embedded or live price; everything else is unchanged.
+var overrideDB map[string]map[string]map[string]float64
+
+// LoadOverrides reads a JSON file shaped like the embedded pricing data
+// (resource type → specific type → region → price) and layers it over the
+// embedded and live prices, for example to apply negotiated discount rates.
+// Prices use the same units as the embedded rows they replace. Call before scanning.
+func LoadOverrides(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read pricing overrides: %w", err)
+	}
+	var overrides map[string]map[string]map[string]float64
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return fmt.Errorf("parse pricing overrides %s: %w", path, err)
+	}
+	for resourceType, types := range overrides {
+		for specificType, regions := range types {
+			for region, price := range regions {
+				if price < 0 {
+					return fmt.Errorf("pricing overrides %s: negative price for %s/%s/%s", path, resourceType, specificType, region)
+				}
+			}
+		}
+	}
+	overrideDB = overrides
+	return nil
+}
@@ -0,0 +1,63 @@
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeOverrides(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "overrides.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write overrides: %v", err)
+	}
+	return path
+}
+
+func TestLoadOverrides_ReplacesOnlyMatchingKeys(t *testing.T) {
+	t.Cleanup(func() { overrideDB = nil })
+	embeddedLarge := MonthlyEC2Cost("t3.large", "us-east-1")
+	embeddedEU := MonthlyEC2Cost("t3.medium", "eu-west-1")
+
+	path := writeOverrides(t, `{"ec2": {"t3.medium": {"us-east-1": 0.03}}, "eip": {"default": {"us-east-1": 3.0}}}`)
+	if err := LoadOverrides(path); err != nil {
+		t.Fatalf("load overrides: %v", err)
+	}
+
+	// $0.03/hour * 730 = $21.90
+	if cost := MonthlyEC2Cost("t3.medium", "us-east-1"); cost < 21.89 || cost > 21.91 {
+		t.Fatalf("expected overridden ~$21.90, got $%.2f", cost)
+	}
+	if cost := MonthlyEC2Cost("t3.large", "us-east-1"); cost != embeddedLarge {
+		t.Fatalf("expected embedded t3.large $%.2f, got $%.2f", embeddedLarge, cost)
+	}
+	if cost := MonthlyEC2Cost("t3.medium", "eu-west-1"); cost != embeddedEU {
+		t.Fatalf("expected embedded eu-west-1 t3.medium $%.2f, got $%.2f", embeddedEU, cost)
+	}
+	if cost := MonthlyEIPCost("us-east-1"); cost != 3.0 {
+		t.Fatalf("expected overridden EIP $3.00, got $%.2f", cost)
+	}
+}
+
+func TestLoadOverrides_Errors(t *testing.T) {
+	t.Cleanup(func() { overrideDB = nil })
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing file", filepath.Join(t.TempDir(), "missing.json")},
+		{"invalid JSON", writeOverrides(t, `{"ec2": [`)},
+		{"negative price", writeOverrides(t, `{"ec2": {"t3.medium": {"us-east-1": -1}}}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := LoadOverrides(tt.path); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+	if overrideDB != nil {
+		t.Fatalf("expected failed loads to leave overrides unset, got %v", overrideDB)
+	}
+}
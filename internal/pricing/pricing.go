@@ -3,6 +3,8 @@ package pricing
 import (
 	"encoding/json"
 	"log/slog"
+	"strconv"
+	"strings"
 )
 
 const hoursPerMonth = 730
@@ -20,44 +22,52 @@ func init() {
 // lookupHourly returns the hourly on-demand price for a resource type, instance type, and region.
 // Returns 0 and false if not found.
 func lookupHourly(resourceType, instanceType, region string) (float64, bool) {
-	types, ok := pricingDB[resourceType]
-	if !ok {
-		return 0, false
-	}
-	regions, ok := types[instanceType]
-	if !ok {
-		return 0, false
-	}
-	price, ok := regions[region]
-	if !ok {
-		// Fall back to us-east-1 as default
-		price, ok = regions["us-east-1"]
-		if !ok {
-			return 0, false
-		}
-	}
-	return price, true
+	price, ok := lookupPrice(resourceType, instanceType, region)
+	recordLookup(resourceType, instanceType, ok)
+	return price, ok
 }
 
 // lookupMonthly returns the monthly flat rate for a resource type and region.
 // Used for EIP, NAT Gateway, ALB, NLB which have monthly fixed costs.
 func lookupMonthly(resourceType, region string) (float64, bool) {
-	types, ok := pricingDB[resourceType]
-	if !ok {
-		return 0, false
+	price, ok := lookupPrice(resourceType, "default", region)
+	recordLookup(resourceType, "default", ok)
+	return price, ok
+}
+
+// monthlyFromHourly returns the monthly equivalent of a flat per-unit rate billed
+// by the hour. Such rates live under the "hourly" key so that "default" rows hold
+// monthly rates only.
+func monthlyFromHourly(resourceType, region string) (float64, bool) {
+	hourly, ok := lookupHourly(resourceType, "hourly", region)
+	return hourly * hoursPerMonth, ok
+}
+
+// lookupPrice reads one price, falling back to us-east-1 when the region has no
+// entry. An installed live pricer is consulted before the embedded database.
+func lookupPrice(resourceType, specificType, region string) (float64, bool) {
+	if price, ok := regionalPrice(resourceType, specificType, region); ok {
+		return price, true
 	}
-	regions, ok := types["default"]
-	if !ok {
+	if region == "us-east-1" {
 		return 0, false
 	}
-	price, ok := regions[region]
-	if !ok {
-		price, ok = regions["us-east-1"]
-		if !ok {
-			return 0, false
+	return regionalPrice(resourceType, specificType, "us-east-1")
+}
+
+// regionalPrice reads one price for exactly the given region. User overrides win over
+// live prices, which win over the embedded database.
+func regionalPrice(resourceType, specificType, region string) (float64, bool) {
+	if price, ok := overrideDB[resourceType][specificType][region]; ok {
+		return price, true
+	}
+	if p := installedLivePricer(); p != nil {
+		if price, ok := p.hourlyPrice(resourceType, specificType, region); ok {
+			return price, true
 		}
 	}
-	return price, true
+	price, ok := pricingDB[resourceType][specificType][region]
+	return price, ok
 }
 
 // MonthlyEC2Cost returns the estimated monthly cost for an EC2 instance type in a region.
@@ -70,6 +80,56 @@ func MonthlyEC2Cost(instanceType, region string) float64 {
 	return hourly * hoursPerMonth
 }
 
+// ec2SizeLadder lists the sizes of each instance family from smallest to largest.
+// Adjacent sizes differ by a factor of two in vCPUs and memory up to 8xlarge.
+var ec2SizeLadder = map[string][]string{
+	"t3": {"nano", "micro", "small", "medium", "large", "xlarge", "2xlarge"},
+	"m5": {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"r5": {"large", "xlarge", "2xlarge", "4xlarge", "8xlarge", "12xlarge", "16xlarge", "24xlarge"},
+	"c5": {"large", "xlarge", "2xlarge", "4xlarge", "9xlarge", "12xlarge", "18xlarge", "24xlarge"},
+}
+
+// EC2NextSmallerType returns the instance type one size down in the same family,
+// e.g. "m5.2xlarge" → "m5.xlarge". Returns ("", false) for the smallest size and
+// for families without a size ladder.
+func EC2NextSmallerType(instanceType string) (string, bool) {
+	family, size, ok := strings.Cut(instanceType, ".")
+	if !ok {
+		return "", false
+	}
+	ladder := ec2SizeLadder[family]
+	for i, s := range ladder {
+		if s == size && i > 0 {
+			return family + "." + ladder[i-1], true
+		}
+	}
+	return "", false
+}
+
+// ec2CurrentGeneration maps previous-generation instance families to the
+// current-generation family with the same sizes and vCPU/memory ratio.
+var ec2CurrentGeneration = map[string]string{
+	"t2": "t3",
+	"m4": "m5",
+	"c4": "c5",
+	"r4": "r5",
+}
+
+// EC2CurrentGenerationType returns the current-generation equivalent of a
+// previous-generation instance type, e.g. "m4.xlarge" → "m5.xlarge".
+// Returns ("", false) for types that are already current generation.
+func EC2CurrentGenerationType(instanceType string) (string, bool) {
+	family, size, ok := strings.Cut(instanceType, ".")
+	if !ok {
+		return "", false
+	}
+	current, ok := ec2CurrentGeneration[family]
+	if !ok {
+		return "", false
+	}
+	return current + "." + size, true
+}
+
 // MonthlyEBSCost returns the estimated monthly cost for an EBS volume.
 // Price is per GiB per month.
 func MonthlyEBSCost(volumeType string, sizeGiB int, region string) float64 {
@@ -80,6 +140,25 @@ func MonthlyEBSCost(volumeType string, sizeGiB int, region string) float64 {
 	return perGiB * float64(sizeGiB)
 }
 
+// gp3IncludedIOPS is the IOPS baseline included in the gp3 storage price.
+const gp3IncludedIOPS = 3000
+
+// MonthlyEBSIOPSCost returns the monthly cost of a volume's provisioned IOPS.
+// gp3 bills only IOPS above its included baseline; other types return 0.
+func MonthlyEBSIOPSCost(volumeType string, iops int, region string) float64 {
+	perIOPS, ok := lookupHourly("ebs_iops", volumeType, region)
+	if !ok {
+		return 0
+	}
+	if volumeType == "gp3" {
+		iops -= gp3IncludedIOPS
+	}
+	if iops <= 0 {
+		return 0
+	}
+	return perIOPS * float64(iops)
+}
+
 // MonthlyEIPCost returns the monthly cost of an unassociated Elastic IP.
 func MonthlyEIPCost(region string) float64 {
 	cost, _ := lookupMonthly("eip", region)
@@ -98,6 +177,56 @@ func NATGatewayDataCostPerGB(region string) float64 {
 	return cost
 }
 
+// InternetEgressCostPerGB returns the per-GB charge for data transferred out to the
+// internet, at the first monthly volume tier.
+func InternetEgressCostPerGB(region string) float64 {
+	cost, _ := lookupMonthly("internet_egress", region)
+	return cost
+}
+
+// NATGatewayCost breaks down the estimated monthly cost of a NAT Gateway.
+type NATGatewayCost struct {
+	Gateway    float64 // hourly gateway charge
+	Processing float64 // data processing, billed on traffic in both directions
+	Egress     float64 // data transfer out to the internet
+}
+
+// Total returns the sum of all cost components.
+func (c NATGatewayCost) Total() float64 {
+	return c.Gateway + c.Processing + c.Egress
+}
+
+// EstimateNATGatewayCost returns the monthly cost of a NAT Gateway that processes
+// processedGB and sends egressGB of it to the internet. Egress ignores the
+// account-wide free allowance, which is shared with every other resource.
+func EstimateNATGatewayCost(region string, processedGB, egressGB float64) NATGatewayCost {
+	return NATGatewayCost{
+		Gateway:    MonthlyNATGatewayCost(region),
+		Processing: processedGB * NATGatewayDataCostPerGB(region),
+		Egress:     egressGB * InternetEgressCostPerGB(region),
+	}
+}
+
+// LambdaGBSecondCost returns the per GB-second price of Lambda compute on x86.
+func LambdaGBSecondCost(region string) float64 {
+	cost, _ := lookupMonthly("lambda_gb_second", region)
+	return cost
+}
+
+// LambdaProvisionedConcurrencyCost returns the monthly cost of keeping one GB of
+// provisioned concurrency allocated, excluding the duration charge of invocations.
+func LambdaProvisionedConcurrencyCost(region string) float64 {
+	cost, _ := monthlyFromHourly("lambda_provisioned_concurrency", region)
+	return cost
+}
+
+// InterAZTransferCostPerGB returns the per-GB charge for data crossing availability
+// zones within a region, billed in each direction.
+func InterAZTransferCostPerGB(region string) float64 {
+	cost, _ := lookupMonthly("inter_az_transfer", region)
+	return cost
+}
+
 // MonthlyALBCost returns the base monthly cost of an ALB (excluding LCU charges).
 func MonthlyALBCost(region string) float64 {
 	cost, _ := lookupMonthly("alb", region)
@@ -110,6 +239,25 @@ func MonthlyNLBCost(region string) float64 {
 	return cost
 }
 
+// LCUCostPerHour returns the price of one load balancer capacity unit-hour for the
+// given load balancer type ("application", "network", or "gateway").
+func LCUCostPerHour(lbType, region string) float64 {
+	cost, _ := lookupHourly("lcu", lbType, region)
+	return cost
+}
+
+// MonthlyLCUCost returns the monthly LCU charge for a load balancer that consumes
+// the given average number of LCUs per hour.
+func MonthlyLCUCost(lbType string, avgLCUs float64, region string) float64 {
+	return avgLCUs * LCUCostPerHour(lbType, region) * hoursPerMonth
+}
+
+// MonthlyGWLBCost returns the base monthly cost of a Gateway Load Balancer (excluding LCU charges).
+func MonthlyGWLBCost(region string) float64 {
+	cost, _ := lookupMonthly("gwlb", region)
+	return cost
+}
+
 // MonthlyRDSCost returns the estimated monthly cost for an RDS instance.
 // If multiAZ is true, the cost is doubled.
 func MonthlyRDSCost(instanceClass, region string, multiAZ bool) float64 {
@@ -124,6 +272,20 @@ func MonthlyRDSCost(instanceClass, region string, multiAZ bool) float64 {
 	return cost
 }
 
+// MonthlyRDSStorageCost returns the monthly cost of RDS allocated storage of the given
+// type (gp2, gp3, io1, io2, standard). If multiAZ is true, the cost is doubled.
+func MonthlyRDSStorageCost(storageType string, sizeGiB int, region string, multiAZ bool) float64 {
+	perGiB, ok := lookupHourly("rds_storage", storageType, region)
+	if !ok {
+		return 0
+	}
+	cost := perGiB * float64(sizeGiB)
+	if multiAZ {
+		cost *= 2
+	}
+	return cost
+}
+
 // rdsMemoryGiB maps RDS instance classes to total memory in GiB.
 var rdsMemoryGiB = map[string]int64{
 	"db.t3.micro":   1,
@@ -150,14 +312,369 @@ func RDSInstanceMemoryBytes(instanceClass string) (int64, bool) {
 	return gib * bytesPerGiB, true
 }
 
+// RDSInstanceVCPUs returns the vCPU count of an RDS instance class, derived from its
+// size suffix (large and smaller = 2, xlarge = 4, Nxlarge = 4N).
+// Returns (0, false) for classes without a standard size, such as db.serverless.
+func RDSInstanceVCPUs(instanceClass string) (int, bool) {
+	i := strings.LastIndex(instanceClass, ".")
+	if i < 0 {
+		return 0, false
+	}
+	size := instanceClass[i+1:]
+	switch size {
+	case "nano", "micro", "small", "medium", "large":
+		return 2, true
+	case "xlarge":
+		return 4, true
+	}
+	if n, err := strconv.Atoi(strings.TrimSuffix(size, "xlarge")); err == nil && strings.HasSuffix(size, "xlarge") && n > 0 {
+		return 4 * n, true
+	}
+	return 0, false
+}
+
+// MonthlyRedshiftCost returns the estimated monthly on-demand cost for a provisioned
+// Redshift cluster of nodeCount nodes (compute only, excluding managed storage).
+func MonthlyRedshiftCost(nodeType string, nodeCount int, region string) float64 {
+	hourly, ok := lookupHourly("redshift", nodeType, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth * float64(nodeCount)
+}
+
+// MonthlyS3StandardCost returns the monthly cost of storing sizeGiB in S3 Standard.
+func MonthlyS3StandardCost(sizeGiB int, region string) float64 {
+	perGiB, ok := lookupMonthly("s3_standard", region)
+	if !ok {
+		return 0
+	}
+	return perGiB * float64(sizeGiB)
+}
+
+// MonthlyS3StandardIACost returns the monthly cost of storing sizeGiB in S3 Standard-IA,
+// excluding per-GB retrieval charges.
+func MonthlyS3StandardIACost(sizeGiB int, region string) float64 {
+	perGiB, ok := lookupMonthly("s3_standard_ia", region)
+	if !ok {
+		return 0
+	}
+	return perGiB * float64(sizeGiB)
+}
+
+// MonthlyEFSStandardCost returns the monthly cost of storing sizeGiB in EFS Standard.
+func MonthlyEFSStandardCost(sizeGiB int, region string) float64 {
+	perGiB, ok := lookupMonthly("efs_standard", region)
+	if !ok {
+		return 0
+	}
+	return perGiB * float64(sizeGiB)
+}
+
+// MonthlyEKSControlPlaneCost returns the flat monthly cost of an EKS cluster control plane
+// on standard Kubernetes version support.
+func MonthlyEKSControlPlaneCost(region string) float64 {
+	cost, _ := lookupMonthly("eks_control_plane", region)
+	return cost
+}
+
+// MonthlyFargateCost returns the monthly on-demand cost of one always-running Fargate
+// task (Linux/x86) with the given vCPU and memory.
+func MonthlyFargateCost(vcpu, memGiB float64, region string) float64 {
+	perVCPU, _ := monthlyFromHourly("fargate_vcpu", region)
+	perGiB, _ := monthlyFromHourly("fargate_memory_gib", region)
+	return vcpu*perVCPU + memGiB*perGiB
+}
+
+// MonthlyDocumentDBCost returns the estimated monthly on-demand cost of one DocumentDB
+// instance (compute only, excluding storage and I/O).
+func MonthlyDocumentDBCost(instanceClass, region string) float64 {
+	hourly, ok := lookupHourly("docdb", instanceClass, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth
+}
+
+// MonthlyNeptuneCost returns the estimated monthly on-demand cost of one Neptune instance
+// (compute only, excluding storage and I/O).
+func MonthlyNeptuneCost(instanceClass, region string) float64 {
+	hourly, ok := lookupHourly("neptune", instanceClass, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth
+}
+
+// MonthlyMSKCost returns the estimated monthly cost of a provisioned MSK cluster:
+// brokers x instance hours plus the EBS storage provisioned on each broker.
+func MonthlyMSKCost(instanceType string, brokers, storageGiBPerBroker int, region string) float64 {
+	hourly, _ := lookupHourly("msk", instanceType, region)
+	perGiB, _ := lookupMonthly("msk_storage", region)
+	return float64(brokers) * (hourly*hoursPerMonth + float64(storageGiBPerBroker)*perGiB)
+}
+
+// MonthlyMSKServerlessCost returns the estimated monthly fixed cost of an MSK Serverless
+// cluster: the cluster-hour charge plus the partition-hour charge.
+func MonthlyMSKServerlessCost(partitions int, region string) float64 {
+	perCluster, _ := monthlyFromHourly("msk_serverless_cluster", region)
+	perPartition, _ := monthlyFromHourly("msk_serverless_partition", region)
+	return perCluster + float64(partitions)*perPartition
+}
+
+// MonthlyApiGatewayCacheCost returns the monthly cost of a provisioned API Gateway stage
+// cache. cacheSize is the size in GB as reported by the API (e.g. "0.5", "6.1").
+func MonthlyApiGatewayCacheCost(cacheSize, region string) float64 {
+	hourly, ok := lookupHourly("apigateway_cache", cacheSize, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth
+}
+
+// MonthlyRoute53HealthCheckCost returns the monthly cost of a basic health check
+// against an AWS endpoint. Route53 is global, so the us-east-1 rate applies.
+func MonthlyRoute53HealthCheckCost() float64 {
+	cost, _ := lookupMonthly("route53_health_check", "us-east-1")
+	return cost
+}
+
+// MonthlyRoute53HostedZoneCost returns the monthly cost of a hosted zone (first 25 zones rate).
+func MonthlyRoute53HostedZoneCost() float64 {
+	cost, _ := lookupMonthly("route53_hosted_zone", "us-east-1")
+	return cost
+}
+
+// ECRStorageCostPerGB returns the monthly ECR storage price per GiB.
+func ECRStorageCostPerGB(region string) float64 {
+	perGiB, _ := lookupMonthly("ecr_storage", region)
+	return perGiB
+}
+
+// MonthlyGlueDPUCost returns the monthly cost of keeping the given number of Glue DPUs
+// allocated around the clock, as a dev endpoint does.
+func MonthlyGlueDPUCost(dpus float64, region string) float64 {
+	perDPU, _ := monthlyFromHourly("glue_dpu", region)
+	return dpus * perDPU
+}
+
+// MonthlySageMakerCost returns the estimated monthly on-demand cost of one SageMaker
+// notebook or real-time hosting instance.
+func MonthlySageMakerCost(instanceType, region string) float64 {
+	hourly, ok := lookupHourly("sagemaker", instanceType, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth
+}
+
+// MonthlyGlobalAcceleratorCost returns the fixed monthly fee of one standard accelerator,
+// excluding data transfer premiums.
+func MonthlyGlobalAcceleratorCost() float64 {
+	cost, _ := monthlyFromHourly("global_accelerator", "us-east-1")
+	return cost
+}
+
+// MonthlyVPNConnectionCost returns the monthly connection-hour cost of a Site-to-Site
+// VPN connection (excluding data transfer).
+func MonthlyVPNConnectionCost(region string) float64 {
+	cost, _ := lookupMonthly("vpn_connection", region)
+	return cost
+}
+
+// MonthlyWorkSpacesCost returns the monthly cost of a WorkSpace by compute type and
+// running mode. AlwaysOn is a flat monthly rate; AutoStop and Manual return only the
+// fixed monthly fee, since hourly usage is zero for an unused WorkSpace.
+func MonthlyWorkSpacesCost(computeType, runningMode, region string) float64 {
+	table := "workspaces_autostop"
+	if runningMode == "ALWAYS_ON" {
+		table = "workspaces_alwayson"
+	}
+	monthly, _ := lookupHourly(table, computeType, region)
+	return monthly
+}
+
+// MonthlyFSxCost returns the monthly cost of an FSx file system from its SSD storage
+// capacity and provisioned throughput. Lustre prices throughput into storage, so its
+// throughput term is zero.
+func MonthlyFSxCost(fsType string, storageGiB, throughputMBps int, region string) float64 {
+	perGiB, ok := lookupHourly("fsx_storage", fsType, region)
+	if !ok {
+		return 0
+	}
+	perMBps, _ := lookupHourly("fsx_throughput", fsType, region)
+	return perGiB*float64(storageGiB) + perMBps*float64(throughputMBps)
+}
+
+// MonthlySecretCost returns the monthly storage cost of one Secrets Manager secret,
+// excluding API calls. The rate is the same in every commercial region.
+func MonthlySecretCost() float64 {
+	cost, _ := lookupMonthly("secretsmanager_secret", "us-east-1")
+	return cost
+}
+
+// MonthlyKMSKeyCost returns the monthly cost of one customer-managed KMS key, excluding
+// requests and rotated key material. The rate is the same in every commercial region.
+func MonthlyKMSKeyCost() float64 {
+	cost, _ := lookupMonthly("kms_key", "us-east-1")
+	return cost
+}
+
+// MonthlyAppRunnerProvisionedCost returns the monthly cost of App Runner instances kept
+// provisioned but not processing requests. Idle instances bill memory only; vCPU is
+// charged only while an instance is actively handling requests.
+func MonthlyAppRunnerProvisionedCost(memGiB float64, instances int, region string) float64 {
+	perGiB, _ := monthlyFromHourly("apprunner_provisioned_memory_gib", region)
+	return memGiB * perGiB * float64(instances)
+}
+
+// MonthlyEMRCost returns the monthly on-demand cost of count EMR instances of the given
+// type: the EC2 instance price plus the per-instance EMR surcharge.
+func MonthlyEMRCost(instanceType string, count int, region string) float64 {
+	ec2Hourly, ok := lookupHourly("ec2", instanceType, region)
+	if !ok {
+		return 0
+	}
+	emrHourly, _ := lookupHourly("emr", instanceType, region)
+	return (ec2Hourly + emrHourly) * float64(count) * hoursPerMonth
+}
+
+// LogsStorageCostPerGB returns the monthly CloudWatch Logs archived storage price per GiB.
+func LogsStorageCostPerGB(region string) float64 {
+	perGiB, _ := lookupMonthly("logs_storage", region)
+	return perGiB
+}
+
+// TimestreamMemoryStoreCostPerGB returns the monthly Timestream memory store price per GiB.
+// The memory store bills per GiB-hour, roughly 900 times the magnetic store rate.
+func TimestreamMemoryStoreCostPerGB(region string) float64 {
+	perGiB, _ := monthlyFromHourly("timestream_memory_store", region)
+	return perGiB
+}
+
+// TimestreamMagneticStoreCostPerGB returns the monthly Timestream magnetic store price per GiB.
+func TimestreamMagneticStoreCostPerGB(region string) float64 {
+	perGiB, _ := lookupMonthly("timestream_magnetic_store", region)
+	return perGiB
+}
+
+// MonthlyOCUCost returns the monthly cost of one OpenSearch Serverless compute unit
+// (indexing or search) running for the whole month.
+func MonthlyOCUCost(region string) float64 {
+	cost, _ := monthlyFromHourly("aoss_ocu", region)
+	return cost
+}
+
+// MonthlyRDSSnapshotCost returns the monthly backup storage cost of a manual RDS or
+// Aurora snapshot, using the source's allocated storage as an upper bound on its size.
+func MonthlyRDSSnapshotCost(allocatedGiB int, region string) float64 {
+	perGiB, _ := lookupMonthly("rds_snapshot", region)
+	return perGiB * float64(allocatedGiB)
+}
+
+// MonthlyPublicIPv4Cost returns the monthly charge for one in-use public IPv4 address.
+// The rate is the same in every commercial region and applies whether or not the
+// address is an Elastic IP.
+func MonthlyPublicIPv4Cost() float64 {
+	cost, _ := lookupMonthly("public_ipv4", "us-east-1")
+	return cost
+}
+
+// MonthlyDMSCost returns the monthly on-demand cost of a DMS replication instance.
+// Multi-AZ instances run a standby and cost twice as much.
+func MonthlyDMSCost(instanceClass, region string, multiAZ bool) float64 {
+	hourly, ok := lookupHourly("dms", instanceClass, region)
+	if !ok {
+		return 0
+	}
+	cost := hourly * hoursPerMonth
+	if multiAZ {
+		cost *= 2
+	}
+	return cost
+}
+
+// MonthlyMQBrokerCost returns the monthly instance cost of an Amazon MQ broker with the
+// given number of broker nodes (2 for active/standby, 3 for a RabbitMQ cluster),
+// excluding storage.
+func MonthlyMQBrokerCost(instanceType string, nodes int, region string) float64 {
+	hourly, ok := lookupHourly("mq", instanceType, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth * float64(nodes)
+}
+
+// MonthlyMemoryDBCost returns the monthly cost of a MemoryDB cluster with the given
+// total node count (shards × (1 + replicas per shard)), excluding data written.
+func MonthlyMemoryDBCost(nodeType string, nodes int, region string) float64 {
+	hourly, ok := lookupHourly("memorydb", nodeType, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth * float64(nodes)
+}
+
+// MonthlyRDSProxyCost returns the monthly cost of an RDS Proxy whose target instances
+// have the given total vCPU count. AWS bills a minimum of 2 vCPUs.
+func MonthlyRDSProxyCost(vcpus int, region string) float64 {
+	perVCPU, ok := monthlyFromHourly("rds_proxy", region)
+	if !ok {
+		return 0
+	}
+	return perVCPU * float64(max(vcpus, 2))
+}
+
+// MonthlyAlarmCost returns the monthly cost of one standard-resolution CloudWatch
+// metric alarm. The rate is the same in every commercial region.
+func MonthlyAlarmCost() float64 {
+	cost, _ := lookupMonthly("cloudwatch_alarm", "us-east-1")
+	return cost
+}
+
+// MonthlyDashboardCost returns the monthly cost of one CloudWatch dashboard beyond the
+// free tier. The rate is the same in every commercial region.
+func MonthlyDashboardCost() float64 {
+	cost, _ := lookupMonthly("cloudwatch_dashboard", "us-east-1")
+	return cost
+}
+
+// MonthlyAppSyncCacheCost returns the monthly cost of a provisioned AppSync API cache.
+// cacheType is the instance type as reported by the API (e.g. "SMALL", "R4_LARGE").
+func MonthlyAppSyncCacheCost(cacheType, region string) float64 {
+	hourly, ok := lookupHourly("appsync_cache", cacheType, region)
+	if !ok {
+		return 0
+	}
+	return hourly * hoursPerMonth
+}
+
 // MonthlyKinesisShardCost returns the estimated monthly cost for N Kinesis shards (provisioned mode).
 // $0.015/shard/hour in us-east-1 ≈ $10.95/shard/month.
 func MonthlyKinesisShardCost(shardCount int, region string) float64 {
-	hourly, ok := lookupMonthly("kinesis_shard", region)
+	perShard, ok := monthlyFromHourly("kinesis_shard", region)
 	if !ok {
 		return 0
 	}
-	return hourly * hoursPerMonth * float64(shardCount)
+	return perShard * float64(shardCount)
+}
+
+// MonthlyKinesisOnDemandBaseCost returns the monthly per-stream charge of an on-demand
+// Kinesis stream, excluding data ingested and retrieved.
+// $0.04/stream/hour in us-east-1 ≈ $29.20/stream/month.
+func MonthlyKinesisOnDemandBaseCost(region string) float64 {
+	cost, _ := monthlyFromHourly("kinesis_on_demand", region)
+	return cost
+}
+
+// MonthlyFirehoseVPCDeliveryCost returns the monthly charge for a Firehose stream
+// delivering into a VPC, billed per hour for each subnet's endpoint, excluding data.
+func MonthlyFirehoseVPCDeliveryCost(subnets int, region string) float64 {
+	perSubnet, ok := monthlyFromHourly("firehose_vpc_delivery", region)
+	if !ok {
+		return 0
+	}
+	return perSubnet * float64(subnets)
 }
 
 // MonthlySnapshotCost returns the estimated monthly cost for a snapshot.
@@ -169,3 +686,59 @@ func MonthlySnapshotCost(sizeGiB int, region string) float64 {
 	}
 	return perGiB * float64(sizeGiB)
 }
+
+// MonthlyArchivedSnapshotCost returns the monthly cost for a snapshot in the archive
+// tier, which stores the full snapshot rather than incremental blocks.
+func MonthlyArchivedSnapshotCost(sizeGiB int, region string) float64 {
+	perGiB, ok := lookupMonthly("snapshot_archive", region)
+	if !ok {
+		return 0
+	}
+	return perGiB * float64(sizeGiB)
+}
+
+// performanceInsightsFreeDays is the Performance Insights retention included at no charge.
+const performanceInsightsFreeDays = 7
+
+// RDSPerformanceInsightsCost returns the monthly cost of Performance Insights long-term
+// retention for one instance. The 7-day free tier costs nothing; longer retention is
+// charged per retained month.
+func RDSPerformanceInsightsCost(retentionDays int, region string) float64 {
+	if retentionDays <= performanceInsightsFreeDays {
+		return 0
+	}
+	perMonth, ok := lookupMonthly("rds_pi_retention", region)
+	if !ok {
+		return 0
+	}
+	months := float64(retentionDays) / 30.0
+	return perMonth * months
+}
+
+// MonthlyTGWAttachmentCost returns the monthly attachment-hour cost of a Transit Gateway
+// VPC, VPN, Direct Connect gateway, or Connect attachment (excluding data processing).
+func MonthlyTGWAttachmentCost(region string) float64 {
+	cost, _ := lookupMonthly("tgw_attachment", region)
+	return cost
+}
+
+// MonthlyTGWPeeringCost returns the monthly attachment-hour cost of a Transit Gateway
+// peering attachment (excluding data processing).
+func MonthlyTGWPeeringCost(region string) float64 {
+	cost, _ := lookupMonthly("tgw_peering", region)
+	return cost
+}
+
+// MonthlyDynamoDBProvisionedCost returns the monthly cost of provisioned DynamoDB
+// read and write capacity units (standard table class, excluding storage).
+func MonthlyDynamoDBProvisionedCost(rcu, wcu int64, region string) float64 {
+	if rcu < 0 {
+		rcu = 0
+	}
+	if wcu < 0 {
+		wcu = 0
+	}
+	perRCU, _ := monthlyFromHourly("dynamodb_rcu", region)
+	perWCU, _ := monthlyFromHourly("dynamodb_wcu", region)
+	return float64(rcu)*perRCU + float64(wcu)*perWCU
+}
@@ -51,6 +51,23 @@ func TestMonthlyEBSCost_UnknownType(t *testing.T) {
 	}
 }
 
+func TestMonthlyEBSIOPSCost(t *testing.T) {
+	// io1 in us-east-1 is $0.065/IOPS/month
+	if cost := MonthlyEBSIOPSCost("io1", 1000, "us-east-1"); cost < 64.99 || cost > 65.01 {
+		t.Fatalf("expected ~$65.00, got $%.2f", cost)
+	}
+	// gp3 bills only IOPS above the 3,000 baseline: 2,000 * $0.005 = $10.00
+	if cost := MonthlyEBSIOPSCost("gp3", 5000, "us-east-1"); cost < 9.99 || cost > 10.01 {
+		t.Fatalf("expected ~$10.00, got $%.2f", cost)
+	}
+	if cost := MonthlyEBSIOPSCost("gp3", 3000, "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 at the gp3 baseline, got $%.2f", cost)
+	}
+	if cost := MonthlyEBSIOPSCost("gp2", 3000, "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 for gp2, got $%.2f", cost)
+	}
+}
+
 func TestMonthlyEIPCost(t *testing.T) {
 	cost := MonthlyEIPCost("us-east-1")
 	if cost == 0 {
@@ -93,6 +110,14 @@ func TestMonthlyNLBCost(t *testing.T) {
 	}
 }
 
+func TestMonthlyTGWPeeringCost(t *testing.T) {
+	// $0.05/attachment-hour * 730 hrs = $36.50
+	cost := MonthlyTGWPeeringCost("us-east-1")
+	if cost != 36.5 {
+		t.Fatalf("expected $36.50, got $%.2f", cost)
+	}
+}
+
 func TestMonthlyRDSCost(t *testing.T) {
 	cost := MonthlyRDSCost("db.t3.medium", "us-east-1", false)
 	if cost == 0 {
@@ -108,6 +133,17 @@ func TestMonthlyRDSCost_MultiAZ(t *testing.T) {
 	}
 }
 
+func TestRDSPerformanceInsightsCost(t *testing.T) {
+	if cost := RDSPerformanceInsightsCost(7, "us-east-1"); cost != 0 {
+		t.Fatalf("expected free tier to cost $0, got $%.2f", cost)
+	}
+	// 731 days ≈ 24.4 retained months at $1.10/month ≈ $26.80
+	cost := RDSPerformanceInsightsCost(731, "us-east-1")
+	if cost < 26 || cost > 27.5 {
+		t.Fatalf("expected ~$26.80 for 731-day retention, got $%.2f", cost)
+	}
+}
+
 func TestMonthlySnapshotCost(t *testing.T) {
 	// 100 GiB at $0.05/GiB = $5.00
 	cost := MonthlySnapshotCost(100, "us-east-1")
@@ -116,6 +152,13 @@ func TestMonthlySnapshotCost(t *testing.T) {
 	}
 }
 
+func TestMonthlyArchivedSnapshotCost(t *testing.T) {
+	// 100 GiB at $0.0125/GiB = $1.25
+	if cost := MonthlyArchivedSnapshotCost(100, "us-east-1"); cost != 1.25 {
+		t.Fatalf("expected $1.25, got $%.2f", cost)
+	}
+}
+
 func TestRDSInstanceMemoryBytes_Known(t *testing.T) {
 	mem, ok := RDSInstanceMemoryBytes("db.r5.large")
 	if !ok {
@@ -146,3 +189,459 @@ func TestPricingDataLoaded(t *testing.T) {
 		t.Fatal("expected non-empty pricing DB")
 	}
 }
+
+func TestMonthlyDynamoDBProvisionedCost(t *testing.T) {
+	// 100 RCU * $0.00013 + 100 WCU * $0.00065 = $0.078/hr * 730 = ~$56.94
+	cost := MonthlyDynamoDBProvisionedCost(100, 100, "us-east-1")
+	if cost < 56 || cost > 58 {
+		t.Fatalf("expected ~$56.94, got $%.2f", cost)
+	}
+	if got := MonthlyDynamoDBProvisionedCost(0, 0, "us-east-1"); got != 0 {
+		t.Fatalf("expected $0 for zero capacity, got $%.2f", got)
+	}
+}
+
+func TestMonthlyRedshiftCost(t *testing.T) {
+	// ra3.4xlarge in us-east-1 is $3.26/hr * 2 nodes * 730 hrs = ~$4759.60
+	cost := MonthlyRedshiftCost("ra3.4xlarge", 2, "us-east-1")
+	if cost < 4759 || cost > 4760 {
+		t.Fatalf("expected ~$4759.60, got $%.2f", cost)
+	}
+	if got := MonthlyRedshiftCost("x99.mega", 2, "us-east-1"); got != 0 {
+		t.Fatalf("expected $0 for unknown node type, got $%.2f", got)
+	}
+}
+
+func TestMonthlyS3StandardCost(t *testing.T) {
+	// 1000 GiB * $0.023 = $23.00
+	cost := MonthlyS3StandardCost(1000, "us-east-1")
+	if cost < 22.99 || cost > 23.01 {
+		t.Fatalf("expected ~$23.00, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyS3StandardIACost(t *testing.T) {
+	// 1000 GiB * $0.0125 = $12.50
+	cost := MonthlyS3StandardIACost(1000, "us-east-1")
+	if cost < 12.49 || cost > 12.51 {
+		t.Fatalf("expected ~$12.50, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyEFSStandardCost(t *testing.T) {
+	// 100 GiB * $0.30 = $30.00
+	cost := MonthlyEFSStandardCost(100, "us-east-1")
+	if cost < 29.99 || cost > 30.01 {
+		t.Fatalf("expected ~$30.00, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyFargateCost(t *testing.T) {
+	// (0.25 vCPU * $0.04048 + 0.5 GiB * $0.004445) * 730 = ~$9.01
+	cost := MonthlyFargateCost(0.25, 0.5, "us-east-1")
+	if cost < 8.9 || cost > 9.1 {
+		t.Fatalf("expected ~$9.01, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyMSKCost(t *testing.T) {
+	// 3 brokers * ($0.21 * 730 + 100 GiB * $0.10) = $489.90
+	cost := MonthlyMSKCost("kafka.m5.large", 3, 100, "us-east-1")
+	if cost < 489.8 || cost > 490 {
+		t.Fatalf("expected ~$489.90, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyMSKServerlessCost(t *testing.T) {
+	// ($0.75 + 10 partitions * $0.0015) * 730 = $558.45
+	cost := MonthlyMSKServerlessCost(10, "us-east-1")
+	if cost < 558.4 || cost > 558.5 {
+		t.Fatalf("expected ~$558.45, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyApiGatewayCacheCost(t *testing.T) {
+	// 0.5 GB cache $0.02/hr * 730 = $14.60
+	cost := MonthlyApiGatewayCacheCost("0.5", "us-east-1")
+	if cost < 14.59 || cost > 14.61 {
+		t.Fatalf("expected ~$14.60, got $%.2f", cost)
+	}
+	if MonthlyApiGatewayCacheCost("999", "us-east-1") != 0 {
+		t.Fatal("expected 0 for unknown cache size")
+	}
+}
+
+func TestMonthlyRoute53Costs(t *testing.T) {
+	if cost := MonthlyRoute53HealthCheckCost(); cost != 0.50 {
+		t.Fatalf("expected $0.50 health check, got $%.2f", cost)
+	}
+	if cost := MonthlyRoute53HostedZoneCost(); cost != 0.50 {
+		t.Fatalf("expected $0.50 hosted zone, got $%.2f", cost)
+	}
+}
+
+func TestECRStorageCostPerGB(t *testing.T) {
+	if cost := ECRStorageCostPerGB("us-east-1"); cost != 0.10 {
+		t.Fatalf("expected $0.10/GiB, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyGlueDPUCost(t *testing.T) {
+	// 2 DPUs * $0.44 * 730 = $642.40
+	cost := MonthlyGlueDPUCost(2, "us-east-1")
+	if cost < 642.3 || cost > 642.5 {
+		t.Fatalf("expected ~$642.40, got $%.2f", cost)
+	}
+}
+
+func TestMonthlySageMakerCost(t *testing.T) {
+	// ml.m5.large $0.115/hr * 730 = $83.95
+	cost := MonthlySageMakerCost("ml.m5.large", "us-east-1")
+	if cost < 83.9 || cost > 84 {
+		t.Fatalf("expected ~$83.95, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyGlobalAcceleratorCost(t *testing.T) {
+	// $0.025/hr * 730 = $18.25
+	cost := MonthlyGlobalAcceleratorCost()
+	if cost < 18.24 || cost > 18.26 {
+		t.Fatalf("expected ~$18.25, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyTGWAttachmentCost(t *testing.T) {
+	if cost := MonthlyTGWAttachmentCost("us-east-1"); cost != 36.50 {
+		t.Fatalf("expected $36.50, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyVPNConnectionCost(t *testing.T) {
+	if cost := MonthlyVPNConnectionCost("us-east-1"); cost != 36.50 {
+		t.Fatalf("expected $36.50, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyWorkSpacesCost(t *testing.T) {
+	if cost := MonthlyWorkSpacesCost("STANDARD", "ALWAYS_ON", "us-east-1"); cost != 35.00 {
+		t.Fatalf("expected $35.00, got $%.2f", cost)
+	}
+	if cost := MonthlyWorkSpacesCost("STANDARD", "AUTO_STOP", "us-east-1"); cost != 9.75 {
+		t.Fatalf("expected $9.75, got $%.2f", cost)
+	}
+	if cost := MonthlyWorkSpacesCost("UNKNOWN", "ALWAYS_ON", "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 for unknown compute type, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyFSxCost(t *testing.T) {
+	// Windows: 1024 GiB * $0.13 + 32 MBps * $2.20 = $133.12 + $70.40
+	if cost := MonthlyFSxCost("WINDOWS", 1024, 32, "us-east-1"); cost < 203.51 || cost > 203.53 {
+		t.Fatalf("expected ~$203.52, got $%.2f", cost)
+	}
+	// Lustre throughput is bundled into storage.
+	if cost := MonthlyFSxCost("LUSTRE", 1200, 150, "us-east-1"); cost < 173.99 || cost > 174.01 {
+		t.Fatalf("expected ~$174.00, got $%.2f", cost)
+	}
+	if cost := MonthlyFSxCost("UNKNOWN", 1024, 32, "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 for unknown type, got $%.2f", cost)
+	}
+}
+
+func TestMonthlySecretCost(t *testing.T) {
+	if cost := MonthlySecretCost(); cost != 0.40 {
+		t.Fatalf("expected $0.40, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyKMSKeyCost(t *testing.T) {
+	if cost := MonthlyKMSKeyCost(); cost != 1.00 {
+		t.Fatalf("expected $1.00, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyAppRunnerProvisionedCost(t *testing.T) {
+	// 2 GiB * $0.007 * 1 instance * 730h = $10.22
+	if cost := MonthlyAppRunnerProvisionedCost(2, 1, "us-east-1"); cost < 10.21 || cost > 10.23 {
+		t.Fatalf("expected ~$10.22, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyEMRCost(t *testing.T) {
+	// m5.xlarge: ($0.192 EC2 + $0.048 EMR) * 3 * 730h = $525.60
+	if cost := MonthlyEMRCost("m5.xlarge", 3, "us-east-1"); cost < 525.59 || cost > 525.61 {
+		t.Fatalf("expected ~$525.60, got $%.2f", cost)
+	}
+	if cost := MonthlyEMRCost("unknown.type", 3, "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 for unknown type, got $%.2f", cost)
+	}
+}
+
+func TestLogsStorageCostPerGB(t *testing.T) {
+	if cost := LogsStorageCostPerGB("us-east-1"); cost != 0.03 {
+		t.Fatalf("expected $0.03, got $%.4f", cost)
+	}
+}
+
+func TestMonthlyRDSSnapshotCost(t *testing.T) {
+	// 100 GiB * $0.095 = $9.50
+	if cost := MonthlyRDSSnapshotCost(100, "us-east-1"); cost < 9.49 || cost > 9.51 {
+		t.Fatalf("expected ~$9.50, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyPublicIPv4Cost(t *testing.T) {
+	// $0.005/hour * 730 hours
+	if cost := MonthlyPublicIPv4Cost(); cost != 3.65 {
+		t.Fatalf("expected $3.65, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyDMSCost(t *testing.T) {
+	// dms.t3.medium: $0.073/hr * 730 = $53.29
+	single := MonthlyDMSCost("dms.t3.medium", "us-east-1", false)
+	if single < 53.28 || single > 53.30 {
+		t.Fatalf("expected ~$53.29, got $%.2f", single)
+	}
+	if multi := MonthlyDMSCost("dms.t3.medium", "us-east-1", true); multi != 2*single {
+		t.Fatalf("expected Multi-AZ to double cost, got $%.2f", multi)
+	}
+	if cost := MonthlyDMSCost("dms.unknown", "us-east-1", false); cost != 0 {
+		t.Fatalf("expected $0 for unknown class, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyMQBrokerCost(t *testing.T) {
+	// mq.m5.large: $0.288/hr * 730 = $210.24 per node
+	single := MonthlyMQBrokerCost("mq.m5.large", 1, "us-east-1")
+	if single < 210.23 || single > 210.25 {
+		t.Fatalf("expected ~$210.24, got $%.2f", single)
+	}
+	if standby := MonthlyMQBrokerCost("mq.m5.large", 2, "us-east-1"); standby != 2*single {
+		t.Fatalf("expected active/standby to double cost, got $%.2f", standby)
+	}
+}
+
+func TestMonthlyMemoryDBCost(t *testing.T) {
+	// 2 shards × (1 primary + 1 replica) of db.r6g.large: $0.309 * 730 * 4 = $902.28
+	cost := MonthlyMemoryDBCost("db.r6g.large", 4, "us-east-1")
+	if cost < 902.27 || cost > 902.29 {
+		t.Fatalf("expected ~$902.28, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyAppSyncCacheCost(t *testing.T) {
+	// SMALL cache: $0.044 * 730 = $32.12
+	cost := MonthlyAppSyncCacheCost("SMALL", "us-east-1")
+	if cost < 32.11 || cost > 32.13 {
+		t.Fatalf("expected ~$32.12, got $%.2f", cost)
+	}
+	if MonthlyAppSyncCacheCost("UNKNOWN", "us-east-1") != 0 {
+		t.Fatal("expected $0 for unknown cache type")
+	}
+}
+
+func TestTimestreamStoreCostPerGB(t *testing.T) {
+	// Memory store: $0.036/GiB-hour * 730 = $26.28/GiB-month
+	memory := TimestreamMemoryStoreCostPerGB("us-east-1")
+	if memory < 26.27 || memory > 26.29 {
+		t.Fatalf("expected ~$26.28, got $%.2f", memory)
+	}
+	if magnetic := TimestreamMagneticStoreCostPerGB("us-east-1"); magnetic != 0.03 {
+		t.Fatalf("expected $0.03, got $%.2f", magnetic)
+	}
+}
+
+func TestMonthlyOCUCost(t *testing.T) {
+	// $0.24/OCU-hour * 730 = $175.20
+	cost := MonthlyOCUCost("us-east-1")
+	if cost < 175.19 || cost > 175.21 {
+		t.Fatalf("expected ~$175.20, got $%.2f", cost)
+	}
+}
+
+func TestEC2NextSmallerType(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		want         string
+		ok           bool
+	}{
+		{"m5.2xlarge", "m5.xlarge", true},
+		{"m5.xlarge", "m5.large", true},
+		{"t3.micro", "t3.nano", true},
+		{"c5.12xlarge", "c5.9xlarge", true},
+		{"m5.large", "", false},
+		{"t3.nano", "", false},
+		{"x2idn.large", "", false},
+		{"invalid", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := EC2NextSmallerType(tt.instanceType)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("EC2NextSmallerType(%q) = %q, %v; want %q, %v", tt.instanceType, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestEC2CurrentGenerationType(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		want         string
+		ok           bool
+	}{
+		{"t2.micro", "t3.micro", true},
+		{"m4.xlarge", "m5.xlarge", true},
+		{"c4.2xlarge", "c5.2xlarge", true},
+		{"r4.large", "r5.large", true},
+		{"m5.large", "", false},
+		{"invalid", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := EC2CurrentGenerationType(tt.instanceType)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("EC2CurrentGenerationType(%q) = %q, %v; want %q, %v", tt.instanceType, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	// Every mapped type in the pricing data must be cheaper after the upgrade.
+	for _, old := range []string{"t2.micro", "t2.large", "m4.xlarge", "c4.large", "r4.2xlarge"} {
+		current, _ := EC2CurrentGenerationType(old)
+		if MonthlyEC2Cost(current, "us-east-1") >= MonthlyEC2Cost(old, "us-east-1") {
+			t.Errorf("expected %s to be cheaper than %s", current, old)
+		}
+	}
+}
+
+func TestMonthlyRDSStorageCost(t *testing.T) {
+	// gp3 in us-east-1 is $0.115/GiB/month
+	if cost := MonthlyRDSStorageCost("gp3", 100, "us-east-1", false); cost < 11.49 || cost > 11.51 {
+		t.Fatalf("expected ~$11.50, got $%.2f", cost)
+	}
+	if cost := MonthlyRDSStorageCost("gp3", 100, "us-east-1", true); cost < 22.99 || cost > 23.01 {
+		t.Fatalf("expected Multi-AZ to double to ~$23.00, got $%.2f", cost)
+	}
+	if cost := MonthlyRDSStorageCost("aurora", 100, "us-east-1", false); cost != 0 {
+		t.Fatalf("expected $0 for unpriced storage type, got $%.2f", cost)
+	}
+}
+
+func TestLambdaGBSecondCost(t *testing.T) {
+	cost := LambdaGBSecondCost("us-east-1")
+	if cost < 0.0000166 || cost > 0.0000167 {
+		t.Fatalf("expected ~$0.0000166667, got $%.10f", cost)
+	}
+}
+
+func TestLambdaProvisionedConcurrencyCost(t *testing.T) {
+	// $0.015 per GB-hour * 730 = $10.95
+	if cost := LambdaProvisionedConcurrencyCost("us-east-1"); cost < 10.94 || cost > 10.96 {
+		t.Fatalf("expected ~$10.95, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyKinesisOnDemandBaseCost(t *testing.T) {
+	// $0.04/stream/hour * 730 = $29.20
+	if cost := MonthlyKinesisOnDemandBaseCost("us-east-1"); cost < 29.19 || cost > 29.21 {
+		t.Fatalf("expected ~$29.20, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyFirehoseVPCDeliveryCost(t *testing.T) {
+	// 3 subnets at $0.01/hour * 730 = $21.90
+	if cost := MonthlyFirehoseVPCDeliveryCost(3, "us-east-1"); cost < 21.89 || cost > 21.91 {
+		t.Fatalf("expected ~$21.90, got $%.2f", cost)
+	}
+	if cost := MonthlyFirehoseVPCDeliveryCost(0, "us-east-1"); cost != 0 {
+		t.Fatalf("expected $0 without VPC delivery, got $%.2f", cost)
+	}
+}
+
+func TestInterAZTransferCostPerGB(t *testing.T) {
+	if cost := InterAZTransferCostPerGB("us-east-1"); cost != 0.01 {
+		t.Fatalf("expected $0.01, got $%.4f", cost)
+	}
+}
+
+func TestEstimateNATGatewayCost(t *testing.T) {
+	cost := EstimateNATGatewayCost("us-east-1", 100, 40)
+	if cost.Gateway != 32.85 {
+		t.Fatalf("expected gateway $32.85, got $%.2f", cost.Gateway)
+	}
+	// 100 GB * $0.045 = $4.50 processing; 40 GB * $0.09 = $3.60 egress
+	if cost.Processing < 4.499 || cost.Processing > 4.501 || cost.Egress < 3.599 || cost.Egress > 3.601 {
+		t.Fatalf("expected $4.50 processing and $3.60 egress, got $%.2f and $%.2f", cost.Processing, cost.Egress)
+	}
+	if total := cost.Total(); total < 40.949 || total > 40.951 {
+		t.Fatalf("expected total $40.95, got $%.2f", total)
+	}
+}
+
+func TestMonthlyGWLBCost(t *testing.T) {
+	if cost := MonthlyGWLBCost("us-east-1"); cost != 9.13 {
+		t.Fatalf("expected $9.13, got $%.2f", cost)
+	}
+}
+
+func TestLCUCostPerHour(t *testing.T) {
+	if cost := LCUCostPerHour("application", "us-east-1"); cost != 0.008 {
+		t.Fatalf("expected ALB LCU $0.008, got $%.4f", cost)
+	}
+	if cost := LCUCostPerHour("network", "us-east-1"); cost != 0.006 {
+		t.Fatalf("expected NLB LCU $0.006, got $%.4f", cost)
+	}
+	if cost := LCUCostPerHour("gateway", "us-east-1"); cost != 0.004 {
+		t.Fatalf("expected GWLB LCU $0.004, got $%.4f", cost)
+	}
+	if cost := LCUCostPerHour("classic", "us-east-1"); cost != 0 {
+		t.Fatalf("expected 0 for unknown type, got $%.4f", cost)
+	}
+	// 2.5 LCUs * $0.008/hour * 730 = $14.60
+	if cost := MonthlyLCUCost("application", 2.5, "us-east-1"); cost < 14.59 || cost > 14.61 {
+		t.Fatalf("expected ~$14.60, got $%.2f", cost)
+	}
+}
+
+func TestRDSInstanceVCPUs(t *testing.T) {
+	tests := []struct {
+		class string
+		want  int
+		ok    bool
+	}{
+		{"db.t3.micro", 2, true},
+		{"db.r5.large", 2, true},
+		{"db.m5.xlarge", 4, true},
+		{"db.r6g.4xlarge", 16, true},
+		{"db.serverless", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := RDSInstanceVCPUs(tt.class)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("RDSInstanceVCPUs(%q) = %d, %v; want %d, %v", tt.class, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestMonthlyRDSProxyCost(t *testing.T) {
+	// 8 vCPUs * $0.015 * 730 = $87.60
+	cost := MonthlyRDSProxyCost(8, "us-east-1")
+	if cost < 87.59 || cost > 87.61 {
+		t.Fatalf("expected ~$87.60, got $%.2f", cost)
+	}
+	// Minimum 2 vCPUs: $21.90
+	if minCost := MonthlyRDSProxyCost(0, "us-east-1"); minCost < 21.89 || minCost > 21.91 {
+		t.Fatalf("expected 2-vCPU minimum ~$21.90, got $%.2f", minCost)
+	}
+}
+
+func TestMonthlyAlarmCost(t *testing.T) {
+	if cost := MonthlyAlarmCost(); cost != 0.10 {
+		t.Fatalf("expected $0.10, got $%.2f", cost)
+	}
+}
+
+func TestMonthlyDashboardCost(t *testing.T) {
+	if cost := MonthlyDashboardCost(); cost != 3.00 {
+		t.Fatalf("expected $3.00, got $%.2f", cost)
+	}
+}
@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// spotHistoryMaxPages bounds the Spot price history read per instance type. Each page
+// holds up to 1000 price changes, far more than a week of history across a region's AZs.
+const spotHistoryMaxPages = 3
+
+// SpotPriceAPI is the minimal interface for EC2 Spot price history.
+type SpotPriceAPI interface {
+	DescribeSpotPriceHistory(ctx context.Context, input *ec2.DescribeSpotPriceHistoryInput, opts ...func(*ec2.Options)) (*ec2.DescribeSpotPriceHistoryOutput, error)
+}
+
+// SpotMonthlyEC2Cost returns the monthly cost of a Linux instance type at its average
+// Spot price over the last lookbackDays, across all availability zones of the
+// client's region. It returns false when the type has no Spot price history there.
+func SpotMonthlyEC2Cost(ctx context.Context, client SpotPriceAPI, instanceType string, lookbackDays int) (float64, bool, error) {
+	end := time.Now().UTC()
+	paginator := ec2.NewDescribeSpotPriceHistoryPaginator(client, &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       []ec2types.InstanceType{ec2types.InstanceType(instanceType)},
+		ProductDescriptions: []string{"Linux/UNIX"},
+		StartTime:           awssdk.Time(end.AddDate(0, 0, -lookbackDays)),
+		EndTime:             awssdk.Time(end),
+	})
+
+	var sum float64
+	var n int
+	for page := 0; page < spotHistoryMaxPages && paginator.HasMorePages(); page++ {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, p := range out.SpotPriceHistory {
+			price, err := strconv.ParseFloat(awssdk.ToString(p.SpotPrice), 64)
+			if err != nil {
+				continue
+			}
+			sum += price
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false, nil
+	}
+	return sum / float64(n) * hoursPerMonth, true, nil
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// DefaultCloudWatchNamespace is the namespace metrics are published to unless configured.
+const DefaultCloudWatchNamespace = "AwsSpectre"
+
+// cloudWatchMetricName is the name of every published waste datum.
+const cloudWatchMetricName = "EstimatedMonthlyWaste"
+
+// cloudWatchBatchSize is the most datums PutMetricData accepts in one call.
+const cloudWatchBatchSize = 1000
+
+// CloudWatchPutAPI is the minimal interface for publishing CloudWatch metrics.
+type CloudWatchPutAPI interface {
+	PutMetricData(ctx context.Context, input *cloudwatch.PutMetricDataInput, opts ...func(*cloudwatch.Options)) (*cloudwatch.PutMetricDataOutput, error)
+}
+
+// CloudWatchPublisher publishes total estimated monthly waste, and waste per resource
+// type under a ResourceType dimension, as CloudWatch custom metrics.
+type CloudWatchPublisher struct {
+	Client    CloudWatchPutAPI
+	Namespace string
+}
+
+// Publish sends the waste metrics for one scan, timestamped with the scan time.
+func (p *CloudWatchPublisher) Publish(ctx context.Context, data Data) error {
+	namespace := p.Namespace
+	if namespace == "" {
+		namespace = DefaultCloudWatchNamespace
+	}
+	datums := cloudWatchDatums(data)
+	for start := 0; start < len(datums); start += cloudWatchBatchSize {
+		end := min(start+cloudWatchBatchSize, len(datums))
+		_, err := p.Client.PutMetricData(ctx, &cloudwatch.PutMetricDataInput{
+			Namespace:  awssdk.String(namespace),
+			MetricData: datums[start:end],
+		})
+		if err != nil {
+			return fmt.Errorf("put CloudWatch metric data: %w", err)
+		}
+	}
+	return nil
+}
+
+// cloudWatchDatums returns the total waste followed by per-resource-type waste,
+// sorted by resource type.
+func cloudWatchDatums(data Data) []cwtypes.MetricDatum {
+	byType := make(map[string]float64)
+	for _, f := range data.Findings {
+		byType[string(f.ResourceType)] += f.EstimatedMonthlyWaste
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	timestamp := awssdk.Time(data.Timestamp)
+	datums := []cwtypes.MetricDatum{{
+		MetricName: awssdk.String(cloudWatchMetricName),
+		Timestamp:  timestamp,
+		Unit:       cwtypes.StandardUnitNone,
+		Value:      awssdk.Float64(data.Summary.TotalMonthlyWaste),
+	}}
+	for _, t := range types {
+		datums = append(datums, cwtypes.MetricDatum{
+			MetricName: awssdk.String(cloudWatchMetricName),
+			Dimensions: []cwtypes.Dimension{{Name: awssdk.String("ResourceType"), Value: awssdk.String(t)}},
+			Timestamp:  timestamp,
+			Unit:       cwtypes.StandardUnitNone,
+			Value:      awssdk.Float64(byType[t]),
+		})
+	}
+	return datums
+}
@@ -0,0 +1,85 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+)
+
+// csvMetadataColumns are metadata keys common across scanners that the CSV report
+// flattens into columns. A column is written only when some finding carries the key.
+var csvMetadataColumns = []string{
+	"instance_type",
+	"instance_class",
+	"volume_type",
+	"size_gib",
+	"engine",
+	"state",
+	"vpc_id",
+	"availability_zone",
+	"age_days",
+	"avg_cpu_percent",
+}
+
+// Generate writes one CSV row per finding.
+func (r *CSVReporter) Generate(data Data) error {
+	header := []string{"id", "severity", "resource_type", "resource_id", "resource_name", "region", "estimated_monthly_waste", "message"}
+	if data.Currency != "" {
+		header = append(header, "currency", "converted_monthly_waste")
+	}
+	var metaColumns []string
+	for _, key := range csvMetadataColumns {
+		for _, f := range data.Findings {
+			if _, ok := f.Metadata[key]; ok {
+				metaColumns = append(metaColumns, key)
+				break
+			}
+		}
+	}
+	header = append(header, metaColumns...)
+
+	w := csv.NewWriter(r.Writer)
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("write CSV header: %w", err)
+	}
+	for _, f := range data.Findings {
+		row := []string{
+			string(f.ID),
+			string(f.Severity),
+			string(f.ResourceType),
+			f.ResourceID,
+			f.ResourceName,
+			f.Region,
+			strconv.FormatFloat(f.EstimatedMonthlyWaste, 'f', 2, 64),
+			f.Message,
+		}
+		if data.Currency != "" {
+			row = append(row, data.Currency, strconv.FormatFloat(*data.convert(f.EstimatedMonthlyWaste), 'f', 2, 64))
+		}
+		for _, key := range metaColumns {
+			row = append(row, csvValue(f.Metadata[key]))
+		}
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("write CSV row: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("write CSV report: %w", err)
+	}
+	return nil
+}
+
+// csvValue renders a metadata value as a cell. Missing values are empty.
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
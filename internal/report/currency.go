@@ -0,0 +1,112 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// reportBody is Data as written by the JSON reporters. When a report currency is
+// set, every finding and the summary carry the converted waste next to the USD value;
+// otherwise the output is identical to Data.
+type reportBody struct {
+	Tool         string            `json:"tool"`
+	Version      string            `json:"version"`
+	Timestamp    time.Time         `json:"timestamp"`
+	Target       Target            `json:"target"`
+	Config       ReportConfig      `json:"config"`
+	Findings     []currencyFinding `json:"findings"`
+	Summary      currencySummary   `json:"summary"`
+	Errors       []string          `json:"errors,omitempty"`
+	Currency     string            `json:"currency,omitempty"`
+	ExchangeRate float64           `json:"exchange_rate,omitempty"`
+}
+
+type currencyFinding struct {
+	awstype.Finding
+	Currency                  string   `json:"currency,omitempty"`
+	ConvertedMonthlyWaste     *float64 `json:"converted_monthly_waste,omitempty"`
+	ConvertedMonthlyWasteLow  *float64 `json:"converted_monthly_waste_low,omitempty"`
+	ConvertedMonthlyWasteHigh *float64 `json:"converted_monthly_waste_high,omitempty"`
+}
+
+type currencySummary struct {
+	analyzer.Summary
+	Currency              string   `json:"currency,omitempty"`
+	ConvertedMonthlyWaste *float64 `json:"converted_total_monthly_waste,omitempty"`
+	ConvertedWasteLow     *float64 `json:"converted_total_waste_low,omitempty"`
+	ConvertedWasteHigh    *float64 `json:"converted_total_waste_high,omitempty"`
+}
+
+func newReportBody(data Data) reportBody {
+	body := reportBody{
+		Tool:         data.Tool,
+		Version:      data.Version,
+		Timestamp:    data.Timestamp,
+		Target:       data.Target,
+		Config:       data.Config,
+		Summary:      currencySummary{Summary: data.Summary},
+		Errors:       data.Errors,
+		Currency:     data.Currency,
+		ExchangeRate: data.ExchangeRate,
+	}
+	if data.Findings != nil {
+		body.Findings = make([]currencyFinding, 0, len(data.Findings))
+	}
+	converted := data.Currency != ""
+	for _, f := range data.Findings {
+		cf := currencyFinding{Finding: f}
+		if converted {
+			cf.Currency = data.Currency
+			cf.ConvertedMonthlyWaste = data.convert(f.EstimatedMonthlyWaste)
+			if f.EstimatedMonthlyWasteHigh > 0 {
+				cf.ConvertedMonthlyWasteLow = data.convert(f.EstimatedMonthlyWasteLow)
+				cf.ConvertedMonthlyWasteHigh = data.convert(f.EstimatedMonthlyWasteHigh)
+			}
+		}
+		body.Findings = append(body.Findings, cf)
+	}
+	if converted {
+		body.Summary.Currency = data.Currency
+		body.Summary.ConvertedMonthlyWaste = data.convert(data.Summary.TotalMonthlyWaste)
+		if data.Summary.TotalWasteHigh > 0 {
+			body.Summary.ConvertedWasteLow = data.convert(data.Summary.TotalWasteLow)
+			body.Summary.ConvertedWasteHigh = data.convert(data.Summary.TotalWasteHigh)
+		}
+	}
+	return body
+}
+
+// inCurrency converts a USD amount at the report's ExchangeRate. Amounts are
+// returned unchanged when the report has no currency or rate.
+func (d Data) inCurrency(usd float64) float64 {
+	if d.Currency == "" || d.ExchangeRate <= 0 {
+		return usd
+	}
+	return usd * d.ExchangeRate
+}
+
+// convert returns a USD amount in the report currency, rounded to cents.
+func (d Data) convert(usd float64) *float64 {
+	v := math.Round(d.inCurrency(usd)*100) / 100
+	return &v
+}
+
+// money renders a USD amount in the report currency: "$12.34" for USD, "11.35 EUR" otherwise.
+func (d Data) money(usd float64) string {
+	if d.Currency == "" {
+		return fmt.Sprintf("$%.2f", usd)
+	}
+	return fmt.Sprintf("%.2f %s", d.inCurrency(usd), d.Currency)
+}
+
+// moneyRange renders a low–high range in the report currency.
+func (d Data) moneyRange(low, high float64) string {
+	if d.Currency == "" {
+		return fmt.Sprintf("$%.2f–$%.2f", low, high)
+	}
+	return fmt.Sprintf("%.2f–%.2f %s", d.inCurrency(low), d.inCurrency(high), d.Currency)
+}
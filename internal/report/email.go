@@ -0,0 +1,108 @@
+package report
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// emailLineLength is the base64 line length for attachments (RFC 2045).
+const emailLineLength = 76
+
+// Generate renders the HTML report and sends it, with the attachment, to every recipient.
+func (r *EmailReporter) Generate(data Data) error {
+	if len(r.To) == 0 {
+		return fmt.Errorf("send email: no recipients")
+	}
+	msg, err := r.buildMessage(data)
+	if err != nil {
+		return err
+	}
+	send := r.Send
+	if send == nil {
+		send = smtp.SendMail
+	}
+	if err := send(r.Server, r.Auth, r.From, r.To, msg); err != nil {
+		return fmt.Errorf("send email via %s: %w", r.Server, err)
+	}
+	return nil
+}
+
+// emailSubject names the total waste so it is visible without opening the message.
+func emailSubject(data Data) string {
+	return fmt.Sprintf("awsspectre: %s/month estimated waste in %d findings",
+		data.money(data.Summary.TotalMonthlyWaste), len(data.Findings))
+}
+
+// buildMessage returns a multipart/mixed message: the HTML report as the body and
+// the Format report as an attachment.
+func (r *EmailReporter) buildMessage(data Data) ([]byte, error) {
+	var html bytes.Buffer
+	if err := (&HTMLReporter{Writer: &html}).Generate(data); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	headers := []struct{ key, value string }{
+		{"From", r.From},
+		{"To", strings.Join(r.To, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", emailSubject(data))},
+		{"Date", time.Now().UTC().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/mixed; boundary=" + mw.Boundary()},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h.key, h.value)
+	}
+	buf.WriteString("\r\n")
+
+	body, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	qp := quotedprintable.NewWriter(body)
+	if _, err := qp.Write(html.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+
+	if len(r.Attachment) > 0 {
+		object, ok := formatObjects[r.Format]
+		if !ok {
+			object = formatObject{"application/octet-stream", ""}
+		}
+		name := "awsspectre-" + data.Timestamp.UTC().Format(reportNameTimeFormat) + object.extension
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {object.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": name})},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoded := base64.StdEncoding.EncodeToString(r.Attachment)
+		for len(encoded) > emailLineLength {
+			fmt.Fprintf(part, "%s\r\n", encoded[:emailLineLength])
+			encoded = encoded[emailLineLength:]
+		}
+		fmt.Fprintf(part, "%s\r\n", encoded)
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,205 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// htmlFinding is a finding as rendered in the HTML report.
+type htmlFinding struct {
+	awstype.Finding
+	Name  string
+	Waste string
+}
+
+// htmlGroup is a collapsible rollup of findings sharing a region or resource type.
+type htmlGroup struct {
+	Name     string
+	Waste    string
+	Findings []htmlFinding
+	usd      float64
+}
+
+// htmlCount is one severity's finding count.
+type htmlCount struct {
+	Severity string
+	Count    int
+}
+
+type htmlView struct {
+	Data
+	TotalWaste string
+	Severities []htmlCount
+	Findings   []htmlFinding
+	Regions    []htmlGroup
+	Types      []htmlGroup
+}
+
+// Generate writes a self-contained static HTML page with inline CSS and script.
+func (r *HTMLReporter) Generate(data Data) error {
+	view := htmlView{
+		Data:       data,
+		TotalWaste: data.money(data.Summary.TotalMonthlyWaste),
+	}
+	for _, s := range []awstype.Severity{awstype.SeverityHigh, awstype.SeverityMedium, awstype.SeverityLow} {
+		view.Severities = append(view.Severities, htmlCount{Severity: string(s), Count: data.Summary.BySeverity[string(s)]})
+	}
+
+	regions := make(map[string]*htmlGroup)
+	types := make(map[string]*htmlGroup)
+	for _, f := range data.Findings {
+		hf := htmlFinding{Finding: f, Name: f.ResourceName, Waste: formatWaste(data, f)}
+		if hf.Name == "" {
+			hf.Name = f.ResourceID
+		}
+		view.Findings = append(view.Findings, hf)
+		addToGroup(regions, f.Region, hf)
+		addToGroup(types, string(f.ResourceType), hf)
+	}
+	view.Regions = sortedGroups(data, regions)
+	view.Types = sortedGroups(data, types)
+
+	if err := htmlTemplate.Execute(r.Writer, view); err != nil {
+		return fmt.Errorf("render HTML report: %w", err)
+	}
+	return nil
+}
+
+func addToGroup(groups map[string]*htmlGroup, name string, f htmlFinding) {
+	g, ok := groups[name]
+	if !ok {
+		g = &htmlGroup{Name: name}
+		groups[name] = g
+	}
+	g.Findings = append(g.Findings, f)
+	g.usd += f.EstimatedMonthlyWaste
+}
+
+// sortedGroups orders groups by descending waste, then name.
+func sortedGroups(data Data, groups map[string]*htmlGroup) []htmlGroup {
+	out := make([]htmlGroup, 0, len(groups))
+	for _, g := range groups {
+		g.Waste = data.money(g.usd)
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].usd != out[j].usd {
+			return out[i].usd > out[j].usd
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>awsspectre — AWS Resource Waste Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; }
+h1 { font-size: 1.5rem; margin-bottom: 0.25rem; }
+.meta { color: #59636e; font-size: 0.85rem; }
+.cards { display: flex; gap: 1rem; margin: 1.5rem 0; flex-wrap: wrap; }
+.card { border: 1px solid #d1d9e0; border-radius: 6px; padding: 0.75rem 1.25rem; min-width: 8rem; }
+.card .value { font-size: 1.4rem; font-weight: 600; }
+.card .label { color: #59636e; font-size: 0.8rem; text-transform: uppercase; }
+table { border-collapse: collapse; width: 100%; margin: 0.5rem 0 1.5rem; font-size: 0.9rem; }
+th, td { text-align: left; padding: 0.4rem 0.6rem; border-bottom: 1px solid #d1d9e0; vertical-align: top; }
+th { background: #f6f8fa; }
+table.sortable th { cursor: pointer; user-select: none; }
+table.sortable th::after { content: " \2195"; color: #9198a1; }
+td.num { text-align: right; white-space: nowrap; }
+.sev-high { color: #d1242f; font-weight: 600; }
+.sev-medium { color: #9a6700; font-weight: 600; }
+.sev-low { color: #59636e; }
+details { margin: 0.4rem 0; }
+summary { cursor: pointer; padding: 0.3rem 0; }
+summary .rollup { color: #59636e; }
+ul.errors { color: #9a6700; }
+</style>
+</head>
+<body>
+<h1>awsspectre — AWS Resource Waste Report</h1>
+<div class="meta">{{.Tool}} {{.Version}} · {{.Timestamp.Format "2006-01-02 15:04 UTC"}} · regions: {{range $i, $r := .Config.Regions}}{{if $i}}, {{end}}{{$r}}{{end}}</div>
+
+<div class="cards">
+<div class="card"><div class="value" id="total-waste">{{.TotalWaste}}</div><div class="label">Estimated monthly waste</div></div>
+<div class="card"><div class="value">{{.Summary.TotalFindings}}</div><div class="label">Findings</div></div>
+{{range .Severities}}<div class="card"><div class="value sev-{{.Severity}}">{{.Count}}</div><div class="label">{{.Severity}}</div></div>
+{{end}}<div class="card"><div class="value">{{.Summary.TotalResourcesScanned}}</div><div class="label">Resources scanned</div></div>
+</div>
+
+{{if .Findings}}
+<h2>Findings</h2>
+<table class="sortable" id="findings">
+<thead><tr><th>Severity</th><th>Type</th><th>Resource</th><th>Region</th><th>Waste/mo</th><th>Finding</th><th>Message</th></tr></thead>
+<tbody>
+{{range .Findings}}<tr><td class="sev-{{.Severity}}" data-sort="{{.Severity}}">{{.Severity}}</td><td>{{.ResourceType}}</td><td>{{.Name}}{{if .ResourceName}}<br><small>{{.ResourceID}}</small>{{end}}</td><td>{{.Region}}</td><td class="num" data-sort="{{printf "%.2f" .EstimatedMonthlyWaste}}">{{.Waste}}</td><td>{{.ID}}</td><td>{{.Message}}</td></tr>
+{{end}}</tbody>
+</table>
+
+<h2>By region</h2>
+{{range .Regions}}<details>
+<summary><strong>{{.Name}}</strong> <span class="rollup">— {{len .Findings}} findings, {{.Waste}}/month</span></summary>
+<table>
+<thead><tr><th>Severity</th><th>Type</th><th>Resource</th><th>Waste/mo</th><th>Message</th></tr></thead>
+<tbody>
+{{range .Findings}}<tr><td class="sev-{{.Severity}}">{{.Severity}}</td><td>{{.ResourceType}}</td><td title="{{.ResourceID}}">{{.Name}}</td><td class="num">{{.Waste}}</td><td>{{.Message}}</td></tr>
+{{end}}</tbody>
+</table>
+</details>
+{{end}}
+<h2>By resource type</h2>
+{{range .Types}}<details>
+<summary><strong>{{.Name}}</strong> <span class="rollup">— {{len .Findings}} findings, {{.Waste}}/month</span></summary>
+<table>
+<thead><tr><th>Severity</th><th>Resource</th><th>Region</th><th>Waste/mo</th><th>Message</th></tr></thead>
+<tbody>
+{{range .Findings}}<tr><td class="sev-{{.Severity}}">{{.Severity}}</td><td title="{{.ResourceID}}">{{.Name}}</td><td>{{.Region}}</td><td class="num">{{.Waste}}</td><td>{{.Message}}</td></tr>
+{{end}}</tbody>
+</table>
+</details>
+{{end}}
+{{else}}
+<p>No idle resources found.</p>
+{{end}}
+
+{{if .Errors}}<h2>Warnings</h2>
+<ul class="errors">
+{{range .Errors}}<li>{{.}}</li>
+{{end}}</ul>
+{{end}}
+
+<script>
+document.querySelectorAll("table.sortable").forEach(function (table) {
+  var severityRank = {high: 0, medium: 1, low: 2};
+  table.querySelectorAll("th").forEach(function (th, col) {
+    var asc = false;
+    th.addEventListener("click", function () {
+      asc = !asc;
+      var body = table.tBodies[0];
+      var rows = Array.prototype.slice.call(body.rows);
+      var key = function (row) {
+        var cell = row.cells[col];
+        var v = cell.getAttribute("data-sort");
+        if (v === null) return cell.textContent;
+        if (v in severityRank) return severityRank[v];
+        return parseFloat(v);
+      };
+      rows.sort(function (a, b) {
+        var x = key(a), y = key(b);
+        var c = typeof x === "number" ? x - y : String(x).localeCompare(String(y));
+        return asc ? c : -c;
+      });
+      rows.forEach(function (row) { body.appendChild(row); });
+    });
+  });
+});
+</script>
+</body>
+</html>
+`))
@@ -8,14 +8,14 @@ import (
 // jsonEnvelope wraps Data with a schema field for spectre/v1 output.
 type jsonEnvelope struct {
 	Schema string `json:"$schema"`
-	Data
+	reportBody
 }
 
 // Generate writes spectre/v1 JSON envelope output.
 func (r *JSONReporter) Generate(data Data) error {
 	envelope := jsonEnvelope{
-		Schema: "spectre/v1",
-		Data:   data,
+		Schema:     "spectre/v1",
+		reportBody: newReportBody(data),
 	}
 
 	enc := json.NewEncoder(r.Writer)
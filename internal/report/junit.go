@@ -0,0 +1,90 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Generate writes JUnit XML with one test suite per resource type and one test case
+// per finding. High-severity findings are failures; the rest pass with their
+// message in system-out, so CI can track them without failing the build.
+func (r *JUnitReporter) Generate(data Data) error {
+	byType := make(map[string][]awstype.Finding)
+	for _, f := range data.Findings {
+		byType[string(f.ResourceType)] = append(byType[string(f.ResourceType)], f)
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	suites := junitTestSuites{Name: data.Tool}
+	timestamp := data.Timestamp.Format("2006-01-02T15:04:05")
+	for _, t := range types {
+		suite := junitTestSuite{Name: t, Timestamp: timestamp}
+		for _, f := range byType[t] {
+			tc := junitTestCase{
+				Name:      fmt.Sprintf("%s %s (%s)", f.ID, f.ResourceID, f.Region),
+				ClassName: data.Tool + "." + t,
+			}
+			text := fmt.Sprintf("%s: estimated monthly waste %s", f.Message, formatWaste(data, f))
+			if f.Severity == awstype.SeverityHigh {
+				tc.Failure = &junitFailure{Message: text, Type: string(f.ID), Text: text}
+				suite.Failures++
+			} else {
+				tc.SystemOut = text
+			}
+			suite.Cases = append(suite.Cases, tc)
+			suite.Tests++
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	w := &errWriter{w: r.Writer}
+	w.printf("%s", xml.Header)
+	if w.err != nil {
+		return w.err
+	}
+	enc := xml.NewEncoder(r.Writer)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return fmt.Errorf("encode JUnit report: %w", err)
+	}
+	w.println("")
+	return w.err
+}
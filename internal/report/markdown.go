@@ -0,0 +1,77 @@
+package report
+
+import (
+	"sort"
+	"strings"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// Generate writes a GitHub-flavored Markdown summary suitable for PR or issue comments.
+func (r *MarkdownReporter) Generate(data Data) error {
+	w := &errWriter{w: r.Writer}
+
+	w.println("## awsspectre — AWS Resource Waste Report")
+	w.println("")
+	if len(data.Findings) == 0 {
+		w.println("No idle resources found.")
+	} else {
+		w.printf("**Estimated monthly waste: %s** across %d findings (%d resources scanned)\n",
+			data.money(data.Summary.TotalMonthlyWaste), data.Summary.TotalFindings, data.Summary.TotalResourcesScanned)
+		w.println("")
+
+		w.println("| Severity | Findings |")
+		w.println("|----------|---------:|")
+		for _, s := range []awstype.Severity{awstype.SeverityHigh, awstype.SeverityMedium, awstype.SeverityLow} {
+			w.printf("| %s | %d |\n", s, data.Summary.BySeverity[string(s)])
+		}
+		w.println("")
+
+		findings := make([]awstype.Finding, len(data.Findings))
+		copy(findings, data.Findings)
+		sort.SliceStable(findings, func(i, j int) bool {
+			return findings[i].EstimatedMonthlyWaste > findings[j].EstimatedMonthlyWaste
+		})
+
+		w.println("| Severity | Type | Resource | Region | Waste/mo | Message |")
+		w.println("|----------|------|----------|--------|---------:|---------|")
+		for _, f := range findings {
+			resource := "`" + f.ResourceID + "`"
+			if f.ResourceName != "" {
+				resource = markdownCell(f.ResourceName) + " (" + resource + ")"
+			}
+			w.printf("| %s | %s | %s | %s | %s | %s |\n",
+				f.Severity, f.ResourceType, resource, f.Region, formatWaste(data, f), markdownCell(f.Message))
+		}
+	}
+
+	w.println("")
+	w.println("<details>")
+	w.println("<summary>Scan configuration</summary>")
+	w.println("")
+	w.printf("- Regions: %s\n", strings.Join(data.Config.Regions, ", "))
+	w.printf("- Idle days: %d\n", data.Config.IdleDays)
+	w.printf("- Stale days: %d\n", data.Config.StaleDays)
+	w.printf("- Minimum monthly cost: $%.2f\n", data.Config.MinMonthlyCost)
+	if data.Currency != "" {
+		w.printf("- Exchange rate: 1 USD = %g %s\n", data.ExchangeRate, data.Currency)
+	}
+	w.printf("- Generated by %s %s at %s\n", data.Tool, data.Version, data.Timestamp.Format("2006-01-02 15:04 UTC"))
+	w.println("")
+	w.println("</details>")
+
+	if len(data.Errors) > 0 {
+		w.println("")
+		w.printf("**Warnings (%d):**\n\n", len(data.Errors))
+		for _, e := range data.Errors {
+			w.printf("- %s\n", markdownCell(e))
+		}
+	}
+	return w.err
+}
+
+// markdownCell escapes text for a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// promSeries identifies one labelled series of the aggregated finding metrics.
+type promSeries struct {
+	resourceType string
+	region       string
+	severity     string
+}
+
+// Generate writes Prometheus text exposition format for the node_exporter textfile
+// collector. Findings are aggregated by resource type, region, and severity.
+func (r *PrometheusReporter) Generate(data Data) error {
+	waste := make(map[promSeries]float64)
+	counts := make(map[promSeries]int)
+	for _, f := range data.Findings {
+		key := promSeries{resourceType: string(f.ResourceType), region: f.Region, severity: string(f.Severity)}
+		waste[key] += f.EstimatedMonthlyWaste
+		counts[key]++
+	}
+	keys := make([]promSeries, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.resourceType != b.resourceType {
+			return a.resourceType < b.resourceType
+		}
+		if a.region != b.region {
+			return a.region < b.region
+		}
+		return a.severity < b.severity
+	})
+
+	w := &errWriter{w: r.Writer}
+	w.println("# HELP awsspectre_estimated_monthly_waste_usd Estimated monthly waste in USD of findings by resource type, region, and severity.")
+	w.println("# TYPE awsspectre_estimated_monthly_waste_usd gauge")
+	for _, key := range keys {
+		w.printf("awsspectre_estimated_monthly_waste_usd{%s} %s\n", key.labels(), promValue(waste[key]))
+	}
+	w.println("# HELP awsspectre_findings_total Number of findings by resource type, region, and severity.")
+	w.println("# TYPE awsspectre_findings_total gauge")
+	for _, key := range keys {
+		w.printf("awsspectre_findings_total{%s} %d\n", key.labels(), counts[key])
+	}
+	w.println("# HELP awsspectre_resources_scanned Number of resources examined by the last scan.")
+	w.println("# TYPE awsspectre_resources_scanned gauge")
+	w.printf("awsspectre_resources_scanned %d\n", data.Summary.TotalResourcesScanned)
+	w.println("# HELP awsspectre_last_scan_timestamp_seconds Unix time the last scan finished.")
+	w.println("# TYPE awsspectre_last_scan_timestamp_seconds gauge")
+	w.printf("awsspectre_last_scan_timestamp_seconds %d\n", data.Timestamp.Unix())
+	return w.err
+}
+
+func (s promSeries) labels() string {
+	return `resource_type="` + promEscape(s.resourceType) + `",region="` + promEscape(s.region) + `",severity="` + promEscape(s.severity) + `"`
+}
+
+// promEscape escapes a label value for the text exposition format.
+func promEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func promValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// reportNameTimeFormat is the scan timestamp layout used in uploaded object keys and
+// attachment names. Colons are replaced so names stay safe on local filesystems.
+const reportNameTimeFormat = "2006-01-02T15-04-05Z"
+
+// S3PutAPI is the minimal interface for uploading reports to S3.
+type S3PutAPI interface {
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+// formatObject is the content type and key extension of an uploaded report format.
+type formatObject struct {
+	contentType string
+	extension   string
+}
+
+// formatObjects maps each --format to how its report is stored in S3.
+var formatObjects = map[string]formatObject{
+	"json":       {"application/json", ".json"},
+	"text":       {"text/plain; charset=utf-8", ".txt"},
+	"sarif":      {"application/sarif+json", ".sarif"},
+	"spectrehub": {"application/json", ".json"},
+	"workitems":  {"application/json", ".json"},
+	"csv":        {"text/csv; charset=utf-8", ".csv"},
+	"html":       {"text/html; charset=utf-8", ".html"},
+	"markdown":   {"text/markdown; charset=utf-8", ".md"},
+	"prometheus": {"text/plain; version=0.0.4", ".prom"},
+	"junit":      {"application/xml", ".xml"},
+}
+
+// S3Uploader stores generated reports under Prefix in Bucket, one object per scan
+// keyed by the scan timestamp, so scheduled scans build a history the diff command
+// can compare.
+type S3Uploader struct {
+	Client S3PutAPI
+	Bucket string
+	Prefix string
+}
+
+// ParseS3URI splits an s3://bucket/prefix URI into its bucket and key prefix.
+func ParseS3URI(uri string) (bucket, prefix string, err error) {
+	rest, ok := strings.CutPrefix(uri, "s3://")
+	if !ok {
+		return "", "", fmt.Errorf("invalid S3 URI %q (expected s3://bucket/prefix)", uri)
+	}
+	bucket, prefix, _ = strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", fmt.Errorf("invalid S3 URI %q: missing bucket", uri)
+	}
+	return bucket, strings.Trim(prefix, "/"), nil
+}
+
+// Key returns the object key for a report of format generated from data.
+func (u *S3Uploader) Key(format string, data Data) string {
+	name := data.Timestamp.UTC().Format(reportNameTimeFormat) + formatObjects[format].extension
+	if u.Prefix == "" {
+		return name
+	}
+	return path.Join(u.Prefix, name)
+}
+
+// Upload stores body, a report generated in format from data, and returns its key.
+func (u *S3Uploader) Upload(ctx context.Context, format string, data Data, body []byte) (string, error) {
+	object, ok := formatObjects[format]
+	if !ok {
+		return "", fmt.Errorf("unsupported format for S3 upload: %s", format)
+	}
+	key := u.Key(format, data)
+	_, err := u.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      awssdk.String(u.Bucket),
+		Key:         awssdk.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: awssdk.String(object.contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("put s3://%s/%s: %w", u.Bucket, key, err)
+	}
+	return key, nil
+}
@@ -71,6 +71,15 @@ func (r *SARIFReporter) Generate(data Data) error {
 	results := make([]sarifResult, 0, len(data.Findings))
 
 	for _, f := range data.Findings {
+		props := map[string]any{
+			"resourceName":          f.ResourceName,
+			"estimatedMonthlyWaste": f.EstimatedMonthlyWaste,
+			"metadata":              f.Metadata,
+		}
+		if data.Currency != "" {
+			props["currency"] = data.Currency
+			props["convertedMonthlyWaste"] = *data.convert(f.EstimatedMonthlyWaste)
+		}
 		results = append(results, sarifResult{
 			RuleID:  string(f.ID),
 			Level:   sarifLevel(f.Severity),
@@ -84,11 +93,7 @@ func (r *SARIFReporter) Generate(data Data) error {
 					},
 				},
 			},
-			Props: map[string]any{
-				"resourceName":          f.ResourceName,
-				"estimatedMonthlyWaste": f.EstimatedMonthlyWaste,
-				"metadata":              f.Metadata,
-			},
+			Props: props,
 		})
 	}
 
@@ -132,13 +137,20 @@ func buildSARIFRules() []sarifRule {
 	return []sarifRule{
 		{ID: string(awstype.FindingIdleEC2), ShortDescription: sarifMessage{Text: "Idle EC2 instance"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		{ID: string(awstype.FindingStoppedEC2), ShortDescription: sarifMessage{Text: "Stopped EC2 instance"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingRightsizeEC2), ShortDescription: sarifMessage{Text: "Oversized EC2 instance"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingOldGenerationEC2), ShortDescription: sarifMessage{Text: "Previous-generation EC2 instance"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingEC2SpotCandidate), ShortDescription: sarifMessage{Text: "Spot-eligible EC2 instance running on demand"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingEBSGp2ToGp3), ShortDescription: sarifMessage{Text: "gp2 EBS volume cheaper as gp3"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingEBSOverProvisionedIOPS), ShortDescription: sarifMessage{Text: "EBS volume with unused provisioned IOPS"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
 		{ID: string(awstype.FindingDetachedEBS), ShortDescription: sarifMessage{Text: "Detached EBS volume"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		{ID: string(awstype.FindingUnusedEIP), ShortDescription: sarifMessage{Text: "Unused Elastic IP"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
 		{ID: string(awstype.FindingIdleALB), ShortDescription: sarifMessage{Text: "Idle Application Load Balancer"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		{ID: string(awstype.FindingIdleNLB), ShortDescription: sarifMessage{Text: "Idle Network Load Balancer"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingIdleGWLB), ShortDescription: sarifMessage{Text: "Idle Gateway Load Balancer"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		{ID: string(awstype.FindingIdleNATGateway), ShortDescription: sarifMessage{Text: "Idle NAT Gateway"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		// WO-201: remaining cost-bearing findings need declared SARIF rules.
 		{ID: string(awstype.FindingLowTrafficNATGateway), ShortDescription: sarifMessage{Text: "Low-traffic NAT Gateway"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingNATGatewayConsolidation), ShortDescription: sarifMessage{Text: "Redundant low-traffic NAT Gateway in a VPC"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
 		{ID: string(awstype.FindingKinesisOverProvisioned), ShortDescription: sarifMessage{Text: "Over-provisioned Kinesis stream"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
 		// WO-207: baseline rules below are outside the WO-201 cost-bearing block.
 		{ID: string(awstype.FindingIdleRDS), ShortDescription: sarifMessage{Text: "Idle RDS instance"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
@@ -147,6 +159,8 @@ func buildSARIFRules() []sarifRule {
 		// WO-200: default-visible hygiene findings need declared SARIF rules.
 		// WO-204: default levels must match scanner-emitted severities.
 		{ID: string(awstype.FindingIdleLambda), ShortDescription: sarifMessage{Text: "Idle Lambda function"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingLambdaIdleConcurrency), ShortDescription: sarifMessage{Text: "Unused Lambda provisioned concurrency"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingLambdaOverProvisioned), ShortDescription: sarifMessage{Text: "Lambda function with over-provisioned memory"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
 		{ID: string(awstype.FindingKinesisStreamIdle), ShortDescription: sarifMessage{Text: "Idle Kinesis stream"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 		{ID: string(awstype.FindingKinesisFirehoseIdle), ShortDescription: sarifMessage{Text: "Idle Kinesis Firehose delivery stream"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
 		{ID: string(awstype.FindingSQSIdle), ShortDescription: sarifMessage{Text: "Idle SQS queue"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
@@ -157,5 +171,64 @@ func buildSARIFRules() []sarifRule {
 		// WO-198: CloudFront findings need declared rules for SARIF code-scanning consumers.
 		{ID: string(awstype.FindingCloudFrontDisabled), ShortDescription: sarifMessage{Text: "Disabled CloudFront distribution"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
 		{ID: string(awstype.FindingCloudFrontIdle), ShortDescription: sarifMessage{Text: "Idle CloudFront distribution"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingRDSIdleReadReplica), ShortDescription: sarifMessage{Text: "RDS read replica with no reads"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingRDSStorageOverProvisioned), ShortDescription: sarifMessage{Text: "RDS instance with mostly unused allocated storage"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingRDSUnnecessaryMonitoring), ShortDescription: sarifMessage{Text: "Paid RDS monitoring on an idle instance"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingIdleTGWPeering), ShortDescription: sarifMessage{Text: "Idle Transit Gateway peering attachment"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingTGWIdleAttachment), ShortDescription: sarifMessage{Text: "Idle Transit Gateway VPC or VPN attachment"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingDynamoDBIdle), ShortDescription: sarifMessage{Text: "Idle DynamoDB table"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingDynamoDBOverProvisioned), ShortDescription: sarifMessage{Text: "Over-provisioned DynamoDB table"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingRedshiftIdle), ShortDescription: sarifMessage{Text: "Idle Redshift cluster"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingRedshiftPauseable), ShortDescription: sarifMessage{Text: "Redshift cluster idle for most of each day"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingS3EmptyBucket), ShortDescription: sarifMessage{Text: "Empty S3 bucket"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingS3NoLifecycle), ShortDescription: sarifMessage{Text: "S3 bucket without lifecycle rules"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingS3IncompleteMultipart), ShortDescription: sarifMessage{Text: "Abandoned S3 multipart uploads"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingS3StorageClassOpportunity), ShortDescription: sarifMessage{Text: "Rarely read S3 data in Standard storage"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingEFSIdle), ShortDescription: sarifMessage{Text: "Idle EFS file system"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingEFSNoLifecycle), ShortDescription: sarifMessage{Text: "EFS file system without an Infrequent Access lifecycle policy"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingEKSEmptyCluster), ShortDescription: sarifMessage{Text: "EKS cluster with no worker nodes"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingECSIdleService), ShortDescription: sarifMessage{Text: "Idle ECS service"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingECSZeroTaskCluster), ShortDescription: sarifMessage{Text: "ECS cluster with no running tasks"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingDocDBIdle), ShortDescription: sarifMessage{Text: "Idle DocumentDB cluster"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingNeptuneIdle), ShortDescription: sarifMessage{Text: "Idle Neptune instance"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingMSKIdle), ShortDescription: sarifMessage{Text: "Idle MSK cluster"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingAPIGWIdleStage), ShortDescription: sarifMessage{Text: "API Gateway stage with zero requests"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingAPIGWUnusedCache), ShortDescription: sarifMessage{Text: "API Gateway stage cache with zero requests"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingRoute53UnusedHealthCheck), ShortDescription: sarifMessage{Text: "Route53 health check not referenced by any record"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingRoute53EmptyZone), ShortDescription: sarifMessage{Text: "Route53 hosted zone with only NS and SOA records"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingSFNIdle), ShortDescription: sarifMessage{Text: "Step Functions state machine with zero executions"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingECRStaleImages), ShortDescription: sarifMessage{Text: "ECR repository with stale images and no lifecycle policy"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingGlueIdleDevEndpoint), ShortDescription: sarifMessage{Text: "Idle Glue development endpoint"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingGlueUnusedJob), ShortDescription: sarifMessage{Text: "Glue job with no recent runs"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingSageMakerIdleNotebook), ShortDescription: sarifMessage{Text: "Long-running SageMaker notebook instance"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingSageMakerIdleEndpoint), ShortDescription: sarifMessage{Text: "SageMaker endpoint with zero invocations"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingGAIdle), ShortDescription: sarifMessage{Text: "Idle Global Accelerator"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingVPNIdle), ShortDescription: sarifMessage{Text: "Idle Site-to-Site VPN connection"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingWorkSpacesIdle), ShortDescription: sarifMessage{Text: "AlwaysOn WorkSpace with no connections"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingWorkSpacesUnused), ShortDescription: sarifMessage{Text: "Unused WorkSpace"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingFSxIdle), ShortDescription: sarifMessage{Text: "Idle FSx file system"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingSecretUnused), ShortDescription: sarifMessage{Text: "Unused Secrets Manager secret"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingKMSUnusedKey), ShortDescription: sarifMessage{Text: "Unused customer-managed KMS key"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingBeanstalkIdleEnv), ShortDescription: sarifMessage{Text: "Idle Elastic Beanstalk environment"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingAppRunnerIdle), ShortDescription: sarifMessage{Text: "Idle App Runner service"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingEMRIdleCluster), ShortDescription: sarifMessage{Text: "Idle EMR cluster"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingLogsNoRetention), ShortDescription: sarifMessage{Text: "Log group with no retention policy"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingLogsEmptyGroup), ShortDescription: sarifMessage{Text: "Empty log group"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingRDSStaleSnapshot), ShortDescription: sarifMessage{Text: "Stale manual RDS snapshot"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingUnusedENI), ShortDescription: sarifMessage{Text: "Unattached network interface"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingPublicIPv4Unneeded), ShortDescription: sarifMessage{Text: "Public IPv4 address on low-traffic instance"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingOrphanedTargetGroup), ShortDescription: sarifMessage{Text: "Target group not attached to a load balancer"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingStaleAMI), ShortDescription: sarifMessage{Text: "Stale AMI not used by any instance"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingDMSIdle), ShortDescription: sarifMessage{Text: "Idle DMS replication instance"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingMQIdle), ShortDescription: sarifMessage{Text: "Idle Amazon MQ broker"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingMemoryDBIdle), ShortDescription: sarifMessage{Text: "Idle MemoryDB cluster"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
+		{ID: string(awstype.FindingRDSProxyIdle), ShortDescription: sarifMessage{Text: "Idle RDS Proxy"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingOrphanedAlarm), ShortDescription: sarifMessage{Text: "Alarm on a deleted resource"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingUnusedDashboard), ShortDescription: sarifMessage{Text: "Unused CloudWatch dashboard"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingCognitoUnusedPool), ShortDescription: sarifMessage{Text: "Unused Cognito user pool"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingAppSyncIdle), ShortDescription: sarifMessage{Text: "AppSync API with zero requests"}, DefaultConfig: sarifDefaultLevel{Level: "note"}},
+		{ID: string(awstype.FindingAppSyncUnusedCache), ShortDescription: sarifMessage{Text: "AppSync API cache with zero requests"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingTimestreamIdle), ShortDescription: sarifMessage{Text: "Timestream table with zero writes and queries"}, DefaultConfig: sarifDefaultLevel{Level: "warning"}},
+		{ID: string(awstype.FindingAOSSIdle), ShortDescription: sarifMessage{Text: "Idle OpenSearch Serverless collection"}, DefaultConfig: sarifDefaultLevel{Level: "error"}},
 	}
 }
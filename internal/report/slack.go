@@ -0,0 +1,119 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
+)
+
+// slackTopFindings is how many of the costliest findings the Slack message lists.
+const slackTopFindings = 5
+
+// slackTimeout bounds the webhook request when no client is injected.
+const slackTimeout = 15 * time.Second
+
+// slackBlock is one Slack Block Kit block. Only the fields the message uses are modelled.
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Fields   []slackText `json:"fields,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// Generate posts a scan summary to the Slack incoming webhook.
+func (r *SlackReporter) Generate(data Data) error {
+	body, err := json.Marshal(buildSlackMessage(data))
+	if err != nil {
+		return fmt.Errorf("encode Slack message: %w", err)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = &http.Client{Timeout: slackTimeout}
+	}
+	resp, err := client.Post(r.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("post Slack message: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("post Slack message: %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+func buildSlackMessage(data Data) slackMessage {
+	total := data.money(data.Summary.TotalMonthlyWaste)
+	msg := slackMessage{
+		Text: fmt.Sprintf("awsspectre: %d findings, estimated monthly waste %s", data.Summary.TotalFindings, total),
+		Blocks: []slackBlock{
+			{Type: "header", Text: &slackText{Type: "plain_text", Text: "awsspectre — AWS Resource Waste Report"}},
+			{Type: "section", Fields: []slackText{
+				{Type: "mrkdwn", Text: "*Estimated monthly waste*\n" + total},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Findings*\n%d", data.Summary.TotalFindings)},
+				{Type: "mrkdwn", Text: "*By severity*\n" + slackSeverityCounts(data)},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Resources scanned*\n%d in %d regions", data.Summary.TotalResourcesScanned, data.Summary.RegionsScanned)},
+			}},
+		},
+	}
+
+	findings := make([]awstype.Finding, len(data.Findings))
+	copy(findings, data.Findings)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].EstimatedMonthlyWaste > findings[j].EstimatedMonthlyWaste
+	})
+	if len(findings) > slackTopFindings {
+		findings = findings[:slackTopFindings]
+	}
+	if len(findings) > 0 {
+		lines := make([]string, 0, len(findings))
+		for _, f := range findings {
+			name := f.ResourceID
+			if f.ResourceName != "" {
+				name = f.ResourceName + " (" + f.ResourceID + ")"
+			}
+			lines = append(lines, fmt.Sprintf("• *%s* `%s` %s — %s/mo", f.ID, slackEscape(name), f.Region, formatWaste(data, f)))
+		}
+		msg.Blocks = append(msg.Blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: fmt.Sprintf("*Top %d findings by cost*\n%s", len(findings), strings.Join(lines, "\n"))},
+		})
+	}
+
+	msg.Blocks = append(msg.Blocks, slackBlock{
+		Type:     "context",
+		Elements: []slackText{{Type: "mrkdwn", Text: fmt.Sprintf("%s %s · %s", data.Tool, data.Version, data.Timestamp.Format("2006-01-02 15:04 UTC"))}},
+	})
+	return msg
+}
+
+func slackSeverityCounts(data Data) string {
+	parts := make([]string, 0, 3)
+	for _, s := range []awstype.Severity{awstype.SeverityHigh, awstype.SeverityMedium, awstype.SeverityLow} {
+		parts = append(parts, fmt.Sprintf("%s: %d", s, data.Summary.BySeverity[string(s)]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// slackEscape escapes the characters Slack treats as control sequences in mrkdwn.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
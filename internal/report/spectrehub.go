@@ -8,14 +8,14 @@ import (
 // spectreHubEnvelope wraps Data for SpectreHub ingestion.
 type spectreHubEnvelope struct {
 	Schema string `json:"schema"`
-	Data
+	reportBody
 }
 
 // Generate writes SpectreHub envelope JSON output.
 func (r *SpectreHubReporter) Generate(data Data) error {
 	envelope := spectreHubEnvelope{
-		Schema: "spectre/v1",
-		Data:   data,
+		Schema:     "spectre/v1",
+		reportBody: newReportBody(data),
 	}
 
 	enc := json.NewEncoder(r.Writer)
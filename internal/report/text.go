@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+
+	awstype "github.com/ppiankov/awsspectre/internal/aws"
 )
 
 // Generate writes human-readable terminal output.
@@ -24,20 +26,28 @@ func (r *TextReporter) Generate(data Data) error {
 		return w.err
 	}
 
-	w.printf("Found %d idle resources with estimated monthly waste of $%.2f\n\n",
-		data.Summary.TotalFindings, data.Summary.TotalMonthlyWaste)
+	w.printf("Found %d idle resources with estimated monthly waste of %s\n\n",
+		data.Summary.TotalFindings, data.money(data.Summary.TotalMonthlyWaste))
 
 	tw2 := &errWriter{w: tw}
-	tw2.printf("SEVERITY\tTYPE\tRESOURCE\tREGION\tWASTE/MO\tMESSAGE\n")
-	tw2.printf("--------\t----\t--------\t------\t--------\t-------\n")
-
-	for _, f := range data.Findings {
-		name := f.ResourceID
-		if f.ResourceName != "" {
-			name = f.ResourceName
+	for _, region := range groupFindings(data.Findings, func(f awstype.Finding) string { return f.Region }) {
+		tw2.printf("%s — %s\n", region.name, groupTotal(data, region.findings))
+		for _, rt := range groupFindings(region.findings, func(f awstype.Finding) string { return string(f.ResourceType) }) {
+			tw2.printf("  %s — %s\n", rt.name, groupTotal(data, rt.findings))
+			tw2.printf("    SEVERITY\tRESOURCE\tWASTE/MO\tMESSAGE\n")
+			for _, f := range rt.findings {
+				name := f.ResourceID
+				if f.ResourceName != "" {
+					name = f.ResourceName
+				}
+				tw2.printf("    %s\t%s\t%s\t%s\n", f.Severity, name, formatWaste(data, f), f.Message)
+			}
 		}
-		tw2.printf("%s\t%s\t%s\t%s\t$%.2f\t%s\n",
-			f.Severity, f.ResourceType, name, f.Region, f.EstimatedMonthlyWaste, f.Message)
+		tw2.println("")
+	}
+	tw2.printf("Grand total — %s\n", groupTotal(data, data.Findings))
+	if tw2.err != nil {
+		return tw2.err
 	}
 	if err := tw.Flush(); err != nil {
 		return err
@@ -54,7 +64,16 @@ func writeTextSummary(w *errWriter, data Data) {
 	w.printf("Resources scanned:       %d\n", data.Summary.TotalResourcesScanned)
 	w.printf("Regions scanned:         %d\n", data.Summary.RegionsScanned)
 	w.printf("Total findings:          %d\n", data.Summary.TotalFindings)
-	w.printf("Estimated monthly waste: $%.2f\n", data.Summary.TotalMonthlyWaste)
+	if data.Summary.Suppressed > 0 {
+		w.printf("Suppressed by baseline:  %d\n", data.Summary.Suppressed)
+	}
+	w.printf("Estimated monthly waste: %s\n", data.money(data.Summary.TotalMonthlyWaste))
+	if data.Summary.TotalWasteHigh > 0 {
+		w.printf("Estimated waste range:   %s\n", data.moneyRange(data.Summary.TotalWasteLow, data.Summary.TotalWasteHigh))
+	}
+	if data.Currency != "" {
+		w.printf("Exchange rate:           1 USD = %g %s\n", data.ExchangeRate, data.Currency)
+	}
 
 	if len(data.Summary.BySeverity) > 0 {
 		parts := formatMapSorted(data.Summary.BySeverity)
@@ -64,6 +83,9 @@ func writeTextSummary(w *errWriter, data Data) {
 		parts := formatMapSorted(data.Summary.ByResourceType)
 		w.printf("By resource type:        %s\n", strings.Join(parts, ", "))
 	}
+	if len(data.Summary.ByTag) > 0 {
+		w.printf("%-25s%s\n", "By tag "+data.Summary.TagKey+":", strings.Join(formatTagWaste(data), ", "))
+	}
 
 	if len(data.Errors) > 0 {
 		w.printf("\nWarnings (%d):\n", len(data.Errors))
@@ -73,6 +95,59 @@ func writeTextSummary(w *errWriter, data Data) {
 	}
 }
 
+// findingGroup is a run of findings sharing a region or resource type.
+type findingGroup struct {
+	name     string
+	findings []awstype.Finding
+	waste    float64
+}
+
+// groupFindings groups findings by key, ordered by descending subtotal, then name.
+// Findings keep their order within a group.
+func groupFindings(findings []awstype.Finding, key func(awstype.Finding) string) []findingGroup {
+	index := make(map[string]int)
+	var groups []findingGroup
+	for _, f := range findings {
+		k := key(f)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, findingGroup{name: k})
+		}
+		groups[i].findings = append(groups[i].findings, f)
+		groups[i].waste += f.EstimatedMonthlyWaste
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].waste != groups[j].waste {
+			return groups[i].waste > groups[j].waste
+		}
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+// groupTotal renders a group's finding count and summed waste.
+func groupTotal(data Data, findings []awstype.Finding) string {
+	var waste float64
+	for _, f := range findings {
+		waste += f.EstimatedMonthlyWaste
+	}
+	noun := "findings"
+	if len(findings) == 1 {
+		noun = "finding"
+	}
+	return fmt.Sprintf("%d %s, %s/month", len(findings), noun, data.money(waste))
+}
+
+// formatWaste renders a finding's waste in the report currency, as a range when bounds are present.
+func formatWaste(data Data, f awstype.Finding) string {
+	if f.EstimatedMonthlyWasteHigh > 0 {
+		return data.moneyRange(f.EstimatedMonthlyWasteLow, f.EstimatedMonthlyWasteHigh)
+	}
+	return data.money(f.EstimatedMonthlyWaste)
+}
+
 // errWriter wraps an io.Writer and captures the first error.
 type errWriter struct {
 	w   io.Writer
@@ -93,6 +168,27 @@ func (ew *errWriter) println(s string) {
 	_, ew.err = fmt.Fprintln(ew.w, s)
 }
 
+// formatTagWaste renders Summary.ByTag as value=waste pairs, costliest first.
+func formatTagWaste(data Data) []string {
+	values := make([]string, 0, len(data.Summary.ByTag))
+	for v := range data.Summary.ByTag {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		a, b := data.Summary.ByTag[values[i]], data.Summary.ByTag[values[j]]
+		if a != b {
+			return a > b
+		}
+		return values[i] < values[j]
+	})
+
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, fmt.Sprintf("%s=%s", v, data.money(data.Summary.ByTag[v])))
+	}
+	return parts
+}
+
 func formatMapSorted(m map[string]int) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -2,6 +2,8 @@ package report
 
 import (
 	"io"
+	"net/http"
+	"net/smtp"
 	"time"
 
 	"github.com/ppiankov/awsspectre/internal/analyzer"
@@ -23,6 +25,9 @@ type Data struct {
 	Findings  []awstype.Finding `json:"findings"`
 	Summary   analyzer.Summary  `json:"summary"`
 	Errors    []string          `json:"errors,omitempty"`
+	// Currency is the ISO 4217 code waste is rendered in besides USD; empty for USD only.
+	Currency     string  `json:"currency,omitempty"`
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
 }
 
 // Target identifies the AWS account being audited.
@@ -58,3 +63,55 @@ type SpectreHubReporter struct {
 type SARIFReporter struct {
 	Writer io.Writer
 }
+
+// CSVReporter generates one CSV row per finding for spreadsheets.
+type CSVReporter struct {
+	Writer io.Writer
+}
+
+// HTMLReporter generates a self-contained static HTML page.
+type HTMLReporter struct {
+	Writer io.Writer
+}
+
+// MarkdownReporter generates a GitHub-flavored Markdown summary for PR and issue comments.
+type MarkdownReporter struct {
+	Writer io.Writer
+}
+
+// PrometheusReporter generates Prometheus text format for the node_exporter textfile collector.
+type PrometheusReporter struct {
+	Writer io.Writer
+}
+
+// SlackReporter posts a scan summary to a Slack incoming webhook. A nil Client
+// uses a default client with a timeout.
+type SlackReporter struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// SMTPSendFunc sends one message through an SMTP server; smtp.SendMail satisfies it.
+type SMTPSendFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// EmailReporter mails the scan as an HTML report with the Format report attached.
+// Attachment holds the Format output; a nil Send uses smtp.SendMail.
+type EmailReporter struct {
+	Server     string
+	Auth       smtp.Auth
+	From       string
+	To         []string
+	Format     string
+	Attachment []byte
+	Send       SMTPSendFunc
+}
+
+// JUnitReporter generates JUnit XML for CI systems.
+type JUnitReporter struct {
+	Writer io.Writer
+}
+
+// WorkItemsReporter generates cleanup work items grouped from linked findings.
+type WorkItemsReporter struct {
+	Writer io.Writer
+}
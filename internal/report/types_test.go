@@ -2,11 +2,28 @@ package report
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/smtp"
 	"strings"
 	"testing"
 	"time"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/ppiankov/awsspectre/internal/analyzer"
 	awstype "github.com/ppiankov/awsspectre/internal/aws"
 )
@@ -96,6 +113,73 @@ func TestTextReporter_Generate(t *testing.T) {
 	}
 }
 
+func TestTextReporter_GroupsByRegionAndType(t *testing.T) {
+	data := sampleData()
+	data.Findings = append(data.Findings,
+		awstype.Finding{ID: awstype.FindingDetachedEBS, Severity: awstype.SeverityMedium, ResourceType: awstype.ResourceEBS, ResourceID: "vol-1", Region: "us-east-1", EstimatedMonthlyWaste: 8},
+		awstype.Finding{ID: awstype.FindingIdleEC2, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEC2, ResourceID: "i-eu1", Region: "eu-west-1", EstimatedMonthlyWaste: 30},
+		awstype.Finding{ID: awstype.FindingIdleEC2, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEC2, ResourceID: "i-eu2", Region: "eu-west-1", EstimatedMonthlyWaste: 12.5},
+	)
+	data.Summary.TotalFindings = 4
+	data.Summary.TotalMonthlyWaste = 100.5
+
+	var buf bytes.Buffer
+	if err := (&TextReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"us-east-1 — 2 findings, $58.00/month",
+		"  ec2 — 1 finding, $50.00/month",
+		"  ebs — 1 finding, $8.00/month",
+		"eu-west-1 — 2 findings, $42.50/month",
+		"  ec2 — 2 findings, $42.50/month",
+		"Grand total — 4 findings, $100.50/month",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected text output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Count(output, "eu-west-1 —") != 1 || strings.Count(output, "us-east-1 —") != 1 {
+		t.Fatalf("expected exactly two region sections, got:\n%s", output)
+	}
+	if strings.Index(output, "us-east-1 —") > strings.Index(output, "eu-west-1 —") {
+		t.Fatal("expected the costlier region first")
+	}
+}
+
+func TestTextAndJSONReporters_ByTag(t *testing.T) {
+	data := sampleData()
+	data.Summary.TagKey = "team"
+	data.Summary.ByTag = map[string]float64{"platform": 42, "(untagged)": 8}
+
+	var text bytes.Buffer
+	if err := (&TextReporter{Writer: &text}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(text.String(), "By tag team:             platform=$42.00, (untagged)=$8.00") {
+		t.Fatalf("expected the tag breakdown, costliest first, got:\n%s", text.String())
+	}
+
+	var js bytes.Buffer
+	if err := (&JSONReporter{Writer: &js}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var envelope struct {
+		Summary struct {
+			TagKey string             `json:"tag_key"`
+			ByTag  map[string]float64 `json:"by_tag"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal(js.Bytes(), &envelope); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if envelope.Summary.TagKey != "team" || envelope.Summary.ByTag["platform"] != 42 {
+		t.Fatalf("expected by_tag in the JSON summary, got %+v", envelope.Summary)
+	}
+}
+
 func TestTextReporter_NoFindings(t *testing.T) {
 	var buf bytes.Buffer
 	r := &TextReporter{Writer: &buf}
@@ -155,6 +239,503 @@ func TestSpectreHubReporter_Generate(t *testing.T) {
 	}
 }
 
+func TestCSVReporter_Generate(t *testing.T) {
+	data := sampleData()
+	data.Findings[0].Metadata = map[string]any{"instance_type": "m5.large", "avg_cpu_percent": 2.5, "tags": map[string]string{"app": "web"}}
+
+	var buf bytes.Buffer
+	if err := (&CSVReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and 1 row, got %d lines:\n%s", len(lines), buf.String())
+	}
+	wantHeader := "id,severity,resource_type,resource_id,resource_name,region,estimated_monthly_waste,message,instance_type,avg_cpu_percent"
+	if lines[0] != wantHeader {
+		t.Fatalf("expected header %q, got %q", wantHeader, lines[0])
+	}
+	wantRow := "IDLE_EC2,high,ec2,i-abc123,web-server,us-east-1,50.00,CPU 2% over 7 days,m5.large,2.5"
+	if lines[1] != wantRow {
+		t.Fatalf("expected row %q, got %q", wantRow, lines[1])
+	}
+}
+
+func TestCSVReporter_NoFindings(t *testing.T) {
+	data := sampleData()
+	data.Findings = nil
+
+	var buf bytes.Buffer
+	if err := (&CSVReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "id,severity,resource_type,resource_id,resource_name,region,estimated_monthly_waste,message" {
+		t.Fatalf("expected only the base header, got %q", got)
+	}
+}
+
+func TestHTMLReporter_Generate(t *testing.T) {
+	data := sampleData()
+	data.Findings[0].Message = "CPU <2% over 7 days"
+
+	var buf bytes.Buffer
+	if err := (&HTMLReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"<!DOCTYPE html>", "$50.00", "i-abc123", "By region", "By resource type", "<details>", "<style>"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected HTML output to contain %q", want)
+		}
+	}
+	if strings.Contains(output, "CPU <2%") {
+		t.Fatal("expected finding messages to be HTML-escaped")
+	}
+	if strings.Contains(output, "<link") || strings.Contains(output, "src=") {
+		t.Fatal("expected no external assets")
+	}
+}
+
+func TestMarkdownReporter_Generate(t *testing.T) {
+	data := sampleData()
+	data.Findings = append(data.Findings, awstype.Finding{
+		ID:                    awstype.FindingDetachedEBS,
+		Severity:              awstype.SeverityMedium,
+		ResourceType:          awstype.ResourceEBS,
+		ResourceID:            "vol-big",
+		Region:                "us-east-1",
+		Message:               "Unattached gp3 | 500 GiB",
+		EstimatedMonthlyWaste: 80.0,
+	})
+	data.Summary.TotalMonthlyWaste = 130.0
+
+	var buf bytes.Buffer
+	if err := (&MarkdownReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"**Estimated monthly waste: $130.00**",
+		"| Severity | Type | Resource | Region | Waste/mo | Message |",
+		"| high | 1 |",
+		`Unattached gp3 \| 500 GiB`,
+		"- Idle days: 7",
+		"<details>",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected Markdown output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Index(output, "vol-big") > strings.Index(output, "i-abc123") {
+		t.Fatal("expected findings sorted by descending waste")
+	}
+}
+
+func TestPrometheusReporter_Generate(t *testing.T) {
+	data := sampleData()
+	data.Findings = append(data.Findings, awstype.Finding{
+		ID:                    awstype.FindingIdleEC2,
+		Severity:              awstype.SeverityHigh,
+		ResourceType:          awstype.ResourceEC2,
+		ResourceID:            "i-def456",
+		Region:                "us-east-1",
+		EstimatedMonthlyWaste: 25.5,
+	})
+
+	var buf bytes.Buffer
+	if err := (&PrometheusReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"# HELP awsspectre_estimated_monthly_waste_usd ",
+		"# TYPE awsspectre_estimated_monthly_waste_usd gauge",
+		`awsspectre_estimated_monthly_waste_usd{resource_type="ec2",region="us-east-1",severity="high"} 75.5`,
+		"# HELP awsspectre_findings_total ",
+		"# TYPE awsspectre_findings_total gauge",
+		`awsspectre_findings_total{resource_type="ec2",region="us-east-1",severity="high"} 2`,
+		"# HELP awsspectre_resources_scanned ",
+		"# TYPE awsspectre_resources_scanned gauge",
+		"awsspectre_resources_scanned 100",
+		"awsspectre_last_scan_timestamp_seconds 1771934400",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected Prometheus output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestSlackReporter_Generate(t *testing.T) {
+	data := sampleData()
+	for i := 0; i < 6; i++ {
+		data.Findings = append(data.Findings, awstype.Finding{
+			ID:                    awstype.FindingDetachedEBS,
+			Severity:              awstype.SeverityMedium,
+			ResourceType:          awstype.ResourceEBS,
+			ResourceID:            fmt.Sprintf("vol-%d", i),
+			Region:                "us-east-1",
+			EstimatedMonthlyWaste: float64(i),
+		})
+	}
+	data.Summary.TotalMonthlyWaste = 65.0
+
+	var posted []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		posted, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	r := &SlackReporter{WebhookURL: server.URL, Client: server.Client()}
+	if err := r.Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var msg map[string]any
+	if err := json.Unmarshal(posted, &msg); err != nil {
+		t.Fatalf("invalid Slack payload: %v", err)
+	}
+	if _, ok := msg["blocks"].([]any); !ok {
+		t.Fatalf("expected Block Kit blocks, got %s", posted)
+	}
+	payload := string(posted)
+	for _, want := range []string{"$65.00", "web-server (i-abc123)", "vol-5", "vol-2", "high: 1"} {
+		if !strings.Contains(payload, want) {
+			t.Fatalf("expected payload to contain %q, got %s", want, payload)
+		}
+	}
+	// Only the 5 costliest findings are listed: $50 and vol-5 through vol-2.
+	if strings.Contains(payload, "vol-1") || strings.Contains(payload, "vol-0") {
+		t.Fatalf("expected the cheapest findings to be left out, got %s", payload)
+	}
+}
+
+func TestSlackReporter_Non2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "invalid_token", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	r := &SlackReporter{WebhookURL: server.URL, Client: server.Client()}
+	err := r.Generate(sampleData())
+	if err == nil {
+		t.Fatal("expected an error for a 403 response")
+	}
+	if !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "invalid_token") {
+		t.Fatalf("expected status and body in the error, got %v", err)
+	}
+}
+
+func TestJUnitReporter_Generate(t *testing.T) {
+	data := sampleData()
+	data.Findings = append(data.Findings,
+		awstype.Finding{ID: awstype.FindingStoppedEC2, Severity: awstype.SeverityMedium, ResourceType: awstype.ResourceEC2, ResourceID: "i-stopped", Region: "us-east-1", Message: "Stopped 45 days", EstimatedMonthlyWaste: 8},
+		awstype.Finding{ID: awstype.FindingDetachedEBS, Severity: awstype.SeverityHigh, ResourceType: awstype.ResourceEBS, ResourceID: "vol-1", Region: "us-east-1", Message: "Detached 30 days", EstimatedMonthlyWaste: 12},
+	)
+
+	var buf bytes.Buffer
+	if err := (&JUnitReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+			Cases    []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Message string `xml:"message,attr"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+	if suites.Tests != 3 || suites.Failures != 2 || len(suites.Suites) != 2 {
+		t.Fatalf("expected 3 tests, 2 failures in 2 suites, got %d tests, %d failures in %d suites", suites.Tests, suites.Failures, len(suites.Suites))
+	}
+	ebs, ec2 := suites.Suites[0], suites.Suites[1]
+	if ebs.Name != "ebs" || ebs.Tests != 1 || ebs.Failures != 1 {
+		t.Fatalf("expected ebs suite with 1 failing test, got %+v", ebs)
+	}
+	if ec2.Name != "ec2" || ec2.Tests != 2 || ec2.Failures != 1 {
+		t.Fatalf("expected ec2 suite with 2 tests and 1 failure, got %+v", ec2)
+	}
+	if ec2.Cases[0].Failure == nil || !strings.Contains(ec2.Cases[0].Failure.Message, "$50.00") {
+		t.Fatalf("expected the high-severity failure message to include the waste, got %+v", ec2.Cases[0])
+	}
+	if ec2.Cases[1].Failure != nil {
+		t.Fatal("expected the medium-severity finding to pass")
+	}
+}
+
+type mockPutMetricData struct {
+	inputs []*cloudwatch.PutMetricDataInput
+}
+
+func (m *mockPutMetricData) PutMetricData(_ context.Context, input *cloudwatch.PutMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.PutMetricDataOutput, error) {
+	m.inputs = append(m.inputs, input)
+	return &cloudwatch.PutMetricDataOutput{}, nil
+}
+
+func TestCloudWatchPublisher_Publish(t *testing.T) {
+	data := sampleData()
+	data.Findings = append(data.Findings, awstype.Finding{
+		ID:                    awstype.FindingDetachedEBS,
+		ResourceType:          awstype.ResourceEBS,
+		ResourceID:            "vol-1",
+		EstimatedMonthlyWaste: 8,
+	})
+	data.Summary.TotalMonthlyWaste = 58
+
+	client := &mockPutMetricData{}
+	p := &CloudWatchPublisher{Client: client}
+	if err := p.Publish(context.Background(), data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(client.inputs) != 1 {
+		t.Fatalf("expected 1 PutMetricData call, got %d", len(client.inputs))
+	}
+	input := client.inputs[0]
+	if awssdk.ToString(input.Namespace) != "AwsSpectre" {
+		t.Fatalf("expected default namespace AwsSpectre, got %q", awssdk.ToString(input.Namespace))
+	}
+	got := make(map[string]float64)
+	for _, d := range input.MetricData {
+		if awssdk.ToString(d.MetricName) != "EstimatedMonthlyWaste" {
+			t.Fatalf("expected metric EstimatedMonthlyWaste, got %q", awssdk.ToString(d.MetricName))
+		}
+		key := "total"
+		if len(d.Dimensions) == 1 && awssdk.ToString(d.Dimensions[0].Name) == "ResourceType" {
+			key = awssdk.ToString(d.Dimensions[0].Value)
+		}
+		got[key] = awssdk.ToFloat64(d.Value)
+	}
+	want := map[string]float64{"total": 58, "ec2": 50, "ebs": 8}
+	if len(got) != len(want) {
+		t.Fatalf("expected datums %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("expected %s = %.2f, got %.2f", k, v, got[k])
+		}
+	}
+}
+
+type mockPutObject struct {
+	inputs []*s3.PutObjectInput
+	bodies [][]byte
+}
+
+func (m *mockPutObject) PutObject(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.inputs = append(m.inputs, input)
+	m.bodies = append(m.bodies, body)
+	return &s3.PutObjectOutput{}, nil
+}
+
+func TestS3Uploader_Upload(t *testing.T) {
+	data := sampleData()
+	var buf bytes.Buffer
+	if err := (&JSONReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bucket, prefix, err := ParseS3URI("s3://reports-bucket/awsspectre/prod/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &mockPutObject{}
+	u := &S3Uploader{Client: client, Bucket: bucket, Prefix: prefix}
+	key, err := u.Upload(context.Background(), "json", data, buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key != "awsspectre/prod/2026-02-24T12-00-00Z.json" {
+		t.Fatalf("unexpected key %q", key)
+	}
+	if len(client.inputs) != 1 {
+		t.Fatalf("expected 1 PutObject call, got %d", len(client.inputs))
+	}
+	input := client.inputs[0]
+	if awssdk.ToString(input.Bucket) != "reports-bucket" || awssdk.ToString(input.Key) != key {
+		t.Fatalf("expected s3://reports-bucket/%s, got s3://%s/%s", key, awssdk.ToString(input.Bucket), awssdk.ToString(input.Key))
+	}
+	if awssdk.ToString(input.ContentType) != "application/json" {
+		t.Fatalf("expected application/json, got %q", awssdk.ToString(input.ContentType))
+	}
+	if !bytes.Equal(client.bodies[0], buf.Bytes()) {
+		t.Fatalf("expected the generated report as body, got %q", client.bodies[0])
+	}
+
+	// Without a prefix the key is the timestamped name alone, with the format's extension.
+	u = &S3Uploader{Client: client, Bucket: "b"}
+	if key := u.Key("markdown", data); key != "2026-02-24T12-00-00Z.md" {
+		t.Fatalf("unexpected key without prefix %q", key)
+	}
+}
+
+func TestParseS3URI_Invalid(t *testing.T) {
+	for _, uri := range []string{"reports-bucket/prefix", "s3://", "s3:///prefix"} {
+		if _, _, err := ParseS3URI(uri); err == nil {
+			t.Fatalf("expected an error for %q", uri)
+		}
+	}
+}
+
+func TestEmailReporter_Generate(t *testing.T) {
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMsg []byte
+	send := func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+		return nil
+	}
+
+	r := &EmailReporter{
+		Server:     "smtp.example.com:587",
+		From:       "awsspectre@example.com",
+		To:         []string{"finops@example.com"},
+		Format:     "json",
+		Attachment: []byte(`{"tool":"awsspectre"}`),
+		Send:       send,
+	}
+	if err := r.Generate(sampleData()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotAddr != "smtp.example.com:587" || gotFrom != "awsspectre@example.com" {
+		t.Fatalf("unexpected server %q or sender %q", gotAddr, gotFrom)
+	}
+	if len(gotTo) != 1 || gotTo[0] != "finops@example.com" {
+		t.Fatalf("expected recipient finops@example.com, got %v", gotTo)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(gotMsg))
+	if err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+	if to := msg.Header.Get("To"); to != "finops@example.com" {
+		t.Fatalf("expected To header finops@example.com, got %q", to)
+	}
+	if subject := msg.Header.Get("Subject"); !strings.Contains(subject, "$50.00") {
+		t.Fatalf("expected the total waste in the subject, got %q", subject)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("invalid Content-Type: %v", err)
+	}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	body, err := mr.NextPart()
+	if err != nil {
+		t.Fatalf("read body part: %v", err)
+	}
+	html, _ := io.ReadAll(body)
+	if !strings.HasPrefix(body.Header.Get("Content-Type"), "text/html") || !strings.Contains(string(html), "i-abc123") {
+		t.Fatalf("expected an HTML body with the finding, got %q", html)
+	}
+	attachment, err := mr.NextPart()
+	if err != nil {
+		t.Fatalf("read attachment part: %v", err)
+	}
+	if attachment.FileName() != "awsspectre-2026-02-24T12-00-00Z.json" {
+		t.Fatalf("unexpected attachment name %q", attachment.FileName())
+	}
+	encoded, _ := io.ReadAll(attachment)
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	if err != nil || string(decoded) != `{"tool":"awsspectre"}` {
+		t.Fatalf("expected the JSON report attached, got %q (%v)", decoded, err)
+	}
+}
+
+func TestJSONReporter_Currency(t *testing.T) {
+	data := sampleData()
+	data.Currency = "EUR"
+	data.ExchangeRate = 0.92
+
+	var buf bytes.Buffer
+	if err := (&JSONReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var envelope struct {
+		Currency string `json:"currency"`
+		Findings []struct {
+			Currency              string  `json:"currency"`
+			EstimatedMonthlyWaste float64 `json:"estimated_monthly_waste"`
+			ConvertedMonthlyWaste float64 `json:"converted_monthly_waste"`
+		} `json:"findings"`
+		Summary struct {
+			TotalMonthlyWaste          float64 `json:"total_monthly_waste"`
+			ConvertedTotalMonthlyWaste float64 `json:"converted_total_monthly_waste"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &envelope); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if envelope.Currency != "EUR" || len(envelope.Findings) != 1 || envelope.Findings[0].Currency != "EUR" {
+		t.Fatalf("expected EUR currency fields, got %+v", envelope)
+	}
+	if f := envelope.Findings[0]; f.EstimatedMonthlyWaste != 50 || f.ConvertedMonthlyWaste != 46 {
+		t.Fatalf("expected $50 and 46 EUR, got $%.2f and %.2f EUR", f.EstimatedMonthlyWaste, f.ConvertedMonthlyWaste)
+	}
+	if envelope.Summary.TotalMonthlyWaste != 50 || envelope.Summary.ConvertedTotalMonthlyWaste != 46 {
+		t.Fatalf("expected summary $50 and 46 EUR, got %+v", envelope.Summary)
+	}
+
+	buf.Reset()
+	if err := (&TextReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "46.00 EUR") {
+		t.Fatalf("expected converted amount in text output, got:\n%s", buf.String())
+	}
+}
+
+func TestReporters_UseDataExchangeRate(t *testing.T) {
+	// The embedded EUR rate differs; reports must use the rate carried in Data.
+	data := sampleData()
+	data.Currency = "EUR"
+	data.ExchangeRate = 0.5
+
+	var buf bytes.Buffer
+	if err := (&TextReporter{Writer: &buf}).Generate(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "25.00 EUR") {
+		t.Fatalf("expected 25.00 EUR at the report rate, got:\n%s", buf.String())
+	}
+	if converted := *data.convert(50); converted != 25 {
+		t.Fatalf("expected 25 EUR, got %.2f", converted)
+	}
+}
+
+func TestJSONReporter_NoCurrencyFields(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&JSONReporter{Writer: &buf}).Generate(sampleData()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "currency") || strings.Contains(buf.String(), "converted") {
+		t.Fatalf("expected USD-only output without currency fields, got:\n%s", buf.String())
+	}
+}
+
 func TestSARIFReporter_Generate(t *testing.T) {
 	var buf bytes.Buffer
 	r := &SARIFReporter{Writer: &buf}
@@ -380,6 +961,56 @@ func TestSARIFReporter_CostBearingRulesDeclared(t *testing.T) {
 	}
 }
 
+func TestBuildSARIFRules_CoversAllFindingIDs(t *testing.T) {
+	// FindingID constants cannot be enumerated at run time, so read them from source.
+	file, err := parser.ParseFile(token.NewFileSet(), "../aws/types.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parse finding IDs: %v", err)
+	}
+	var declared []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); !ok || ident.Name != "FindingID" {
+				continue
+			}
+			for _, v := range vs.Values {
+				if lit, ok := v.(*ast.BasicLit); ok {
+					declared = append(declared, strings.Trim(lit.Value, `"`))
+				}
+			}
+		}
+	}
+	if len(declared) == 0 {
+		t.Fatal("found no FindingID constants")
+	}
+
+	rules := make(map[string]bool)
+	for _, rule := range buildSARIFRules() {
+		if rules[rule.ID] {
+			t.Fatalf("duplicate SARIF rule %s", rule.ID)
+		}
+		rules[rule.ID] = true
+		switch rule.DefaultConfig.Level {
+		case "error", "warning", "note":
+		default:
+			t.Fatalf("SARIF rule %s has invalid level %q", rule.ID, rule.DefaultConfig.Level)
+		}
+	}
+	for _, id := range declared {
+		if !rules[id] {
+			t.Errorf("FindingID %s has no SARIF rule", id)
+		}
+	}
+	if len(rules) != len(declared) {
+		t.Errorf("expected %d SARIF rules, got %d", len(declared), len(rules))
+	}
+}
+
 func sarifResultByRuleID(t *testing.T, results []any, ruleID string) map[string]any {
 	t.Helper()
 
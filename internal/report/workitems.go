@@ -0,0 +1,43 @@
+package report
+
+import (
+	"strings"
+
+	"github.com/ppiankov/awsspectre/internal/analyzer"
+)
+
+// Generate writes findings grouped into cleanup work items.
+func (r *WorkItemsReporter) Generate(data Data) error {
+	w := &errWriter{w: r.Writer}
+	items := analyzer.BuildWorkItems(data.Findings)
+
+	w.println("awsspectre — Cleanup Work Items")
+	w.println(strings.Repeat("=", 40))
+	w.println("")
+
+	if len(items) == 0 {
+		w.println("No idle resources found.")
+		return w.err
+	}
+
+	var teardown, modify float64
+	for _, item := range items {
+		teardown += item.TotalMonthlySavings
+		modify += item.ModifyMonthlySavings
+	}
+	w.printf("%d work items covering %d findings, estimated monthly savings $%.2f from teardown",
+		len(items), data.Summary.TotalFindings, teardown)
+	if modify > 0 {
+		w.printf(" and $%.2f from modifications", modify)
+	}
+	w.printf("\n\n")
+
+	for i, item := range items {
+		w.printf("[%d] %s (%s)\n", i+1, item.Title, item.Region)
+		for _, step := range item.TeardownSteps {
+			w.printf("    %s\n", step)
+		}
+		w.println("")
+	}
+	return w.err
+}